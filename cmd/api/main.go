@@ -4,12 +4,23 @@ package main
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"log"
 
+	"github.com/Perajit/expense-tracker-go/internal/account"
 	"github.com/Perajit/expense-tracker-go/internal/auth"
+	"github.com/Perajit/expense-tracker-go/internal/budget"
 	"github.com/Perajit/expense-tracker-go/internal/database"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/goal"
+	"github.com/Perajit/expense-tracker-go/internal/group"
+	"github.com/Perajit/expense-tracker-go/internal/importer"
 	"github.com/Perajit/expense-tracker-go/internal/middleware"
+	"github.com/Perajit/expense-tracker-go/internal/notification"
+	"github.com/Perajit/expense-tracker-go/internal/report"
+	"github.com/Perajit/expense-tracker-go/internal/storage"
 	"github.com/Perajit/expense-tracker-go/internal/user"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -28,8 +39,34 @@ func main() {
 		panic("failed to connect to database")
 	}
 
+	blobStore, err := storage.NewFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid storage configuration: %v", err)
+	}
+
+	attachmentConfig := expense.AttachmentConfig{
+		MaxSize:   envInt64("ATTACHMENT_MAX_SIZE", expense.DefaultAttachmentMaxSize),
+		UserQuota: envInt64("ATTACHMENT_QUOTA", expense.DefaultAttachmentQuota),
+		URLSecret: []byte(os.Getenv("ATTACHMENT_URL_SECRET")),
+		URLTTL:    expense.DefaultAttachmentURLTTL,
+	}
+	if len(attachmentConfig.URLSecret) == 0 {
+		attachmentConfig.URLSecret = []byte(os.Getenv("JWT_ACCESS_SECRET"))
+	}
+	if value := os.Getenv("ATTACHMENT_URL_TTL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("Invalid ATTACHMENT_URL_TTL: %v", err)
+		}
+		attachmentConfig.URLTTL = parsed
+	}
+
 	// init app
-	app := fiber.New()
+	app := fiber.New(fiber.Config{
+		EnableSplittingOnParsers: true,
+		// leave room for the multipart envelope around an attachment of the maximum size
+		BodyLimit: int(attachmentConfig.MaxSize) + 1<<20,
+	})
 
 	// set up dependencies
 	accessSecret := os.Getenv("JWT_ACCESS_SECRET")
@@ -47,9 +84,107 @@ func main() {
 
 	authMiddleware := middleware.AuthMiddleware(authService)
 
+	accountRepository := account.NewAccountRepository(db)
+	accountService := account.NewAccountService(accountRepository, userService)
+	accountHandler := account.NewAccountHandler(accountService, validate)
+
+	transferRepository := account.NewTransferRepository(db)
+	transferService := account.NewTransferService(db, transferRepository, accountRepository)
+	transferHandler := account.NewTransferHandler(transferService, validate)
+
+	expenseRepository := expense.NewExpenseRepository(db)
+	revisionRepository := expense.NewRevisionRepository(db)
+
+	categoryRepository := expense.NewCategoryRepository(db)
+	categoryService := expense.NewCategoryService(db, categoryRepository, expenseRepository, revisionRepository)
+	categoryHandler := expense.NewCategoryHandler(categoryService, validate)
+
+	tagRepository := expense.NewTagRepository(db)
+	tagService := expense.NewTagService(db, tagRepository, expenseRepository, revisionRepository)
+	tagHandler := expense.NewTagHandler(tagService, validate)
+
+	ruleRepository := expense.NewRuleRepository(db)
+	ruleService := expense.NewRuleService(db, ruleRepository, expenseRepository, revisionRepository, categoryService, tagService, userService, accountService)
+	ruleHandler := expense.NewRuleHandler(ruleService, validate)
+
+	duplicateRepository := expense.NewDuplicateRepository(db)
+	duplicateService := expense.NewDuplicateService(db, expenseRepository, revisionRepository, duplicateRepository)
+
+	recurringExpenseRepository := expense.NewRecurringExpenseRepository(db)
+	recurringExpenseService := expense.NewRecurringExpenseService(db, recurringExpenseRepository, expenseRepository, revisionRepository, categoryService, tagService)
+	recurringExpenseHandler := expense.NewRecurringExpenseHandler(recurringExpenseService, validate)
+
+	reportRepository := report.NewReportRepository(db)
+	reportService := report.NewReportService(reportRepository, userService)
+	reportHandler := report.NewReportHandler(reportService, validate)
+
+	budgetRepository := budget.NewBudgetRepository(db)
+	budgetService := budget.NewBudgetService(db, budgetRepository, reportRepository, categoryService, tagService, userService)
+	budgetHandler := budget.NewBudgetHandler(budgetService, validate)
+
+	notificationChannels, err := notification.NewChannelsFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid notification configuration: %v", err)
+	}
+	notificationRepository := notification.NewNotificationRepository(db)
+	notificationService := notification.NewNotificationService(notificationRepository, expenseRepository, recurringExpenseService, budgetService, userService, notificationChannels)
+	notificationHandler := notification.NewNotificationHandler(notificationService, validate)
+	notificationListener := notification.NewExpenseListener(notificationService)
+
+	merchantRepository := expense.NewMerchantRepository(db)
+	merchantService := expense.NewMerchantService(db, merchantRepository, categoryService, tagService)
+	merchantHandler := expense.NewMerchantHandler(merchantService, validate)
+
+	expenseService := expense.NewExpenseService(db, expenseRepository, revisionRepository, categoryService, tagService, userService, accountService, ruleService, merchantService, notificationListener)
+	expenseHandler := expense.NewExpenseHandler(expenseService, categoryService, tagService, duplicateService, validate)
+
+	trashRetention := expense.DefaultTrashRetention
+	if value := os.Getenv("TRASH_RETENTION"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("Invalid TRASH_RETENTION: %v", err)
+		}
+		trashRetention = parsed
+	}
+	attachmentRepository := expense.NewAttachmentRepository(db)
+	attachmentService := expense.NewAttachmentService(db, attachmentRepository, expenseRepository, blobStore, attachmentConfig)
+	attachmentHandler := expense.NewAttachmentHandler(attachmentService, validate)
+
+	trashService := expense.NewTrashService(db, expenseRepository, revisionRepository, categoryRepository, tagRepository, attachmentRepository, blobStore, trashRetention)
+	trashHandler := expense.NewTrashHandler(trashService)
+
+	groupRepository := group.NewGroupRepository(db)
+	groupExpenseRepository := group.NewGroupExpenseRepository(db)
+	groupService := group.NewGroupService(db, groupRepository, groupExpenseRepository, userService)
+	groupExpenseService := group.NewGroupExpenseService(groupRepository, groupExpenseRepository, expenseRepository)
+	groupHandler := group.NewGroupHandler(groupService, groupExpenseService, validate)
+
+	importService := importer.NewImportService(db, expenseRepository, revisionRepository, categoryRepository, tagRepository, ruleRepository, merchantRepository, userService)
+	importHandler := importer.NewImportHandler(importService, validate)
+
+	goalRepository := goal.NewGoalRepository(db)
+	goalService := goal.NewGoalService(goalRepository, accountService, userService)
+	goalHandler := goal.NewGoalHandler(goalService, validate)
+
 	// routes
 	userHandler.RegisterRoutes(app, authMiddleware)
 	authHandler.RegisterRoutes(app)
+	accountHandler.RegisterRoutes(app, authMiddleware)
+	transferHandler.RegisterRoutes(app, authMiddleware)
+	categoryHandler.RegisterRoutes(app, authMiddleware)
+	tagHandler.RegisterRoutes(app, authMiddleware)
+	expenseHandler.RegisterRoutes(app, authMiddleware)
+	recurringExpenseHandler.RegisterRoutes(app, authMiddleware)
+	ruleHandler.RegisterRoutes(app, authMiddleware)
+	merchantHandler.RegisterRoutes(app, authMiddleware)
+	attachmentHandler.RegisterRoutes(app, authMiddleware)
+	trashHandler.RegisterRoutes(app, authMiddleware)
+	importHandler.RegisterRoutes(app, authMiddleware)
+	reportHandler.RegisterRoutes(app, authMiddleware)
+	budgetHandler.RegisterRoutes(app, authMiddleware)
+	groupHandler.RegisterRoutes(app, authMiddleware)
+	goalHandler.RegisterRoutes(app, authMiddleware)
+	notificationHandler.RegisterRoutes(app, authMiddleware)
 
 	// start app
 	port := os.Getenv("APP_PORT")
@@ -62,3 +197,17 @@ func main() {
 		log.Fatalf("Error starting server: %v", err)
 	}
 }
+
+func envInt64(name string, fallback int64) int64 {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback
+	}
+
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || parsed <= 0 {
+		log.Fatalf("Invalid %s: %q", name, value)
+	}
+
+	return parsed
+}
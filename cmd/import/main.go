@@ -0,0 +1,94 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/database"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/importer"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	var dto importer.ImportCSVRequest
+	userID := flag.Uint("user", 0, "id of the user who owns the imported expenses")
+	filePath := flag.String("file", "", "path to the csv file")
+	flag.StringVar(&dto.Delimiter, "delimiter", "", "field delimiter (default ,)")
+	flag.StringVar(&dto.DateColumn, "date-col", "date", "date column header")
+	flag.StringVar(&dto.DateFormat, "date-format", "YYYY-MM-DD", "date format, e.g. DD/MM/YYYY")
+	flag.StringVar(&dto.AmountColumn, "amount-col", "amount", "amount column header")
+	flag.StringVar(&dto.DecimalSeparator, "decimal", ".", "decimal separator, . or ,")
+	flag.StringVar(&dto.CurrencyColumn, "currency-col", "", "currency column header")
+	flag.StringVar(&dto.Currency, "currency", "", "currency of rows without one (default: the user's default currency)")
+	flag.StringVar(&dto.NoteColumn, "note-col", "", "note column header")
+	flag.StringVar(&dto.CategoryColumn, "category-col", "", "category column header")
+	flag.StringVar(&dto.DefaultCategory, "default-category", "", "category for rows without one")
+	flag.StringVar(&dto.TagsColumn, "tags-col", "", "tags column header")
+	flag.StringVar(&dto.TagSeparator, "tag-separator", "|", "separator between tags in the tags column")
+	flag.BoolVar(&dto.DryRun, "dry-run", false, "validate the file without importing")
+	flag.BoolVar(&dto.CreateMissing, "create-missing", false, "create categories and tags that do not exist")
+	flag.Parse()
+
+	if *userID == 0 || *filePath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	err := godotenv.Load()
+	if err != nil {
+		log.Println("WARNING: .env not found, using system env variables")
+	}
+
+	db, err := database.ConnectDB()
+	if err != nil {
+		log.Fatalf("Import failed: could not connect to databse: %v", err)
+	}
+
+	file, err := os.Open(*filePath)
+	if err != nil {
+		log.Fatalf("Import failed: could not open file: %v", err)
+	}
+	defer file.Close()
+
+	importService := importer.NewImportService(
+		db,
+		expense.NewExpenseRepository(db),
+		expense.NewRevisionRepository(db),
+		expense.NewCategoryRepository(db),
+		expense.NewTagRepository(db),
+		expense.NewRuleRepository(db),
+		expense.NewMerchantRepository(db),
+		user.NewUserService(user.NewUserRepository(db)),
+	)
+
+	result, err := importService.ImportCSV(*userID, file, dto)
+	if result != nil {
+		for _, rowErr := range result.Errors {
+			log.Printf("line %d: %s: %s", rowErr.Line, rowErr.Field, rowErr.Message)
+		}
+		for _, name := range result.NewCategories {
+			log.Printf("new category: %s", name)
+		}
+		for _, name := range result.NewTags {
+			log.Printf("new tag: %s", name)
+		}
+	}
+	if err != nil {
+		if errors.Is(err, apperror.ErrInvalidRequest) && result != nil {
+			log.Fatalf("Import failed: %d row(s) with errors, nothing imported", len(result.Errors))
+		}
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	if dto.DryRun {
+		log.Printf("Dry run: %d row(s) read, %d error(s)", result.Total, len(result.Errors))
+		return
+	}
+
+	log.Printf("Imported %d of %d row(s)", result.Imported, result.Total)
+}
@@ -3,9 +3,15 @@ package main
 import (
 	"log"
 
+	"github.com/Perajit/expense-tracker-go/internal/account"
 	"github.com/Perajit/expense-tracker-go/internal/auth"
+	"github.com/Perajit/expense-tracker-go/internal/budget"
+	"github.com/Perajit/expense-tracker-go/internal/currency"
 	"github.com/Perajit/expense-tracker-go/internal/database"
 	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/goal"
+	"github.com/Perajit/expense-tracker-go/internal/group"
+	"github.com/Perajit/expense-tracker-go/internal/notification"
 	"github.com/Perajit/expense-tracker-go/internal/user"
 	"github.com/joho/godotenv"
 )
@@ -26,11 +32,21 @@ func main() {
 	models := []any{}
 	models = append(models, user.GetModels()...)
 	models = append(models, auth.GetModels()...)
+	models = append(models, account.GetModels()...)
 	models = append(models, expense.GetModels()...)
+	models = append(models, budget.GetModels()...)
+	models = append(models, currency.GetModels()...)
+	models = append(models, group.GetModels()...)
+	models = append(models, goal.GetModels()...)
+	models = append(models, notification.GetModels()...)
 
 	if err := db.AutoMigrate(models...); err != nil {
 		log.Fatalf("Migration failed: %v", err)
 	}
 
+	if err := expense.MigrateData(db); err != nil {
+		log.Fatalf("Migration failed: %v", err)
+	}
+
 	log.Println("-- Migration completed successfully ---")
 }
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/database"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/storage"
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	retentionFlag := flag.Duration("retention", 0, "purge items deleted longer ago than this (default TRASH_RETENTION or 720h)")
+	flag.Parse()
+
+	err := godotenv.Load()
+	if err != nil {
+		log.Println("WARNING: .env not found, using system env variables")
+	}
+
+	retention := expense.DefaultTrashRetention
+	if value := os.Getenv("TRASH_RETENTION"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("Purge failed: invalid TRASH_RETENTION: %v", err)
+		}
+		retention = parsed
+	}
+	if *retentionFlag > 0 {
+		retention = *retentionFlag
+	}
+
+	db, err := database.ConnectDB()
+	if err != nil {
+		log.Fatalf("Purge failed: could not connect to databse: %v", err)
+	}
+
+	blobStore, err := storage.NewFromEnv()
+	if err != nil {
+		log.Fatalf("Purge failed: invalid storage configuration: %v", err)
+	}
+
+	trashService := expense.NewTrashService(
+		db,
+		expense.NewExpenseRepository(db),
+		expense.NewRevisionRepository(db),
+		expense.NewCategoryRepository(db),
+		expense.NewTagRepository(db),
+		expense.NewAttachmentRepository(db),
+		blobStore,
+		retention,
+	)
+
+	result, err := trashService.Purge(time.Now())
+	if err != nil {
+		log.Fatalf("Purge failed: %v", err)
+	}
+
+	log.Printf("Purged %d expense(s), %d category(s), %d tag(s) and %d attachment(s) deleted more than %v ago",
+		result.Expenses, result.Categories, result.Tags, result.Attachments, retention)
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/currency"
+	"github.com/Perajit/expense-tracker-go/internal/database"
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	filePath := flag.String("file", "", "csv or json file with date,base,quote,rate entries")
+	providerURL := flag.String("provider-url", "", "rate provider url, defaults to EXCHANGE_RATE_PROVIDER_URL")
+	base := flag.String("base", currency.DefaultCode, "base currency to fetch from the provider")
+	date := flag.String("date", time.Now().Format(time.DateOnly), "date to fetch from the provider")
+	flag.Parse()
+
+	err := godotenv.Load()
+	if err != nil {
+		log.Println("WARNING: .env not found, using system env variables")
+	}
+
+	db, err := database.ConnectDB()
+	if err != nil {
+		log.Fatalf("Rates failed: could not connect to databse: %v", err)
+	}
+
+	currencyService := currency.NewCurrencyService(currency.NewExchangeRateRepository(db))
+
+	if *filePath != "" {
+		file, err := os.Open(*filePath)
+		if err != nil {
+			log.Fatalf("Rates failed: could not open file: %v", err)
+		}
+		defer file.Close()
+
+		format := currency.FileFormat(strings.TrimPrefix(filepath.Ext(*filePath), "."))
+		count, err := currencyService.ImportRates(file, format)
+		if err != nil {
+			log.Fatalf("Rates failed: %v", err)
+		}
+
+		log.Printf("Loaded %d exchange rate(s) from %s", count, *filePath)
+		return
+	}
+
+	if *providerURL == "" {
+		*providerURL = os.Getenv("EXCHANGE_RATE_PROVIDER_URL")
+	}
+	if *providerURL == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	day, err := time.ParseInLocation(time.DateOnly, *date, time.UTC)
+	if err != nil {
+		log.Fatalf("Rates failed: invalid date: %v", err)
+	}
+
+	code, ok := currency.Normalize(*base)
+	if !ok {
+		log.Fatalf("Rates failed: invalid base currency %q", *base)
+	}
+
+	count, err := currencyService.SyncRates(currency.NewHTTPProvider(*providerURL), code, day)
+	if err != nil {
+		log.Fatalf("Rates failed: %v", err)
+	}
+
+	log.Printf("Synced %d exchange rate(s) for %s on %s", count, code, *date)
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/budget"
+	"github.com/Perajit/expense-tracker-go/internal/currency"
+	"github.com/Perajit/expense-tracker-go/internal/database"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/notification"
+	"github.com/Perajit/expense-tracker-go/internal/report"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	"github.com/joho/godotenv"
+)
+
+const defaultInterval = time.Hour
+
+func main() {
+	once := flag.Bool("once", false, "materialize due occurrences once and exit")
+	flag.Parse()
+
+	err := godotenv.Load()
+	if err != nil {
+		log.Println("WARNING: .env not found, using system env variables")
+	}
+
+	db, err := database.ConnectDB()
+	if err != nil {
+		log.Fatalf("Scheduler failed: could not connect to databse: %v", err)
+	}
+
+	expenseRepository := expense.NewExpenseRepository(db)
+	revisionRepository := expense.NewRevisionRepository(db)
+	categoryService := expense.NewCategoryService(db, expense.NewCategoryRepository(db), expenseRepository, revisionRepository)
+	tagService := expense.NewTagService(db, expense.NewTagRepository(db), expenseRepository, revisionRepository)
+	recurringExpenseService := expense.NewRecurringExpenseService(
+		db,
+		expense.NewRecurringExpenseRepository(db),
+		expenseRepository,
+		revisionRepository,
+		categoryService,
+		tagService,
+	)
+
+	userService := user.NewUserService(user.NewUserRepository(db))
+	budgetService := budget.NewBudgetService(db, budget.NewBudgetRepository(db), report.NewReportRepository(db), categoryService, tagService, userService)
+	notificationChannels, err := notification.NewChannelsFromEnv()
+	if err != nil {
+		log.Fatalf("Scheduler failed: invalid notification configuration: %v", err)
+	}
+	notificationService := notification.NewNotificationService(
+		notification.NewNotificationRepository(db),
+		expenseRepository,
+		recurringExpenseService,
+		budgetService,
+		userService,
+		notificationChannels,
+	)
+
+	// exchange rates are synced on every run when a provider is configured
+	currencyService := currency.NewCurrencyService(currency.NewExchangeRateRepository(db))
+	var rateProvider currency.RateProvider
+	if providerURL := os.Getenv("EXCHANGE_RATE_PROVIDER_URL"); providerURL != "" {
+		rateProvider = currency.NewHTTPProvider(providerURL)
+	}
+	rateBase := os.Getenv("EXCHANGE_RATE_BASE")
+	if rateBase == "" {
+		rateBase = currency.DefaultCode
+	}
+
+	run := func() {
+		if rateProvider != nil {
+			synced, err := currencyService.SyncRates(rateProvider, rateBase, time.Now())
+			if err != nil {
+				log.Printf("Scheduler could not sync exchange rates: %v", err)
+			} else {
+				log.Printf("Scheduler synced %d exchange rate(s)", synced)
+			}
+		}
+
+		created, err := recurringExpenseService.MaterializeDue(time.Now())
+		if err != nil {
+			log.Printf("Scheduler run finished with errors: %v", err)
+		}
+		log.Printf("Scheduler created %d expense(s)", created)
+
+		notified, err := notificationService.NotifyUpcoming(time.Now())
+		if err != nil {
+			log.Printf("Scheduler could not deliver every upcoming charge notification: %v", err)
+		}
+		log.Printf("Scheduler sent %d upcoming charge notification(s)", notified)
+	}
+
+	run()
+	if *once {
+		return
+	}
+
+	interval := defaultInterval
+	if value := os.Getenv("SCHEDULER_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("Scheduler failed: invalid SCHEDULER_INTERVAL: %v", err)
+		}
+		interval = parsed
+	}
+
+	log.Printf("Scheduler is running every %v", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		run()
+	}
+}
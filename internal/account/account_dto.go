@@ -0,0 +1,85 @@
+package account
+
+import (
+	"github.com/Perajit/expense-tracker-go/internal/report"
+	"github.com/shopspring/decimal"
+)
+
+type CreateAccountRequest struct {
+	Name           string          `json:"name" validate:"required,max=100"`
+	Type           string          `json:"type" validate:"required,oneof=cash bank credit_card ewallet"`
+	Currency       string          `json:"currency" validate:"omitempty,iso4217"`
+	OpeningBalance decimal.Decimal `json:"openingBalance"`
+}
+
+type UpdateAccountRequest struct {
+	Name           *string          `json:"name" validate:"omitempty,max=100"`
+	Type           *string          `json:"type" validate:"omitempty,oneof=cash bank credit_card ewallet"`
+	OpeningBalance *decimal.Decimal `json:"openingBalance"`
+}
+
+type GetBalanceHistoryRequest struct {
+	From     string `query:"from" validate:"required,datetime=2006-01-02"`
+	To       string `query:"to" validate:"required,datetime=2006-01-02"`
+	Period   string `query:"period" validate:"omitempty,oneof=day week month year"`
+	Timezone string `query:"tz" validate:"omitempty,timezone"`
+}
+
+// AccountBalance is an account together with its balance as of now.
+type AccountBalance struct {
+	Account     AccountEntity
+	Balance     decimal.Decimal
+	Unconverted int64
+}
+
+type AccountResponse struct {
+	ID             uint            `json:"id"`
+	Name           string          `json:"name"`
+	Type           AccountType     `json:"type"`
+	Currency       string          `json:"currency"`
+	OpeningBalance decimal.Decimal `json:"openingBalance"`
+}
+
+func (AccountResponse) FromEntity(account AccountEntity) AccountResponse {
+	return AccountResponse{
+		ID:             account.ID,
+		Name:           account.Name,
+		Type:           account.Type,
+		Currency:       account.Currency,
+		OpeningBalance: account.OpeningBalance,
+	}
+}
+
+type AccountBalanceResponse struct {
+	AccountResponse
+	Balance     decimal.Decimal `json:"balance"`
+	Unconverted int64           `json:"unconverted"`
+}
+
+func (AccountBalanceResponse) FromBalance(balance AccountBalance) AccountBalanceResponse {
+	return AccountBalanceResponse{
+		AccountResponse: AccountResponse{}.FromEntity(balance.Account),
+		Balance:         balance.Balance,
+		Unconverted:     balance.Unconverted,
+	}
+}
+
+type BalancePeriodRow struct {
+	Period      string          `json:"period"`
+	Change      decimal.Decimal `json:"change"`
+	Balance     decimal.Decimal `json:"balance"`
+	Unconverted int64           `json:"unconverted"`
+}
+
+type BalanceHistoryResponse struct {
+	AccountID   uint               `json:"accountId"`
+	Currency    string             `json:"currency"`
+	From        string             `json:"from"`
+	To          string             `json:"to"`
+	Timezone    string             `json:"timezone"`
+	Period      report.Period      `json:"period"`
+	Opening     decimal.Decimal    `json:"opening"`
+	Closing     decimal.Decimal    `json:"closing"`
+	Unconverted int64              `json:"unconverted"`
+	ByPeriod    []BalancePeriodRow `json:"byPeriod"`
+}
@@ -0,0 +1,30 @@
+package account
+
+import (
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type AccountType string
+
+const (
+	AccountTypeCash       AccountType = "cash"
+	AccountTypeBank       AccountType = "bank"
+	AccountTypeCreditCard AccountType = "credit_card"
+	AccountTypeEWallet    AccountType = "ewallet"
+)
+
+type AccountEntity struct {
+	gorm.Model
+	UserID         uint            `gorm:"not null;index:idx_accounts_user"`
+	User           user.UserEntity `gorm:"foreignKey:UserID"`
+	Name           string          `gorm:"type:varchar(100);not null"`
+	Type           AccountType     `gorm:"type:varchar(20);not null"`
+	Currency       string          `gorm:"type:char(3);not null"`
+	OpeningBalance decimal.Decimal `gorm:"type:decimal(15,2);not null;default:0"`
+}
+
+func (AccountEntity) TableName() string {
+	return "accounts"
+}
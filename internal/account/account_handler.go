@@ -0,0 +1,189 @@
+package account
+
+import (
+	"errors"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/util"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type AccountHandler struct {
+	accountService AccountService
+	validate       *validator.Validate
+}
+
+func NewAccountHandler(accountService AccountService, validate *validator.Validate) *AccountHandler {
+	return &AccountHandler{
+		accountService: accountService,
+		validate:       validate,
+	}
+}
+
+func (h *AccountHandler) RegisterRoutes(app *fiber.App, authMiddleware fiber.Handler) {
+	group := app.Group("/accounts", authMiddleware)
+	group.Get("/", h.GetAccounts)
+	group.Get("/:id", h.GetAccountByID)
+	group.Get("/:id/balances", h.GetBalanceHistory)
+	group.Post("/", h.CreateAccount)
+	group.Patch("/:id", h.UpdateAccount)
+	group.Delete("/:id", h.DeleteAccount)
+}
+
+func (h *AccountHandler) GetAccounts(c *fiber.Ctx) error {
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	balances, err := h.accountService.GetAccounts(authUserID)
+	if err != nil {
+		log.Error(err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	accountResponses := []AccountBalanceResponse{}
+	for _, balance := range balances {
+		accountResponses = append(accountResponses, AccountBalanceResponse{}.FromBalance(balance))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(accountResponses)
+}
+
+func (h *AccountHandler) GetAccountByID(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	balance, err := h.accountService.GetAccountByID(id, authUserID)
+	if err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(AccountBalanceResponse{}.FromBalance(*balance))
+}
+
+func (h *AccountHandler) GetBalanceHistory(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractQuery[GetBalanceHistoryRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	history, err := h.accountService.GetBalanceHistory(id, authUserID, dto)
+	if err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(history)
+}
+
+func (h *AccountHandler) CreateAccount(c *fiber.Ctx) error {
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractDto[CreateAccountRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	account, err := h.accountService.CreateAccount(authUserID, dto)
+	if err != nil {
+		log.Error(err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(AccountResponse{}.FromEntity(*account))
+}
+
+func (h *AccountHandler) UpdateAccount(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractDto[UpdateAccountRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	if err := h.accountService.UpdateAccount(id, authUserID, dto); err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"status": "success"})
+}
+
+func (h *AccountHandler) DeleteAccount(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	if err := h.accountService.DeleteAccount(id, authUserID); err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"status": "success"})
+}
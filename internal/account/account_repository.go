@@ -0,0 +1,139 @@
+package account
+
+import (
+	"fmt"
+
+	"github.com/Perajit/expense-tracker-go/internal/currency"
+	"github.com/Perajit/expense-tracker-go/internal/report"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type AccountRepository interface {
+	WithTx(tx *gorm.DB) AccountRepository
+	GetByUser(userID uint) ([]AccountEntity, error)
+	GetByIDAndUser(id uint, userID uint) (*AccountEntity, error)
+	IsOwner(id uint, userID uint) (bool, error)
+	Create(account *AccountEntity) error
+	Update(account *AccountEntity) error
+	Delete(id uint) error
+	SumMovements(account AccountEntity, to int64) (*MovementTotalRow, error)
+	SumMovementsByPeriod(account AccountEntity, from int64, to int64, timezone string, period report.Period) ([]MovementPeriodRow, error)
+}
+
+type MovementTotalRow struct {
+	Total       decimal.Decimal
+	Unconverted int64
+}
+
+type MovementPeriodRow struct {
+	Period      string
+	Total       decimal.Decimal
+	Unconverted int64
+}
+
+type accountRepository struct {
+	db *gorm.DB
+}
+
+func NewAccountRepository(db *gorm.DB) AccountRepository {
+	return &accountRepository{db: db}
+}
+
+func (r *accountRepository) WithTx(tx *gorm.DB) AccountRepository {
+	if tx == nil {
+		return r
+	}
+
+	return &accountRepository{db: tx}
+}
+
+func (r *accountRepository) GetByUser(userID uint) ([]AccountEntity, error) {
+	var accounts []AccountEntity
+	if err := r.db.Where("user_id = ?", userID).Order("id").Find(&accounts).Error; err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+func (r *accountRepository) GetByIDAndUser(id uint, userID uint) (*AccountEntity, error) {
+	var account AccountEntity
+	if err := r.db.Where("id = ?", id).Where("user_id = ?", userID).First(&account).Error; err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+func (r *accountRepository) IsOwner(id uint, userID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&AccountEntity{}).Where("id = ?", id).Where("user_id = ?", userID).Count(&count).Error
+
+	return count > 0, err
+}
+
+func (r *accountRepository) Create(account *AccountEntity) error {
+	return r.db.Create(account).Error
+}
+
+func (r *accountRepository) Update(account *AccountEntity) error {
+	return r.db.Save(account).Error
+}
+
+func (r *accountRepository) Delete(id uint) error {
+	return r.db.Delete(&AccountEntity{}, id).Error
+}
+
+// SumMovements adds up everything that went in or out of the account up to and including to.
+func (r *accountRepository) SumMovements(account AccountEntity, to int64) (*MovementTotalRow, error) {
+	var row MovementTotalRow
+	if err := r.db.Table("(?) AS m", r.movements(account)).
+		Select("COALESCE(SUM(m.amount), 0) AS total, COUNT(*) FILTER (WHERE m.amount IS NULL) AS unconverted").
+		Where("m.date <= ?", to).
+		Scan(&row).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func (r *accountRepository) SumMovementsByPeriod(account AccountEntity, from int64, to int64, timezone string, period report.Period) ([]MovementPeriodRow, error) {
+	var rows []MovementPeriodRow
+	if err := r.db.Table("(?) AS m", r.movements(account)).
+		Select(fmt.Sprintf("%s AS period, COALESCE(SUM(m.amount), 0) AS total, COUNT(*) FILTER (WHERE m.amount IS NULL) AS unconverted", report.BucketExpr(period, "m.date")), timezone).
+		Where("m.date BETWEEN ? AND ?", from, to).
+		Group("period").
+		Order("period").
+		Scan(&rows).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// movements lists every signed change to the account balance in the account currency.
+// Expenses in another currency are converted at the rate of their date and are NULL when none is known.
+func (r *accountRepository) movements(account AccountEntity) *gorm.DB {
+	db := r.db.Session(&gorm.Session{NewDB: true})
+
+	expenses := db.Table("expenses e").
+		Select(fmt.Sprintf("e.date, CASE WHEN e.kind = '%s' THEN 1 ELSE -1 END * ROUND(e.amount * %s, 2) AS amount",
+			report.KindIncome, currency.RateExpr("e.currency", "e.date")), currency.RateArgs(account.Currency)...).
+		Where("e.deleted_at IS NULL").
+		Where("e.account_id = ?", account.ID)
+
+	outgoing := db.Table("transfers t").
+		Select("t.date, -t.amount AS amount").
+		Where("t.deleted_at IS NULL").
+		Where("t.from_account_id = ?", account.ID)
+
+	incoming := db.Table("transfers t").
+		Select("t.date, t.to_amount AS amount").
+		Where("t.deleted_at IS NULL").
+		Where("t.to_account_id = ?", account.ID)
+
+	return db.Raw("? UNION ALL ? UNION ALL ?", expenses, outgoing, incoming)
+}
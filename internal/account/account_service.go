@@ -0,0 +1,207 @@
+package account
+
+import (
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/report"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+)
+
+type AccountService interface {
+	GetAccounts(authUserID uint) ([]AccountBalance, error)
+	GetAccountByID(id uint, authUserID uint) (*AccountBalance, error)
+	GetBalanceHistory(id uint, authUserID uint, dto GetBalanceHistoryRequest) (*BalanceHistoryResponse, error)
+	IsAccountOwner(id uint, userID uint) (bool, error)
+	CreateAccount(authUserID uint, dto CreateAccountRequest) (*AccountEntity, error)
+	UpdateAccount(id uint, authUserID uint, dto UpdateAccountRequest) error
+	DeleteAccount(id uint, authUserID uint) error
+}
+
+type accountService struct {
+	accountRepo AccountRepository
+	userService user.UserService
+}
+
+func NewAccountService(accountRepo AccountRepository, userService user.UserService) AccountService {
+	return &accountService{
+		accountRepo: accountRepo,
+		userService: userService,
+	}
+}
+
+func (s *accountService) GetAccounts(authUserID uint) ([]AccountBalance, error) {
+	accounts, err := s.accountRepo.GetByUser(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := []AccountBalance{}
+	for _, account := range accounts {
+		balance, err := s.balanceAt(account, time.Now().Unix())
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, *balance)
+	}
+
+	return balances, nil
+}
+
+func (s *accountService) GetAccountByID(id uint, authUserID uint) (*AccountBalance, error) {
+	account, err := s.accountRepo.GetByIDAndUser(id, authUserID)
+	if err != nil {
+		return nil, apperror.ErrNotFound
+	}
+
+	return s.balanceAt(*account, time.Now().Unix())
+}
+
+// GetBalanceHistory reports the closing balance of every period with movements between from and to.
+func (s *accountService) GetBalanceHistory(id uint, authUserID uint, dto GetBalanceHistoryRequest) (*BalanceHistoryResponse, error) {
+	account, err := s.accountRepo.GetByIDAndUser(id, authUserID)
+	if err != nil {
+		return nil, apperror.ErrNotFound
+	}
+
+	loc, err := s.location(authUserID, dto.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err := report.ParseDateRange(dto.From, dto.To, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	period := report.PeriodMonth
+	if dto.Period != "" {
+		period = report.Period(dto.Period)
+	}
+
+	opening, err := s.balanceAt(*account, from.Unix()-1)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.accountRepo.SumMovementsByPeriod(*account, from.Unix(), to.Unix(), loc.String(), period)
+	if err != nil {
+		return nil, err
+	}
+
+	history := &BalanceHistoryResponse{
+		AccountID:   account.ID,
+		Currency:    account.Currency,
+		From:        dto.From,
+		To:          dto.To,
+		Timezone:    loc.String(),
+		Period:      period,
+		Opening:     opening.Balance,
+		Closing:     opening.Balance,
+		Unconverted: opening.Unconverted,
+		ByPeriod:    []BalancePeriodRow{},
+	}
+	for _, row := range rows {
+		history.Closing = history.Closing.Add(row.Total)
+		history.Unconverted += row.Unconverted
+		history.ByPeriod = append(history.ByPeriod, BalancePeriodRow{
+			Period:      row.Period,
+			Change:      row.Total,
+			Balance:     history.Closing,
+			Unconverted: row.Unconverted,
+		})
+	}
+
+	return history, nil
+}
+
+func (s *accountService) IsAccountOwner(id uint, userID uint) (bool, error) {
+	return s.accountRepo.IsOwner(id, userID)
+}
+
+func (s *accountService) CreateAccount(authUserID uint, dto CreateAccountRequest) (*AccountEntity, error) {
+	currency := dto.Currency
+	if currency == "" {
+		u, err := s.userService.GetUserByID(authUserID, authUserID)
+		if err != nil {
+			return nil, err
+		}
+		currency = u.DefaultCurrency
+	}
+
+	account := &AccountEntity{
+		UserID:         authUserID,
+		Name:           dto.Name,
+		Type:           AccountType(dto.Type),
+		Currency:       currency,
+		OpeningBalance: dto.OpeningBalance,
+	}
+	if err := s.accountRepo.Create(account); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func (s *accountService) UpdateAccount(id uint, authUserID uint, dto UpdateAccountRequest) error {
+	account, err := s.accountRepo.GetByIDAndUser(id, authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	if dto.Name != nil {
+		account.Name = *dto.Name
+	}
+
+	if dto.Type != nil {
+		account.Type = AccountType(*dto.Type)
+	}
+
+	if dto.OpeningBalance != nil {
+		account.OpeningBalance = *dto.OpeningBalance
+	}
+
+	return s.accountRepo.Update(account)
+}
+
+func (s *accountService) DeleteAccount(id uint, authUserID uint) error {
+	isOwner, err := s.accountRepo.IsOwner(id, authUserID)
+	if err != nil {
+		return err
+	}
+	if !isOwner {
+		return apperror.ErrUnauthorized
+	}
+
+	return s.accountRepo.Delete(id)
+}
+
+func (s *accountService) balanceAt(account AccountEntity, at int64) (*AccountBalance, error) {
+	movements, err := s.accountRepo.SumMovements(account, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountBalance{
+		Account:     account,
+		Balance:     account.OpeningBalance.Add(movements.Total),
+		Unconverted: movements.Unconverted,
+	}, nil
+}
+
+func (s *accountService) location(authUserID uint, timezone string) (*time.Location, error) {
+	if timezone == "" {
+		u, err := s.userService.GetUserByID(authUserID, authUserID)
+		if err != nil {
+			return nil, err
+		}
+		timezone = u.Timezone
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	return loc, nil
+}
@@ -0,0 +1,104 @@
+package account_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/account"
+	"github.com/Perajit/expense-tracker-go/internal/account/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/report"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestGetAccountByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+		matchedEntity := &account.AccountEntity{
+			Model:          gorm.Model{ID: id},
+			UserID:         userID,
+			Name:           "Wallet",
+			Type:           account.AccountTypeCash,
+			Currency:       "THB",
+			OpeningBalance: decimal.NewFromInt(1000),
+		}
+
+		mockAccountRepo := new(mocks.MockAccountRepository)
+		mockAccountRepo.On("GetByIDAndUser", id, userID).Return(matchedEntity, nil).Once()
+		mockAccountRepo.On("SumMovements", *matchedEntity, mock.AnythingOfType("int64")).
+			Return(&account.MovementTotalRow{Total: decimal.RequireFromString("-250.75"), Unconverted: 1}, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+
+		service := account.NewAccountService(mockAccountRepo, mockUserService)
+		balance, err := service.GetAccountByID(id, userID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, *matchedEntity, balance.Account)
+		assert.True(t, decimal.RequireFromString("749.25").Equal(balance.Balance))
+		assert.Equal(t, int64(1), balance.Unconverted)
+		mockAccountRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_not_found", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+
+		mockAccountRepo := new(mocks.MockAccountRepository)
+		mockAccountRepo.On("GetByIDAndUser", id, userID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+
+		service := account.NewAccountService(mockAccountRepo, mockUserService)
+		balance, err := service.GetAccountByID(id, userID)
+
+		assert.Nil(t, balance)
+		assert.Equal(t, apperror.ErrNotFound, err)
+	})
+}
+
+func TestGetBalanceHistory(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+		matchedEntity := &account.AccountEntity{
+			Model:          gorm.Model{ID: id},
+			UserID:         userID,
+			Currency:       "USD",
+			OpeningBalance: decimal.NewFromInt(100),
+		}
+		dto := account.GetBalanceHistoryRequest{From: "2026-01-01", To: "2026-03-31", Timezone: "UTC"}
+		from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+		to := time.Date(2026, 3, 31, 23, 59, 59, 0, time.UTC).Unix()
+		rows := []account.MovementPeriodRow{
+			{Period: "2026-01", Total: decimal.NewFromInt(2500)},
+			{Period: "2026-03", Total: decimal.NewFromInt(-800)},
+		}
+
+		mockAccountRepo := new(mocks.MockAccountRepository)
+		mockAccountRepo.On("GetByIDAndUser", id, userID).Return(matchedEntity, nil).Once()
+		mockAccountRepo.On("SumMovements", *matchedEntity, from-1).
+			Return(&account.MovementTotalRow{Total: decimal.NewFromInt(400)}, nil).Once()
+		mockAccountRepo.On("SumMovementsByPeriod", *matchedEntity, from, to, "UTC", report.PeriodMonth).Return(rows, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+
+		service := account.NewAccountService(mockAccountRepo, mockUserService)
+		history, err := service.GetBalanceHistory(id, userID, dto)
+
+		assert.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(500).Equal(history.Opening))
+		assert.True(t, decimal.NewFromInt(2200).Equal(history.Closing))
+		if assert.Len(t, history.ByPeriod, 2) {
+			assert.True(t, decimal.NewFromInt(3000).Equal(history.ByPeriod[0].Balance))
+			assert.True(t, decimal.NewFromInt(2200).Equal(history.ByPeriod[1].Balance))
+		}
+		mockAccountRepo.AssertExpectations(t)
+		mockUserService.AssertNotCalled(t, "GetUserByID", mock.Anything, mock.Anything)
+	})
+}
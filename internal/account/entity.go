@@ -0,0 +1,5 @@
+package account
+
+func GetModels() []any {
+	return []any{&AccountEntity{}, &TransferEntity{}}
+}
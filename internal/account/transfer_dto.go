@@ -0,0 +1,42 @@
+package account
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type CreateTransferRequest struct {
+	Date          time.Time        `json:"date" validate:"required"`
+	FromAccountID uint             `json:"fromAccountId" validate:"required"`
+	ToAccountID   uint             `json:"toAccountId" validate:"required,nefield=FromAccountID"`
+	Amount        decimal.Decimal  `json:"amount" validate:"required"`
+	ToAmount      *decimal.Decimal `json:"toAmount"`
+	Note          string           `json:"note"`
+}
+
+type GetTransfersRequest struct {
+	AccountID *uint `query:"accountId"`
+}
+
+type TransferResponse struct {
+	ID          uint            `json:"id"`
+	Date        time.Time       `json:"date"`
+	FromAccount AccountResponse `json:"fromAccount"`
+	ToAccount   AccountResponse `json:"toAccount"`
+	Amount      decimal.Decimal `json:"amount"`
+	ToAmount    decimal.Decimal `json:"toAmount"`
+	Note        string          `json:"note"`
+}
+
+func (TransferResponse) FromEntity(transfer TransferEntity) TransferResponse {
+	return TransferResponse{
+		ID:          transfer.ID,
+		Date:        time.Unix(transfer.Date, 0),
+		FromAccount: AccountResponse{}.FromEntity(transfer.FromAccount),
+		ToAccount:   AccountResponse{}.FromEntity(transfer.ToAccount),
+		Amount:      transfer.Amount,
+		ToAmount:    transfer.ToAmount,
+		Note:        transfer.Note,
+	}
+}
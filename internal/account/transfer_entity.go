@@ -0,0 +1,27 @@
+package account
+
+import (
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// TransferEntity moves money between two accounts of the same user.
+// Amount leaves the source in its currency and ToAmount arrives in the destination's.
+type TransferEntity struct {
+	gorm.Model
+	UserID        uint            `gorm:"not null;index:idx_transfers_user_date"`
+	User          user.UserEntity `gorm:"foreignKey:UserID"`
+	Date          int64           `gorm:"not null;index:idx_transfers_user_date"`
+	FromAccountID uint            `gorm:"not null;index:idx_transfers_from_account"`
+	FromAccount   AccountEntity   `gorm:"foreignKey:FromAccountID"`
+	ToAccountID   uint            `gorm:"not null;index:idx_transfers_to_account"`
+	ToAccount     AccountEntity   `gorm:"foreignKey:ToAccountID"`
+	Amount        decimal.Decimal `gorm:"type:decimal(15,2);not null"`
+	ToAmount      decimal.Decimal `gorm:"type:decimal(15,2);not null"`
+	Note          string          `gorm:"type:text"`
+}
+
+func (TransferEntity) TableName() string {
+	return "transfers"
+}
@@ -0,0 +1,132 @@
+package account
+
+import (
+	"errors"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/util"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type TransferHandler struct {
+	transferService TransferService
+	validate        *validator.Validate
+}
+
+func NewTransferHandler(transferService TransferService, validate *validator.Validate) *TransferHandler {
+	return &TransferHandler{
+		transferService: transferService,
+		validate:        validate,
+	}
+}
+
+func (h *TransferHandler) RegisterRoutes(app *fiber.App, authMiddleware fiber.Handler) {
+	group := app.Group("/transfers", authMiddleware)
+	group.Get("/", h.GetTransfers)
+	group.Get("/:id", h.GetTransferByID)
+	group.Post("/", h.CreateTransfer)
+	group.Delete("/:id", h.DeleteTransfer)
+}
+
+func (h *TransferHandler) GetTransfers(c *fiber.Ctx) error {
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractQuery[GetTransfersRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	transfers, err := h.transferService.GetTransfers(authUserID, dto)
+	if err != nil {
+		log.Error(err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	transferResponses := []TransferResponse{}
+	for _, transfer := range transfers {
+		transferResponses = append(transferResponses, TransferResponse{}.FromEntity(transfer))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(transferResponses)
+}
+
+func (h *TransferHandler) GetTransferByID(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	transfer, err := h.transferService.GetTransferByID(id, authUserID)
+	if err != nil {
+		log.Error(err)
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": apperror.ErrNotFound.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(TransferResponse{}.FromEntity(*transfer))
+}
+
+func (h *TransferHandler) CreateTransfer(c *fiber.Ctx) error {
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractDto[CreateTransferRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	transfer, err := h.transferService.CreateTransfer(authUserID, dto)
+	if err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(TransferResponse{}.FromEntity(*transfer))
+}
+
+func (h *TransferHandler) DeleteTransfer(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	if err := h.transferService.DeleteTransfer(id, authUserID); err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"status": "success"})
+}
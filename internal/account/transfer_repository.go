@@ -0,0 +1,74 @@
+package account
+
+import "gorm.io/gorm"
+
+type TransferRepository interface {
+	WithTx(tx *gorm.DB) TransferRepository
+	GetByUser(userID uint, accountID *uint) ([]TransferEntity, error)
+	GetByIDAndUser(id uint, userID uint) (*TransferEntity, error)
+	IsOwner(id uint, userID uint) (bool, error)
+	Create(transfer *TransferEntity) error
+	Delete(id uint) error
+}
+
+type transferRepository struct {
+	db *gorm.DB
+}
+
+func NewTransferRepository(db *gorm.DB) TransferRepository {
+	return &transferRepository{db: db}
+}
+
+func (r *transferRepository) WithTx(tx *gorm.DB) TransferRepository {
+	if tx == nil {
+		return r
+	}
+
+	return &transferRepository{db: tx}
+}
+
+func (r *transferRepository) GetByUser(userID uint, accountID *uint) ([]TransferEntity, error) {
+	db := r.db.Preload("FromAccount").
+		Preload("ToAccount").
+		Where("user_id = ?", userID)
+
+	if accountID != nil {
+		db = db.Where("from_account_id = ? OR to_account_id = ?", *accountID, *accountID)
+	}
+
+	var transfers []TransferEntity
+	if err := db.Order("date DESC, id DESC").Find(&transfers).Error; err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+func (r *transferRepository) GetByIDAndUser(id uint, userID uint) (*TransferEntity, error) {
+	var transfer TransferEntity
+	if err := r.db.Preload("FromAccount").
+		Preload("ToAccount").
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		First(&transfer).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+func (r *transferRepository) IsOwner(id uint, userID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&TransferEntity{}).Where("id = ?", id).Where("user_id = ?", userID).Count(&count).Error
+
+	return count > 0, err
+}
+
+func (r *transferRepository) Create(transfer *TransferEntity) error {
+	return r.db.Omit("FromAccount", "ToAccount").Create(transfer).Error
+}
+
+func (r *transferRepository) Delete(id uint) error {
+	return r.db.Delete(&TransferEntity{}, id).Error
+}
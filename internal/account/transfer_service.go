@@ -0,0 +1,99 @@
+package account
+
+import (
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"gorm.io/gorm"
+)
+
+type TransferService interface {
+	GetTransfers(authUserID uint, dto GetTransfersRequest) ([]TransferEntity, error)
+	GetTransferByID(id uint, authUserID uint) (*TransferEntity, error)
+	CreateTransfer(authUserID uint, dto CreateTransferRequest) (*TransferEntity, error)
+	DeleteTransfer(id uint, authUserID uint) error
+}
+
+type transferService struct {
+	db           *gorm.DB
+	transferRepo TransferRepository
+	accountRepo  AccountRepository
+}
+
+func NewTransferService(db *gorm.DB, transferRepo TransferRepository, accountRepo AccountRepository) TransferService {
+	return &transferService{
+		db:           db,
+		transferRepo: transferRepo,
+		accountRepo:  accountRepo,
+	}
+}
+
+func (s *transferService) GetTransfers(authUserID uint, dto GetTransfersRequest) ([]TransferEntity, error) {
+	return s.transferRepo.GetByUser(authUserID, dto.AccountID)
+}
+
+func (s *transferService) GetTransferByID(id uint, authUserID uint) (*TransferEntity, error) {
+	return s.transferRepo.GetByIDAndUser(id, authUserID)
+}
+
+// CreateTransfer needs ToAmount only when the two accounts hold different currencies.
+func (s *transferService) CreateTransfer(authUserID uint, dto CreateTransferRequest) (*TransferEntity, error) {
+	if !dto.Amount.IsPositive() || dto.FromAccountID == dto.ToAccountID {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	var transfer *TransferEntity
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		accountRepo := s.accountRepo.WithTx(tx)
+		transferRepo := s.transferRepo.WithTx(tx)
+
+		from, err := accountRepo.GetByIDAndUser(dto.FromAccountID, authUserID)
+		if err != nil {
+			return apperror.ErrNotFound
+		}
+
+		to, err := accountRepo.GetByIDAndUser(dto.ToAccountID, authUserID)
+		if err != nil {
+			return apperror.ErrNotFound
+		}
+
+		toAmount := dto.Amount
+		if dto.ToAmount != nil {
+			toAmount = *dto.ToAmount
+		} else if from.Currency != to.Currency {
+			return apperror.ErrInvalidRequest
+		}
+		if !toAmount.IsPositive() {
+			return apperror.ErrInvalidRequest
+		}
+
+		transfer = &TransferEntity{
+			UserID:        authUserID,
+			Date:          dto.Date.Unix(),
+			FromAccountID: from.ID,
+			FromAccount:   *from,
+			ToAccountID:   to.ID,
+			ToAccount:     *to,
+			Amount:        dto.Amount,
+			ToAmount:      toAmount,
+			Note:          dto.Note,
+		}
+
+		return transferRepo.Create(transfer)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+func (s *transferService) DeleteTransfer(id uint, authUserID uint) error {
+	isOwner, err := s.transferRepo.IsOwner(id, authUserID)
+	if err != nil {
+		return err
+	}
+	if !isOwner {
+		return apperror.ErrUnauthorized
+	}
+
+	return s.transferRepo.Delete(id)
+}
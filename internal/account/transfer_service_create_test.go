@@ -0,0 +1,155 @@
+package account_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/account"
+	"github.com/Perajit/expense-tracker-go/internal/account/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestCreateTransfer(t *testing.T) {
+	var userID uint = 11
+	bank := &account.AccountEntity{Model: gorm.Model{ID: 1}, UserID: userID, Name: "Bank", Currency: "USD"}
+	wallet := &account.AccountEntity{Model: gorm.Model{ID: 2}, UserID: userID, Name: "Wallet", Currency: "USD"}
+	travel := &account.AccountEntity{Model: gorm.Model{ID: 3}, UserID: userID, Name: "Travel card", Currency: "EUR"}
+
+	t.Run("success", func(t *testing.T) {
+		dto := account.CreateTransferRequest{
+			Date:          time.Now(),
+			FromAccountID: bank.ID,
+			ToAccountID:   wallet.ID,
+			Amount:        decimal.NewFromInt(200),
+			Note:          "atm",
+		}
+		var createdEntity *account.TransferEntity
+
+		db := testutil.SetupDB()
+
+		mockAccountRepo := new(mocks.MockAccountRepository)
+		mockAccountRepo.On("WithTx", mock.Anything).Return(mockAccountRepo).Once()
+		mockAccountRepo.On("GetByIDAndUser", bank.ID, userID).Return(bank, nil).Once()
+		mockAccountRepo.On("GetByIDAndUser", wallet.ID, userID).Return(wallet, nil).Once()
+
+		mockTransferRepo := new(mocks.MockTransferRepository)
+		mockTransferRepo.On("WithTx", mock.Anything).Return(mockTransferRepo).Once()
+		mockTransferRepo.On("Create", mock.MatchedBy(func(e *account.TransferEntity) bool {
+			createdEntity = e
+			return e.FromAccountID == bank.ID && e.ToAccountID == wallet.ID && e.ToAmount.Equal(dto.Amount)
+		})).Return(nil).Once()
+
+		service := account.NewTransferService(db, mockTransferRepo, mockAccountRepo)
+		transfer, err := service.CreateTransfer(userID, dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, createdEntity, transfer)
+		mockAccountRepo.AssertExpectations(t)
+		mockTransferRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_cross_currency", func(t *testing.T) {
+		toAmount := decimal.RequireFromString("91.80")
+		dto := account.CreateTransferRequest{
+			Date:          time.Now(),
+			FromAccountID: bank.ID,
+			ToAccountID:   travel.ID,
+			Amount:        decimal.NewFromInt(100),
+			ToAmount:      &toAmount,
+		}
+
+		db := testutil.SetupDB()
+
+		mockAccountRepo := new(mocks.MockAccountRepository)
+		mockAccountRepo.On("WithTx", mock.Anything).Return(mockAccountRepo).Once()
+		mockAccountRepo.On("GetByIDAndUser", bank.ID, userID).Return(bank, nil).Once()
+		mockAccountRepo.On("GetByIDAndUser", travel.ID, userID).Return(travel, nil).Once()
+
+		mockTransferRepo := new(mocks.MockTransferRepository)
+		mockTransferRepo.On("WithTx", mock.Anything).Return(mockTransferRepo).Once()
+		mockTransferRepo.On("Create", mock.MatchedBy(func(e *account.TransferEntity) bool {
+			return e.Amount.Equal(dto.Amount) && e.ToAmount.Equal(toAmount)
+		})).Return(nil).Once()
+
+		service := account.NewTransferService(db, mockTransferRepo, mockAccountRepo)
+		_, err := service.CreateTransfer(userID, dto)
+
+		assert.NoError(t, err)
+		mockTransferRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_missing_to_amount", func(t *testing.T) {
+		dto := account.CreateTransferRequest{
+			Date:          time.Now(),
+			FromAccountID: bank.ID,
+			ToAccountID:   travel.ID,
+			Amount:        decimal.NewFromInt(100),
+		}
+
+		db := testutil.SetupDB()
+
+		mockAccountRepo := new(mocks.MockAccountRepository)
+		mockAccountRepo.On("WithTx", mock.Anything).Return(mockAccountRepo).Once()
+		mockAccountRepo.On("GetByIDAndUser", bank.ID, userID).Return(bank, nil).Once()
+		mockAccountRepo.On("GetByIDAndUser", travel.ID, userID).Return(travel, nil).Once()
+
+		mockTransferRepo := new(mocks.MockTransferRepository)
+		mockTransferRepo.On("WithTx", mock.Anything).Return(mockTransferRepo).Once()
+
+		service := account.NewTransferService(db, mockTransferRepo, mockAccountRepo)
+		transfer, err := service.CreateTransfer(userID, dto)
+
+		assert.Nil(t, transfer)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockTransferRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("error_account_not_found", func(t *testing.T) {
+		dto := account.CreateTransferRequest{
+			Date:          time.Now(),
+			FromAccountID: bank.ID,
+			ToAccountID:   99,
+			Amount:        decimal.NewFromInt(100),
+		}
+
+		db := testutil.SetupDB()
+
+		mockAccountRepo := new(mocks.MockAccountRepository)
+		mockAccountRepo.On("WithTx", mock.Anything).Return(mockAccountRepo).Once()
+		mockAccountRepo.On("GetByIDAndUser", bank.ID, userID).Return(bank, nil).Once()
+		mockAccountRepo.On("GetByIDAndUser", uint(99), userID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		mockTransferRepo := new(mocks.MockTransferRepository)
+		mockTransferRepo.On("WithTx", mock.Anything).Return(mockTransferRepo).Once()
+
+		service := account.NewTransferService(db, mockTransferRepo, mockAccountRepo)
+		transfer, err := service.CreateTransfer(userID, dto)
+
+		assert.Nil(t, transfer)
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+	})
+
+	t.Run("error_same_account", func(t *testing.T) {
+		dto := account.CreateTransferRequest{
+			Date:          time.Now(),
+			FromAccountID: bank.ID,
+			ToAccountID:   bank.ID,
+			Amount:        decimal.NewFromInt(100),
+		}
+
+		mockAccountRepo := new(mocks.MockAccountRepository)
+		mockTransferRepo := new(mocks.MockTransferRepository)
+
+		service := account.NewTransferService(testutil.SetupDB(), mockTransferRepo, mockAccountRepo)
+		transfer, err := service.CreateTransfer(userID, dto)
+
+		assert.Nil(t, transfer)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockAccountRepo.AssertNotCalled(t, "GetByIDAndUser", mock.Anything, mock.Anything)
+	})
+}
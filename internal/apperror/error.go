@@ -9,9 +9,14 @@ var (
 	ErrInvalidRequest         = errors.New("invalid request")
 	ErrNotFound               = errors.New("not found")
 	ErrUnauthorized           = errors.New("unauthorized")
+	ErrForbidden              = errors.New("not allowed")
 	ErrUserDuplication        = errors.New("user already exists")
 	ErrInvalidCredentials     = errors.New("invalid username or password")
 	ErrInvalidToken           = errors.New("invalid or expired token")
 	ErrSecurityContextMissing = errors.New("security context missing")
 	ErrRecordDuplication      = errors.New("record already exists")
+	ErrConflict               = errors.New("record is still in use")
+	ErrTooLarge               = errors.New("file is too large")
+	ErrQuotaExceeded          = errors.New("storage quota exceeded")
+	ErrUnsupportedType        = errors.New("unsupported file type")
 )
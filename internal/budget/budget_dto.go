@@ -0,0 +1,76 @@
+package budget
+
+import (
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/shopspring/decimal"
+)
+
+type CreateBudgetRequest struct {
+	Name       string          `json:"name" validate:"required"`
+	Amount     decimal.Decimal `json:"amount" validate:"required"`
+	CategoryID *uint           `json:"categoryId"`
+	TagIDs     []uint          `json:"tagIds"`
+	Rollover   bool            `json:"rollover"`
+	StartMonth string          `json:"startMonth" validate:"omitempty,datetime=2006-01"`
+}
+
+type UpdateBudgetRequest struct {
+	Name       *string          `json:"name"`
+	Amount     *decimal.Decimal `json:"amount"`
+	CategoryID *uint            `json:"categoryId"`
+	TagIDs     *[]uint          `json:"tagIds"`
+	Rollover   *bool            `json:"rollover"`
+	StartMonth *string          `json:"startMonth" validate:"omitempty,datetime=2006-01"`
+}
+
+type GetProgressRequest struct {
+	Month string `query:"month" validate:"omitempty,datetime=2006-01"`
+}
+
+type BudgetResponse struct {
+	ID         uint                      `json:"id"`
+	Name       string                    `json:"name"`
+	Amount     decimal.Decimal           `json:"amount"`
+	Category   *expense.CategoryResponse `json:"category"`
+	Tags       []expense.TagResponse     `json:"tags"`
+	Rollover   bool                      `json:"rollover"`
+	StartMonth string                    `json:"startMonth"`
+}
+
+func (BudgetResponse) FromEntity(budget BudgetEntity) BudgetResponse {
+	var categoryResponse *expense.CategoryResponse
+	if budget.Category != nil {
+		category := expense.CategoryResponse{}.FromEntity(*budget.Category)
+		categoryResponse = &category
+	}
+
+	tagResponses := []expense.TagResponse{}
+	for _, tag := range budget.Tags {
+		tagResponses = append(tagResponses, expense.TagResponse{}.FromEntity(tag))
+	}
+
+	return BudgetResponse{
+		ID:         budget.ID,
+		Name:       budget.Name,
+		Amount:     budget.Amount,
+		Category:   categoryResponse,
+		Tags:       tagResponses,
+		Rollover:   budget.Rollover,
+		StartMonth: budget.StartMonth,
+	}
+}
+
+type ProgressResponse struct {
+	BudgetID    uint            `json:"budgetId"`
+	Month       string          `json:"month"`
+	Currency    string          `json:"currency"`
+	Limit       decimal.Decimal `json:"limit"`
+	CarriedOver decimal.Decimal `json:"carriedOver"`
+	Available   decimal.Decimal `json:"available"`
+	Spent       decimal.Decimal `json:"spent"`
+	Remaining   decimal.Decimal `json:"remaining"`
+	PercentUsed decimal.Decimal `json:"percentUsed"`
+	Projected   decimal.Decimal `json:"projected"`
+	DaysElapsed int             `json:"daysElapsed"`
+	DaysInMonth int             `json:"daysInMonth"`
+}
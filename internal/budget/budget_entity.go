@@ -0,0 +1,25 @@
+package budget
+
+import (
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type BudgetEntity struct {
+	gorm.Model
+	UserID     uint                    `gorm:"not null;index:idx_budgets_user"`
+	User       user.UserEntity         `gorm:"foreignKey:UserID"`
+	Name       string                  `gorm:"not null"`
+	Amount     decimal.Decimal         `gorm:"type:decimal(15,2);not null"`
+	CategoryID *uint                   `gorm:"index:idx_budgets_category"`
+	Category   *expense.CategoryEntity `gorm:"foreignKey:CategoryID"`
+	Tags       []expense.TagEntity     `gorm:"many2many:budgets_tags;"`
+	Rollover   bool                    `gorm:"default:false"`
+	StartMonth string                  `gorm:"type:char(7);not null"`
+}
+
+func (BudgetEntity) TableName() string {
+	return "budgets"
+}
@@ -0,0 +1,198 @@
+package budget
+
+import (
+	"errors"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/util"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type BudgetHandler struct {
+	budgetService BudgetService
+	validate      *validator.Validate
+}
+
+func NewBudgetHandler(budgetService BudgetService, validate *validator.Validate) *BudgetHandler {
+	return &BudgetHandler{
+		budgetService: budgetService,
+		validate:      validate,
+	}
+}
+
+func (h *BudgetHandler) RegisterRoutes(app *fiber.App, authMiddleware fiber.Handler) {
+	group := app.Group("/budgets", authMiddleware)
+	group.Get("/", h.GetBudgets)
+	group.Get("/:id", h.GetBudgetByID)
+	group.Get("/:id/progress", h.GetProgress)
+	group.Post("/", h.CreateBudget)
+	group.Patch("/:id", h.UpdateBudget)
+	group.Delete("/:id", h.DeleteBudget)
+}
+
+func (h *BudgetHandler) GetBudgets(c *fiber.Ctx) error {
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	budgets, err := h.budgetService.GetBudgets(authUserID)
+	if err != nil {
+		log.Error(err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	budgetResponses := []BudgetResponse{}
+	for _, budget := range budgets {
+		budgetResponses = append(budgetResponses, BudgetResponse{}.FromEntity(budget))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(budgetResponses)
+}
+
+func (h *BudgetHandler) GetBudgetByID(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	budget, err := h.budgetService.GetBudgetByID(id, authUserID)
+	if err != nil {
+		log.Error(err)
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": apperror.ErrNotFound.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(BudgetResponse{}.FromEntity(*budget))
+}
+
+func (h *BudgetHandler) GetProgress(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractQuery[GetProgressRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	progress, err := h.budgetService.GetProgress(id, authUserID, dto)
+	if err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(progress)
+}
+
+func (h *BudgetHandler) CreateBudget(c *fiber.Ctx) error {
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractDto[CreateBudgetRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	budget, err := h.budgetService.CreateBudget(authUserID, dto)
+	if err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(BudgetResponse{}.FromEntity(*budget))
+}
+
+func (h *BudgetHandler) UpdateBudget(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractDto[UpdateBudgetRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	if err := h.budgetService.UpdateBudget(id, authUserID, dto); err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"status": "success"})
+}
+
+func (h *BudgetHandler) DeleteBudget(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	if err := h.budgetService.DeleteBudget(id, authUserID); err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"status": "success"})
+}
@@ -0,0 +1,84 @@
+package budget
+
+import (
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"gorm.io/gorm"
+)
+
+type BudgetRepository interface {
+	WithTx(tx *gorm.DB) BudgetRepository
+	GetByUser(userID uint) ([]BudgetEntity, error)
+	GetByIDAndUser(id uint, userID uint) (*BudgetEntity, error)
+	IsOwner(id uint, userID uint) (bool, error)
+	Create(budget *BudgetEntity) error
+	Update(budget *BudgetEntity) error
+	UpdateTags(budget *BudgetEntity, tags []expense.TagEntity) error
+	Delete(id uint) error
+}
+
+type budgetRepository struct {
+	db *gorm.DB
+}
+
+func NewBudgetRepository(db *gorm.DB) BudgetRepository {
+	return &budgetRepository{db: db}
+}
+
+func (r *budgetRepository) WithTx(tx *gorm.DB) BudgetRepository {
+	if tx == nil {
+		return r
+	}
+
+	return &budgetRepository{db: tx}
+}
+
+func (r *budgetRepository) GetByUser(userID uint) ([]BudgetEntity, error) {
+	var budgets []BudgetEntity
+	if err := r.db.Preload("Category").
+		Preload("Tags").
+		Where("user_id = ?", userID).
+		Order("id").
+		Find(&budgets).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return budgets, nil
+}
+
+func (r *budgetRepository) GetByIDAndUser(id uint, userID uint) (*BudgetEntity, error) {
+	var budget BudgetEntity
+	if err := r.db.Preload("Category").
+		Preload("Tags").
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		First(&budget).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &budget, nil
+}
+
+func (r *budgetRepository) IsOwner(id uint, userID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&BudgetEntity{}).Where("id = ?", id).Where("user_id = ?", userID).Count(&count).Error
+
+	return count > 0, err
+}
+
+func (r *budgetRepository) Create(budget *BudgetEntity) error {
+	return r.db.Create(budget).Error
+}
+
+func (r *budgetRepository) Update(budget *BudgetEntity) error {
+	return r.db.Omit("Category", "Tags").Save(budget).Error
+}
+
+func (r *budgetRepository) UpdateTags(budget *BudgetEntity, tags []expense.TagEntity) error {
+	return r.db.Model(budget).Association("Tags").Replace(tags)
+}
+
+func (r *budgetRepository) Delete(id uint) error {
+	return r.db.Delete(&BudgetEntity{}, id).Error
+}
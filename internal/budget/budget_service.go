@@ -0,0 +1,316 @@
+package budget
+
+import (
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/report"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+const monthLayout = "2006-01"
+
+type BudgetService interface {
+	GetBudgets(authUserID uint) ([]BudgetEntity, error)
+	GetBudgetByID(id uint, authUserID uint) (*BudgetEntity, error)
+	CreateBudget(authUserID uint, dto CreateBudgetRequest) (*BudgetEntity, error)
+	UpdateBudget(id uint, authUserID uint, dto UpdateBudgetRequest) error
+	DeleteBudget(id uint, authUserID uint) error
+	GetProgress(id uint, authUserID uint, dto GetProgressRequest) (*ProgressResponse, error)
+}
+
+type budgetService struct {
+	db              *gorm.DB
+	budgetRepo      BudgetRepository
+	reportRepo      report.ReportRepository
+	categoryService expense.CategoryService
+	tagService      expense.TagService
+	userService     user.UserService
+}
+
+func NewBudgetService(
+	db *gorm.DB,
+	budgetRepo BudgetRepository,
+	reportRepo report.ReportRepository,
+	categoryService expense.CategoryService,
+	tagService expense.TagService,
+	userService user.UserService,
+) BudgetService {
+	return &budgetService{
+		db:              db,
+		budgetRepo:      budgetRepo,
+		reportRepo:      reportRepo,
+		categoryService: categoryService,
+		tagService:      tagService,
+		userService:     userService,
+	}
+}
+
+func (s *budgetService) GetBudgets(authUserID uint) ([]BudgetEntity, error) {
+	return s.budgetRepo.GetByUser(authUserID)
+}
+
+func (s *budgetService) GetBudgetByID(id uint, authUserID uint) (*BudgetEntity, error) {
+	return s.budgetRepo.GetByIDAndUser(id, authUserID)
+}
+
+func (s *budgetService) CreateBudget(authUserID uint, dto CreateBudgetRequest) (*BudgetEntity, error) {
+	if !dto.Amount.IsPositive() {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	if dto.CategoryID != nil {
+		if err := s.checkCategory(*dto.CategoryID, authUserID); err != nil {
+			return nil, err
+		}
+	}
+
+	tags, err := s.getTags(dto.TagIDs, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	startMonth := dto.StartMonth
+	if startMonth == "" {
+		loc, _, err := s.settings(authUserID)
+		if err != nil {
+			return nil, err
+		}
+		startMonth = time.Now().In(loc).Format(monthLayout)
+	}
+
+	budget := &BudgetEntity{
+		UserID:     authUserID,
+		Name:       dto.Name,
+		Amount:     dto.Amount,
+		CategoryID: dto.CategoryID,
+		Tags:       tags,
+		Rollover:   dto.Rollover,
+		StartMonth: startMonth,
+	}
+	if err := s.budgetRepo.Create(budget); err != nil {
+		return nil, err
+	}
+
+	return budget, nil
+}
+
+func (s *budgetService) UpdateBudget(id uint, authUserID uint, dto UpdateBudgetRequest) error {
+	budget, err := s.budgetRepo.GetByIDAndUser(id, authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	if dto.Name != nil {
+		budget.Name = *dto.Name
+	}
+
+	if dto.Amount != nil {
+		if !dto.Amount.IsPositive() {
+			return apperror.ErrInvalidRequest
+		}
+		budget.Amount = *dto.Amount
+	}
+
+	if dto.CategoryID != nil {
+		if *dto.CategoryID == 0 {
+			budget.CategoryID = nil
+		} else {
+			if err := s.checkCategory(*dto.CategoryID, authUserID); err != nil {
+				return err
+			}
+			budget.CategoryID = dto.CategoryID
+		}
+		budget.Category = nil
+	}
+
+	if dto.TagIDs != nil {
+		tags, err := s.getTags(*dto.TagIDs, authUserID)
+		if err != nil {
+			return err
+		}
+		budget.Tags = tags
+	}
+
+	if dto.Rollover != nil {
+		budget.Rollover = *dto.Rollover
+	}
+
+	if dto.StartMonth != nil {
+		budget.StartMonth = *dto.StartMonth
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		budgetRepo := s.budgetRepo.WithTx(tx)
+
+		if err := budgetRepo.Update(budget); err != nil {
+			return err
+		}
+
+		if err := budgetRepo.UpdateTags(budget, budget.Tags); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (s *budgetService) DeleteBudget(id uint, authUserID uint) error {
+	isOwner, err := s.budgetRepo.IsOwner(id, authUserID)
+	if err != nil {
+		return err
+	}
+	if !isOwner {
+		return apperror.ErrUnauthorized
+	}
+
+	return s.budgetRepo.Delete(id)
+}
+
+func (s *budgetService) GetProgress(id uint, authUserID uint, dto GetProgressRequest) (*ProgressResponse, error) {
+	budget, err := s.budgetRepo.GetByIDAndUser(id, authUserID)
+	if err != nil {
+		return nil, apperror.ErrNotFound
+	}
+
+	loc, currency, err := s.settings(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().In(loc)
+	month := now.Format(monthLayout)
+	if dto.Month != "" {
+		month = dto.Month
+	}
+
+	monthStart, err := time.ParseInLocation(monthLayout, month, loc)
+	if err != nil {
+		return nil, apperror.ErrInvalidRequest
+	}
+	monthEnd := monthStart.AddDate(0, 1, 0)
+
+	// rollover needs every month since the budget started, otherwise only the requested one
+	historyStart := monthStart
+	if budget.Rollover {
+		if start, err := time.ParseInLocation(monthLayout, budget.StartMonth, loc); err == nil && start.Before(monthStart) {
+			historyStart = start
+		}
+	}
+
+	filter := report.ReportFilter{
+		UserID:   authUserID,
+		From:     historyStart.Unix(),
+		To:       monthEnd.Unix() - 1,
+		Timezone: loc.String(),
+		Currency: currency,
+		Kind:     report.KindExpense,
+	}
+	if budget.CategoryID != nil {
+		filter.CategoryIDs = []uint{*budget.CategoryID}
+	}
+	for _, tag := range budget.Tags {
+		filter.TagIDs = append(filter.TagIDs, tag.ID)
+	}
+
+	rows, err := s.reportRepo.SumByPeriod(filter, report.PeriodMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	spentByMonth := map[string]decimal.Decimal{}
+	for _, row := range rows {
+		spentByMonth[row.Period] = row.Total
+	}
+
+	carried := decimal.Zero
+	for m := historyStart; m.Before(monthStart); m = m.AddDate(0, 1, 0) {
+		left := budget.Amount.Add(carried).Sub(spentByMonth[m.Format(monthLayout)])
+		carried = decimal.Max(left, decimal.Zero)
+	}
+
+	available := budget.Amount.Add(carried)
+	spent := spentByMonth[month]
+	daysInMonth := monthEnd.AddDate(0, 0, -1).Day()
+
+	daysElapsed := 0
+	switch {
+	case !now.Before(monthEnd):
+		daysElapsed = daysInMonth
+	case !now.Before(monthStart):
+		daysElapsed = now.Day()
+	}
+
+	projected := spent
+	if daysElapsed > 0 && daysElapsed < daysInMonth {
+		projected = spent.Div(decimal.NewFromInt(int64(daysElapsed))).
+			Mul(decimal.NewFromInt(int64(daysInMonth))).
+			Round(2)
+	}
+
+	percentUsed := decimal.Zero
+	if available.IsPositive() {
+		percentUsed = spent.Div(available).Mul(decimal.NewFromInt(100)).Round(2)
+	}
+
+	return &ProgressResponse{
+		BudgetID:    budget.ID,
+		Month:       month,
+		Currency:    currency,
+		Limit:       budget.Amount,
+		CarriedOver: carried,
+		Available:   available,
+		Spent:       spent,
+		Remaining:   available.Sub(spent),
+		PercentUsed: percentUsed,
+		Projected:   projected,
+		DaysElapsed: daysElapsed,
+		DaysInMonth: daysInMonth,
+	}, nil
+}
+
+func (s *budgetService) checkCategory(categoryID uint, authUserID uint) error {
+	isOwner, err := s.categoryService.IsCategoryOwner(categoryID, authUserID)
+	if err != nil {
+		return err
+	}
+	if !isOwner {
+		return apperror.ErrUnauthorized
+	}
+
+	return nil
+}
+
+func (s *budgetService) getTags(ids []uint, authUserID uint) ([]expense.TagEntity, error) {
+	if len(ids) == 0 {
+		return []expense.TagEntity{}, nil
+	}
+
+	tags, err := s.tagService.GetTagsByIDs(ids, authUserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) != len(ids) {
+		return nil, apperror.ErrUnauthorized
+	}
+
+	return tags, nil
+}
+
+// settings returns the user's timezone and the currency budgets are tracked in.
+func (s *budgetService) settings(authUserID uint) (*time.Location, string, error) {
+	u, err := s.userService.GetUserByID(authUserID, authUserID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	loc, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		return time.UTC, u.DefaultCurrency, nil
+	}
+
+	return loc, u.DefaultCurrency, nil
+}
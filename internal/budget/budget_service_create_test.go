@@ -0,0 +1,98 @@
+package budget_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/budget"
+	budgetMocks "github.com/Perajit/expense-tracker-go/internal/budget/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	expenseMocks "github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	reportMocks "github.com/Perajit/expense-tracker-go/internal/report/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestCreateBudget(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var userID uint = 11
+		var categoryID uint = 2
+		tags := []expense.TagEntity{
+			{Model: gorm.Model{ID: 3}, UserID: userID, Name: "tag3"},
+		}
+		dto := budget.CreateBudgetRequest{
+			Name:       "Food",
+			Amount:     decimal.NewFromInt(500),
+			CategoryID: &categoryID,
+			TagIDs:     []uint{3},
+			Rollover:   true,
+			StartMonth: "2026-01",
+		}
+		var createdEntity *budget.BudgetEntity
+
+		db := testutil.SetupDB()
+
+		mockBudgetRepo := new(budgetMocks.MockBudgetRepository)
+		mockBudgetRepo.On("Create", mock.MatchedBy(func(e *budget.BudgetEntity) bool {
+			if e.UserID != userID || e.Name != dto.Name || !e.Amount.Equal(dto.Amount) || e.CategoryID != dto.CategoryID {
+				return false
+			}
+			if !e.Rollover || e.StartMonth != dto.StartMonth || !slices.Equal(e.Tags, tags) {
+				return false
+			}
+			createdEntity = e
+			return true
+		})).Return(nil).Once()
+
+		mockReportRepo := new(reportMocks.MockReportRepository)
+
+		mockCategoryService := new(expenseMocks.MockCategoryService)
+		mockCategoryService.On("IsCategoryOwner", categoryID, userID).Return(true, nil).Once()
+
+		mockTagService := new(expenseMocks.MockTagService)
+		mockTagService.On("GetTagsByIDs", dto.TagIDs, userID).Return(tags, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+
+		service := budget.NewBudgetService(db, mockBudgetRepo, mockReportRepo, mockCategoryService, mockTagService, mockUserService)
+		entity, err := service.CreateBudget(userID, dto)
+
+		assert.Equal(t, createdEntity, entity)
+		assert.NoError(t, err)
+		mockBudgetRepo.AssertExpectations(t)
+		mockCategoryService.AssertExpectations(t)
+	})
+
+	t.Run("error_non_positive_amount", func(t *testing.T) {
+		var userID uint = 11
+		dto := budget.CreateBudgetRequest{
+			Name:       "Overall",
+			Amount:     decimal.NewFromInt(-5),
+			StartMonth: "2026-01",
+		}
+
+		db := testutil.SetupDB()
+
+		mockBudgetRepo := new(budgetMocks.MockBudgetRepository)
+
+		mockReportRepo := new(reportMocks.MockReportRepository)
+
+		mockCategoryService := new(expenseMocks.MockCategoryService)
+
+		mockTagService := new(expenseMocks.MockTagService)
+
+		mockUserService := new(userMocks.MockUserService)
+
+		service := budget.NewBudgetService(db, mockBudgetRepo, mockReportRepo, mockCategoryService, mockTagService, mockUserService)
+		entity, err := service.CreateBudget(userID, dto)
+
+		assert.Nil(t, entity)
+		assert.Equal(t, apperror.ErrInvalidRequest, err)
+		mockBudgetRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
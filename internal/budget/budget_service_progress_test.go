@@ -0,0 +1,85 @@
+package budget_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/budget"
+	budgetMocks "github.com/Perajit/expense-tracker-go/internal/budget/mocks"
+	expenseMocks "github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/report"
+	reportMocks "github.com/Perajit/expense-tracker-go/internal/report/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestGetProgress(t *testing.T) {
+	t.Run("success_rollover", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+		var categoryID uint = 2
+		matchedEntity := &budget.BudgetEntity{
+			Model:      gorm.Model{ID: id},
+			UserID:     userID,
+			Name:       "Food",
+			Amount:     decimal.NewFromInt(100),
+			CategoryID: &categoryID,
+			Rollover:   true,
+			StartMonth: "2025-01",
+		}
+		filter := report.ReportFilter{
+			UserID:      userID,
+			From:        time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).Unix(),
+			To:          time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC).Unix() - 1,
+			Timezone:    "UTC",
+			Currency:    "EUR",
+			Kind:        report.KindExpense,
+			CategoryIDs: []uint{categoryID},
+		}
+		rows := []report.PeriodTotalRow{
+			{Period: "2025-01", Total: decimal.NewFromInt(60), Count: 2},
+			{Period: "2025-02", Total: decimal.NewFromInt(120), Count: 3},
+			{Period: "2025-03", Total: decimal.NewFromInt(30), Count: 1},
+		}
+
+		db := testutil.SetupDB()
+
+		mockBudgetRepo := new(budgetMocks.MockBudgetRepository)
+		mockBudgetRepo.On("GetByIDAndUser", id, userID).Return(matchedEntity, nil).Once()
+
+		mockReportRepo := new(reportMocks.MockReportRepository)
+		mockReportRepo.On("SumByPeriod", filter, report.PeriodMonth).Return(rows, nil).Once()
+
+		mockCategoryService := new(expenseMocks.MockCategoryService)
+
+		mockTagService := new(expenseMocks.MockTagService)
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(&user.UserEntity{
+			Model:           gorm.Model{ID: userID},
+			Timezone:        "UTC",
+			DefaultCurrency: "EUR",
+		}, nil).Once()
+
+		service := budget.NewBudgetService(db, mockBudgetRepo, mockReportRepo, mockCategoryService, mockTagService, mockUserService)
+		progress, err := service.GetProgress(id, userID, budget.GetProgressRequest{Month: "2025-03"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "2025-03", progress.Month)
+		assert.Equal(t, "EUR", progress.Currency)
+		assert.True(t, decimal.NewFromInt(20).Equal(progress.CarriedOver))
+		assert.True(t, decimal.NewFromInt(120).Equal(progress.Available))
+		assert.True(t, decimal.NewFromInt(30).Equal(progress.Spent))
+		assert.True(t, decimal.NewFromInt(90).Equal(progress.Remaining))
+		assert.True(t, decimal.NewFromInt(25).Equal(progress.PercentUsed))
+		assert.True(t, decimal.NewFromInt(30).Equal(progress.Projected))
+		assert.Equal(t, 31, progress.DaysElapsed)
+		assert.Equal(t, 31, progress.DaysInMonth)
+		mockBudgetRepo.AssertExpectations(t)
+		mockReportRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,5 @@
+package budget
+
+func GetModels() []any {
+	return []any{&BudgetEntity{}}
+}
@@ -0,0 +1,37 @@
+package currency
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const DefaultCode = "USD"
+
+var codeValidator = validator.New()
+
+// Normalize upper-cases an ISO-4217 code and reports whether it is a known currency.
+func Normalize(code string) (string, bool) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if codeValidator.Var(code, "iso4217") != nil {
+		return "", false
+	}
+
+	return code, true
+}
+
+// RateExpr is a SQL expression for the rate converting an amount in fromCol, dated dateCol, into a target currency.
+// A stored rate is used directly, inverted, or crossed through a common base, always the latest one on or before
+// the date. It is NULL when no rate is known. Bind it with RateArgs.
+func RateExpr(fromCol string, dateCol string) string {
+	return fmt.Sprintf(`(CASE WHEN %[1]s = ? THEN 1 ELSE COALESCE(
+(SELECT r.rate FROM exchange_rates r WHERE r.base = %[1]s AND r.quote = ? AND r.effective_date <= %[2]s ORDER BY r.effective_date DESC LIMIT 1),
+(SELECT 1 / r.rate FROM exchange_rates r WHERE r.base = ? AND r.quote = %[1]s AND r.effective_date <= %[2]s ORDER BY r.effective_date DESC LIMIT 1),
+(SELECT b.rate / a.rate FROM exchange_rates a JOIN exchange_rates b ON b.base = a.base AND b.effective_date = a.effective_date
+WHERE a.quote = %[1]s AND b.quote = ? AND a.effective_date <= %[2]s ORDER BY a.effective_date DESC LIMIT 1)) END)`, fromCol, dateCol)
+}
+
+func RateArgs(target string) []any {
+	return []any{target, target, target, target}
+}
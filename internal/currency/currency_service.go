@@ -0,0 +1,45 @@
+package currency
+
+import (
+	"io"
+	"time"
+)
+
+type CurrencyService interface {
+	ImportRates(r io.Reader, format FileFormat) (int, error)
+	SyncRates(provider RateProvider, base string, date time.Time) (int, error)
+}
+
+type currencyService struct {
+	rateRepo ExchangeRateRepository
+}
+
+func NewCurrencyService(rateRepo ExchangeRateRepository) CurrencyService {
+	return &currencyService{rateRepo: rateRepo}
+}
+
+func (s *currencyService) ImportRates(r io.Reader, format FileFormat) (int, error) {
+	rates, err := LoadRates(r, format)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.rateRepo.Upsert(rates); err != nil {
+		return 0, err
+	}
+
+	return len(rates), nil
+}
+
+func (s *currencyService) SyncRates(provider RateProvider, base string, date time.Time) (int, error) {
+	rates, err := provider.FetchRates(base, date)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.rateRepo.Upsert(rates); err != nil {
+		return 0, err
+	}
+
+	return len(rates), nil
+}
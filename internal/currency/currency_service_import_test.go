@@ -0,0 +1,80 @@
+package currency_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/currency"
+	"github.com/Perajit/expense-tracker-go/internal/currency/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestImportRates(t *testing.T) {
+	effective := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC).Unix()
+	expected := []currency.ExchangeRateEntity{
+		{Base: "USD", Quote: "EUR", EffectiveDate: effective, Rate: decimal.RequireFromString("0.9712")},
+		{Base: "EUR", Quote: "THB", EffectiveDate: effective, Rate: decimal.RequireFromString("35.1")},
+	}
+
+	t.Run("success_csv", func(t *testing.T) {
+		file := "date,base,quote,rate\n" +
+			"2025-01-03,USD,EUR,0.9712\n" +
+			"2025-01-03,eur,thb,35.1\n"
+
+		mockRateRepo := new(mocks.MockExchangeRateRepository)
+		mockRateRepo.On("Upsert", expected).Return(nil).Once()
+
+		service := currency.NewCurrencyService(mockRateRepo)
+		count, err := service.ImportRates(strings.NewReader(file), currency.FileFormatCSV)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+		mockRateRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_json", func(t *testing.T) {
+		file := `[
+			{"date": "2025-01-03", "base": "USD", "quote": "EUR", "rate": "0.9712"},
+			{"date": "2025-01-03", "base": "EUR", "quote": "THB", "rate": 35.1}
+		]`
+
+		mockRateRepo := new(mocks.MockExchangeRateRepository)
+		mockRateRepo.On("Upsert", expected).Return(nil).Once()
+
+		service := currency.NewCurrencyService(mockRateRepo)
+		count, err := service.ImportRates(strings.NewReader(file), currency.FileFormatJSON)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+		mockRateRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_invalid_currency", func(t *testing.T) {
+		file := "date,base,quote,rate\n2025-01-03,USD,ABC,1.5\n"
+
+		mockRateRepo := new(mocks.MockExchangeRateRepository)
+
+		service := currency.NewCurrencyService(mockRateRepo)
+		count, err := service.ImportRates(strings.NewReader(file), currency.FileFormatCSV)
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		assert.Equal(t, 0, count)
+		mockRateRepo.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+
+	t.Run("error_non_positive_rate", func(t *testing.T) {
+		file := `[{"date": "2025-01-03", "base": "USD", "quote": "EUR", "rate": 0}]`
+
+		mockRateRepo := new(mocks.MockExchangeRateRepository)
+
+		service := currency.NewCurrencyService(mockRateRepo)
+		count, err := service.ImportRates(strings.NewReader(file), currency.FileFormatJSON)
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		assert.Equal(t, 0, count)
+	})
+}
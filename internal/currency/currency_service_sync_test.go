@@ -0,0 +1,99 @@
+package currency_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/currency"
+	"github.com/Perajit/expense-tracker-go/internal/currency/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSyncRates(t *testing.T) {
+	t.Run("success_http_provider", func(t *testing.T) {
+		var requested string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requested = r.URL.String()
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"amount":1.0,"base":"USD","date":"2025-01-03","rates":{"EUR":0.9712,"THB":34.25,"XXX1":2}}`))
+		}))
+		defer server.Close()
+
+		effective := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC).Unix()
+		var upserted []currency.ExchangeRateEntity
+
+		mockRateRepo := new(mocks.MockExchangeRateRepository)
+		mockRateRepo.On("Upsert", mock.Anything).Run(func(args mock.Arguments) {
+			upserted = args.Get(0).([]currency.ExchangeRateEntity)
+		}).Return(nil).Once()
+
+		service := currency.NewCurrencyService(mockRateRepo)
+		count, err := service.SyncRates(currency.NewHTTPProvider(server.URL), "USD", time.Date(2025, 1, 4, 15, 0, 0, 0, time.UTC))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.Equal(t, "/2025-01-04?from=USD", requested)
+		assert.ElementsMatch(t, []currency.ExchangeRateEntity{
+			{Base: "USD", Quote: "EUR", EffectiveDate: effective, Rate: decimal.RequireFromString("0.9712")},
+			{Base: "USD", Quote: "THB", EffectiveDate: effective, Rate: decimal.RequireFromString("34.25")},
+		}, upserted)
+		mockRateRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_static_provider", func(t *testing.T) {
+		day := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+		rates := []currency.ExchangeRateEntity{
+			{Base: "USD", Quote: "EUR", EffectiveDate: day.Unix(), Rate: decimal.RequireFromString("0.97")},
+			{Base: "USD", Quote: "EUR", EffectiveDate: day.AddDate(0, 0, 1).Unix(), Rate: decimal.RequireFromString("0.98")},
+			{Base: "EUR", Quote: "USD", EffectiveDate: day.Unix(), Rate: decimal.RequireFromString("1.03")},
+		}
+
+		mockRateRepo := new(mocks.MockExchangeRateRepository)
+		mockRateRepo.On("Upsert", rates[:1]).Return(nil).Once()
+
+		service := currency.NewCurrencyService(mockRateRepo)
+		count, err := service.SyncRates(currency.StaticProvider{Rates: rates}, "USD", day.Add(10*time.Hour))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+		mockRateRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_provider_status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		mockRateRepo := new(mocks.MockExchangeRateRepository)
+
+		service := currency.NewCurrencyService(mockRateRepo)
+		count, err := service.SyncRates(currency.NewHTTPProvider(server.URL), "USD", time.Now())
+
+		assert.Error(t, err)
+		assert.Equal(t, 0, count)
+		mockRateRepo.AssertNotCalled(t, "Upsert", mock.Anything)
+	})
+
+	t.Run("error_upsert", func(t *testing.T) {
+		expectedErr := errors.New("db error")
+		day := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+		rates := []currency.ExchangeRateEntity{
+			{Base: "USD", Quote: "EUR", EffectiveDate: day.Unix(), Rate: decimal.RequireFromString("0.97")},
+		}
+
+		mockRateRepo := new(mocks.MockExchangeRateRepository)
+		mockRateRepo.On("Upsert", rates).Return(expectedErr).Once()
+
+		service := currency.NewCurrencyService(mockRateRepo)
+		count, err := service.SyncRates(currency.StaticProvider{Rates: rates}, "USD", day)
+
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 0, count)
+	})
+}
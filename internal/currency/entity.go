@@ -0,0 +1,5 @@
+package currency
+
+func GetModels() []any {
+	return []any{&ExchangeRateEntity{}}
+}
@@ -0,0 +1,22 @@
+package currency
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRateEntity says one unit of Base buys Rate units of Quote from EffectiveDate until a newer rate exists.
+type ExchangeRateEntity struct {
+	ID            uint            `gorm:"primaryKey"`
+	Base          string          `gorm:"type:char(3);not null;uniqueIndex:idx_exchange_rates_pair_date"`
+	Quote         string          `gorm:"type:char(3);not null;uniqueIndex:idx_exchange_rates_pair_date"`
+	EffectiveDate int64           `gorm:"not null;uniqueIndex:idx_exchange_rates_pair_date"`
+	Rate          decimal.Decimal `gorm:"type:decimal(20,10);not null"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (ExchangeRateEntity) TableName() string {
+	return "exchange_rates"
+}
@@ -0,0 +1,133 @@
+package currency
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/shopspring/decimal"
+)
+
+type FileFormat string
+
+const (
+	FileFormatCSV  FileFormat = "csv"
+	FileFormatJSON FileFormat = "json"
+)
+
+type rateRecord struct {
+	Date  string          `json:"date"`
+	Base  string          `json:"base"`
+	Quote string          `json:"quote"`
+	Rate  decimal.Decimal `json:"rate"`
+}
+
+// LoadRates reads rates from a CSV with a date,base,quote,rate header or a JSON array of the same fields.
+func LoadRates(r io.Reader, format FileFormat) ([]ExchangeRateEntity, error) {
+	var records []rateRecord
+
+	switch format {
+	case FileFormatJSON:
+		if err := json.NewDecoder(r).Decode(&records); err != nil {
+			return nil, fmt.Errorf("%w: %v", apperror.ErrInvalidRequest, err)
+		}
+	case FileFormatCSV:
+		parsed, err := readCSVRates(r)
+		if err != nil {
+			return nil, err
+		}
+		records = parsed
+	default:
+		return nil, fmt.Errorf("%w: unsupported rate file format %q", apperror.ErrInvalidRequest, format)
+	}
+
+	rates := []ExchangeRateEntity{}
+	for i, record := range records {
+		rate, err := record.toEntity()
+		if err != nil {
+			return nil, fmt.Errorf("%w: rate %d: %v", apperror.ErrInvalidRequest, i+1, err)
+		}
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
+
+func readCSVRates(r io.Reader) ([]rateRecord, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: could not read header: %v", apperror.ErrInvalidRequest, err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"date", "base", "quote", "rate"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: column %q not found", apperror.ErrInvalidRequest, name)
+		}
+	}
+
+	records := []rateRecord{}
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", apperror.ErrInvalidRequest, err)
+		}
+
+		rate, err := decimal.NewFromString(strings.TrimSpace(fields[columns["rate"]]))
+		if err != nil {
+			line, _ := reader.FieldPos(0)
+			return nil, fmt.Errorf("%w: line %d: invalid rate", apperror.ErrInvalidRequest, line)
+		}
+
+		records = append(records, rateRecord{
+			Date:  strings.TrimSpace(fields[columns["date"]]),
+			Base:  fields[columns["base"]],
+			Quote: fields[columns["quote"]],
+			Rate:  rate,
+		})
+	}
+
+	return records, nil
+}
+
+func (r rateRecord) toEntity() (ExchangeRateEntity, error) {
+	date, err := time.ParseInLocation(time.DateOnly, r.Date, time.UTC)
+	if err != nil {
+		return ExchangeRateEntity{}, fmt.Errorf("invalid date %q", r.Date)
+	}
+
+	base, ok := Normalize(r.Base)
+	if !ok {
+		return ExchangeRateEntity{}, fmt.Errorf("invalid currency %q", r.Base)
+	}
+
+	quote, ok := Normalize(r.Quote)
+	if !ok {
+		return ExchangeRateEntity{}, fmt.Errorf("invalid currency %q", r.Quote)
+	}
+
+	if !r.Rate.IsPositive() {
+		return ExchangeRateEntity{}, errors.New("rate must be positive")
+	}
+
+	return ExchangeRateEntity{
+		Base:          base,
+		Quote:         quote,
+		EffectiveDate: date.Unix(),
+		Rate:          r.Rate,
+	}, nil
+}
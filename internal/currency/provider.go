@@ -0,0 +1,94 @@
+package currency
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// RateProvider is a source of daily rates from one base currency.
+type RateProvider interface {
+	FetchRates(base string, date time.Time) ([]ExchangeRateEntity, error)
+}
+
+// StaticProvider serves a fixed set of rates, e.g. loaded with LoadRates, as if it were a remote source.
+type StaticProvider struct {
+	Rates []ExchangeRateEntity
+}
+
+func (p StaticProvider) FetchRates(base string, date time.Time) ([]ExchangeRateEntity, error) {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC).Unix()
+
+	rates := []ExchangeRateEntity{}
+	for _, rate := range p.Rates {
+		if rate.Base == base && rate.EffectiveDate == day {
+			rates = append(rates, rate)
+		}
+	}
+
+	return rates, nil
+}
+
+// HTTPProvider reads rates from a Frankfurter-compatible API: GET {BaseURL}/{date}?from={base}.
+type HTTPProvider struct {
+	BaseURL string
+	Client  *http.Client
+}
+
+type httpRatesResponse struct {
+	Base  string                     `json:"base"`
+	Date  string                     `json:"date"`
+	Rates map[string]decimal.Decimal `json:"rates"`
+}
+
+func NewHTTPProvider(baseURL string) *HTTPProvider {
+	return &HTTPProvider{
+		BaseURL: baseURL,
+		Client:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (p *HTTPProvider) FetchRates(base string, date time.Time) ([]ExchangeRateEntity, error) {
+	endpoint := fmt.Sprintf("%s/%s?from=%s", p.BaseURL, date.Format(time.DateOnly), url.QueryEscape(base))
+
+	res, err := p.Client.Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("rate provider returned %s", res.Status)
+	}
+
+	var body httpRatesResponse
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	// the provider may answer with the last business day, which is the date the rates are effective from
+	effective, err := time.ParseInLocation(time.DateOnly, body.Date, time.UTC)
+	if err != nil {
+		return nil, fmt.Errorf("rate provider returned invalid date %q", body.Date)
+	}
+
+	rates := []ExchangeRateEntity{}
+	for quote, rate := range body.Rates {
+		code, ok := Normalize(quote)
+		if !ok || !rate.IsPositive() {
+			continue
+		}
+		rates = append(rates, ExchangeRateEntity{
+			Base:          base,
+			Quote:         code,
+			EffectiveDate: effective.Unix(),
+			Rate:          rate,
+		})
+	}
+
+	return rates, nil
+}
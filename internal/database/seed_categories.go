@@ -34,7 +34,7 @@ func insertCategory(db *gorm.DB, data CategorySeed) error {
 	var u expense.CategoryEntity
 
 	// // check duplication
-	err := db.Where("user_id = ?", data.UserID).Where("name = ?", data.Name).First(&u).Error
+	err := db.Where("user_id = ?", data.UserID).Where("parent_id = ?", 0).Where("name = ?", data.Name).First(&u).Error
 	if err != gorm.ErrRecordNotFound {
 		return fmt.Errorf("Skip category: category [%s] already exists for user [%d]", data.Name, data.UserID)
 	}
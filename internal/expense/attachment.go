@@ -0,0 +1,112 @@
+package expense
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"net/http"
+	"time"
+)
+
+const (
+	DefaultAttachmentMaxSize = 10 << 20
+	DefaultAttachmentQuota   = 200 << 20
+	DefaultAttachmentURLTTL  = 15 * time.Minute
+
+	// thumbnailSize bounds the longer side of a thumbnail, in pixels.
+	thumbnailSize = 320
+	// thumbnailMaxPixels bounds the source image, as decoding holds every pixel in memory.
+	thumbnailMaxPixels = 25_000_000
+)
+
+type AttachmentConfig struct {
+	MaxSize   int64
+	UserQuota int64
+	URLSecret []byte
+	URLTTL    time.Duration
+}
+
+// attachmentTypes are the content types accepted for upload, and whether a thumbnail can be made from them.
+var attachmentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"image/webp":      false,
+	"application/pdf": false,
+}
+
+// sniffContentType looks at the bytes themselves rather than trusting the name or header the client sent.
+func sniffContentType(data []byte) (string, bool) {
+	contentType := http.DetectContentType(data)
+	_, ok := attachmentTypes[contentType]
+
+	return contentType, ok
+}
+
+// makeThumbnail scales an image down to fit within thumbnailSize and encodes it as JPEG.
+// It returns nil for types that get no thumbnail and for images larger than thumbnailMaxPixels,
+// whose dimensions are read from the header before anything is decoded.
+func makeThumbnail(data []byte, contentType string) ([]byte, error) {
+	if !attachmentTypes[contentType] {
+		return nil, nil
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if int64(config.Width)*int64(config.Height) > thumbnailMaxPixels {
+		return nil, nil
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > thumbnailSize || height > thumbnailSize {
+		if width >= height {
+			width, height = thumbnailSize, max(1, height*thumbnailSize/width)
+		} else {
+			width, height = max(1, width*thumbnailSize/height), thumbnailSize
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*bounds.Dy()/height)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*bounds.Dx()/width)
+			dst.Set(x, y, averageColor(src, x0, y0, x1, y1))
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// averageColor averages a box of source pixels, sampling at most a 4x4 grid so large images stay cheap.
+func averageColor(src image.Image, x0 int, y0 int, x1 int, y1 int) color.RGBA {
+	stepX, stepY := max(1, (x1-x0)/4), max(1, (y1-y0)/4)
+
+	var r, g, b, a, n uint32
+	for y := y0; y < y1; y += stepY {
+		for x := x0; x < x1; x += stepX {
+			cr, cg, cb, ca := src.At(x, y).RGBA()
+			r, g, b, a, n = r+cr, g+cg, b+cb, a+ca, n+1
+		}
+	}
+
+	return color.RGBA{R: uint8(r / n >> 8), G: uint8(g / n >> 8), B: uint8(b / n >> 8), A: uint8(a / n >> 8)}
+}
@@ -0,0 +1,38 @@
+package expense
+
+import "time"
+
+type DownloadAttachmentRequest struct {
+	Expires   int64  `query:"expires" validate:"required"`
+	Signature string `query:"signature" validate:"required,hexadecimal"`
+	Thumbnail bool   `query:"thumbnail"`
+}
+
+type AttachmentUsage struct {
+	Used  int64 `json:"used"`
+	Quota int64 `json:"quota"`
+}
+
+type AttachmentResponse struct {
+	ID           uint      `json:"id"`
+	ExpenseID    uint      `json:"expenseId"`
+	FileName     string    `json:"fileName"`
+	ContentType  string    `json:"contentType"`
+	Size         int64     `json:"size"`
+	URL          string    `json:"url"`
+	ThumbnailURL string    `json:"thumbnailUrl,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+func (AttachmentResponse) FromEntity(attachment AttachmentEntity, url string, thumbnailURL string) AttachmentResponse {
+	return AttachmentResponse{
+		ID:           attachment.ID,
+		ExpenseID:    attachment.ExpenseID,
+		FileName:     attachment.FileName,
+		ContentType:  attachment.ContentType,
+		Size:         attachment.Size,
+		URL:          url,
+		ThumbnailURL: thumbnailURL,
+		CreatedAt:    attachment.CreatedAt,
+	}
+}
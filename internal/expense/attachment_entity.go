@@ -0,0 +1,22 @@
+package expense
+
+import "time"
+
+// AttachmentEntity is a receipt or other file kept with an expense. The file itself lives in the blob store under Key,
+// and images also get a JPEG thumbnail under ThumbnailKey. Both sizes count towards the owner's quota.
+type AttachmentEntity struct {
+	ID            uint `gorm:"primarykey"`
+	CreatedAt     time.Time
+	UserID        uint   `gorm:"not null;index"`
+	ExpenseID     uint   `gorm:"not null;index"`
+	FileName      string `gorm:"not null"`
+	ContentType   string `gorm:"type:varchar(100);not null"`
+	Size          int64  `gorm:"not null"`
+	Key           string `gorm:"not null"`
+	ThumbnailKey  string
+	ThumbnailSize int64 `gorm:"not null;default:0"`
+}
+
+func (AttachmentEntity) TableName() string {
+	return "attachments"
+}
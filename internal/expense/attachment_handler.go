@@ -0,0 +1,200 @@
+package expense
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/util"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type AttachmentHandler struct {
+	attachmentService AttachmentService
+	validate          *validator.Validate
+}
+
+func NewAttachmentHandler(attachmentService AttachmentService, validate *validator.Validate) *AttachmentHandler {
+	return &AttachmentHandler{
+		attachmentService: attachmentService,
+		validate:          validate,
+	}
+}
+
+func (h *AttachmentHandler) RegisterRoutes(app *fiber.App, authMiddleware fiber.Handler) {
+	group := app.Group("/expenses/:id/attachments", authMiddleware)
+	group.Get("/", h.GetAttachments)
+	group.Post("/", h.UploadAttachment)
+	group.Delete("/:attachmentId", h.DeleteAttachment)
+
+	app.Get("/attachments/usage", authMiddleware, h.GetUsage)
+	// downloads are authorized by the signature in the URL, so they also work from <img> tags and links
+	app.Get("/attachments/:id/download", h.DownloadAttachment)
+}
+
+func (h *AttachmentHandler) GetAttachments(c *fiber.Ctx) error {
+	expenseID, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	attachments, err := h.attachmentService.GetAttachments(expenseID, authUserID)
+	if err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	attachmentResponses := []AttachmentResponse{}
+	for _, attachment := range attachments {
+		attachmentResponses = append(attachmentResponses, h.response(attachment))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(attachmentResponses)
+}
+
+func (h *AttachmentHandler) GetUsage(c *fiber.Ctx) error {
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	usage, err := h.attachmentService.GetUsage(authUserID)
+	if err != nil {
+		log.Error(err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(usage)
+}
+
+func (h *AttachmentHandler) UploadAttachment(c *fiber.Ctx) error {
+	expenseID, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	fileHeader, errFile := c.FormFile("file")
+	if errFile != nil {
+		log.Error(errFile)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	file, errOpen := fileHeader.Open()
+	if errOpen != nil {
+		log.Error(errOpen)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+	defer file.Close()
+
+	attachment, err := h.attachmentService.UploadAttachment(expenseID, authUserID, fileHeader.Filename, file)
+	if err != nil {
+		log.Error(err)
+		switch {
+		case errors.Is(err, apperror.ErrNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case errors.Is(err, apperror.ErrInvalidRequest):
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		case errors.Is(err, apperror.ErrUnsupportedType):
+			return c.Status(fiber.StatusUnsupportedMediaType).JSON(fiber.Map{"error": apperror.ErrUnsupportedType.Error()})
+		case errors.Is(err, apperror.ErrTooLarge), errors.Is(err, apperror.ErrQuotaExceeded):
+			return c.Status(fiber.StatusRequestEntityTooLarge).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(h.response(*attachment))
+}
+
+func (h *AttachmentHandler) DeleteAttachment(c *fiber.Ctx) error {
+	expenseID, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	attachmentID, errAttachmentID := util.ExtractNamedIDParam(c, "attachmentId")
+	if errAttachmentID != nil {
+		log.Error(errAttachmentID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	if err := h.attachmentService.DeleteAttachment(attachmentID, expenseID, authUserID); err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *AttachmentHandler) DownloadAttachment(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	dto, errDTO := util.ExtractQuery[DownloadAttachmentRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	attachment, reader, err := h.attachmentService.OpenAttachment(id, dto)
+	if err != nil {
+		log.Error(err)
+		switch {
+		case errors.Is(err, apperror.ErrInvalidToken):
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case errors.Is(err, apperror.ErrNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	c.Set(fiber.HeaderContentType, attachment.ContentType)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`inline; filename="%s"; filename*=UTF-8''%s`,
+		attachment.FileName, url.PathEscape(attachment.FileName)))
+	c.Set(fiber.HeaderCacheControl, "private, max-age=300")
+	c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+
+	return c.Status(fiber.StatusOK).SendStream(reader, int(attachment.Size))
+}
+
+func (h *AttachmentHandler) response(attachment AttachmentEntity) AttachmentResponse {
+	thumbnailURL := ""
+	if attachment.ThumbnailKey != "" {
+		thumbnailURL = h.attachmentService.DownloadURL(attachment, true)
+	}
+
+	return AttachmentResponse{}.FromEntity(attachment, h.attachmentService.DownloadURL(attachment, false), thumbnailURL)
+}
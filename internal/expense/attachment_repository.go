@@ -0,0 +1,104 @@
+package expense
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type AttachmentRepository interface {
+	WithTx(tx *gorm.DB) AttachmentRepository
+	GetByExpense(expenseID uint) ([]AttachmentEntity, error)
+	GetByID(id uint) (*AttachmentEntity, error)
+	GetByIDAndUser(id uint, userID uint) (*AttachmentEntity, error)
+	GetByDeletedExpenses(before time.Time) ([]AttachmentEntity, error)
+	SumSizeByUser(userID uint) (int64, error)
+	LockUser(userID uint) error
+	Create(attachment *AttachmentEntity) error
+	Delete(id uint) error
+}
+
+type attachmentRepository struct {
+	db *gorm.DB
+}
+
+func NewAttachmentRepository(db *gorm.DB) AttachmentRepository {
+	return &attachmentRepository{db: db}
+}
+
+func (r *attachmentRepository) WithTx(tx *gorm.DB) AttachmentRepository {
+	if tx == nil {
+		return r
+	}
+
+	return &attachmentRepository{db: tx}
+}
+
+func (r *attachmentRepository) GetByExpense(expenseID uint) ([]AttachmentEntity, error) {
+	var attachments []AttachmentEntity
+	if err := r.db.Where("expense_id = ?", expenseID).
+		Order("id").
+		Find(&attachments).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+func (r *attachmentRepository) GetByID(id uint) (*AttachmentEntity, error) {
+	var attachment AttachmentEntity
+	if err := r.db.First(&attachment, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &attachment, nil
+}
+
+func (r *attachmentRepository) GetByIDAndUser(id uint, userID uint) (*AttachmentEntity, error) {
+	var attachment AttachmentEntity
+	if err := r.db.Where("id = ?", id).
+		Where("user_id = ?", userID).
+		First(&attachment).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &attachment, nil
+}
+
+// GetByDeletedExpenses returns the attachments of expenses soft-deleted before the given time, i.e. those the next purge removes.
+func (r *attachmentRepository) GetByDeletedExpenses(before time.Time) ([]AttachmentEntity, error) {
+	ids := r.db.Unscoped().Model(&ExpenseEntity{}).Select("id").Where("deleted_at < ?", before)
+
+	var attachments []AttachmentEntity
+	if err := r.db.Where("expense_id IN (?)", ids).Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+func (r *attachmentRepository) SumSizeByUser(userID uint) (int64, error) {
+	var total int64
+	err := r.db.Model(&AttachmentEntity{}).
+		Select("COALESCE(SUM(size + thumbnail_size), 0)").
+		Where("user_id = ?", userID).
+		Scan(&total).
+		Error
+
+	return total, err
+}
+
+// LockUser holds the user's row until the transaction ends, so that quota checks on their uploads take turns.
+func (r *attachmentRepository) LockUser(userID uint) error {
+	return r.db.Exec("SELECT id FROM users WHERE id = ? FOR UPDATE", userID).Error
+}
+
+func (r *attachmentRepository) Create(attachment *AttachmentEntity) error {
+	return r.db.Create(attachment).Error
+}
+
+func (r *attachmentRepository) Delete(id uint) error {
+	return r.db.Delete(&AttachmentEntity{}, id).Error
+}
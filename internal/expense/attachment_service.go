@@ -0,0 +1,267 @@
+package expense
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/storage"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AttachmentService interface {
+	GetAttachments(expenseID uint, authUserID uint) ([]AttachmentEntity, error)
+	GetUsage(authUserID uint) (*AttachmentUsage, error)
+	UploadAttachment(expenseID uint, authUserID uint, fileName string, r io.Reader) (*AttachmentEntity, error)
+	DeleteAttachment(id uint, expenseID uint, authUserID uint) error
+	DownloadURL(attachment AttachmentEntity, thumbnail bool) string
+	OpenAttachment(id uint, dto DownloadAttachmentRequest) (*AttachmentEntity, io.ReadCloser, error)
+}
+
+type attachmentService struct {
+	db             *gorm.DB
+	attachmentRepo AttachmentRepository
+	expenseRepo    ExpenseRepository
+	blobStore      storage.BlobStore
+	config         AttachmentConfig
+}
+
+func NewAttachmentService(
+	db *gorm.DB,
+	attachmentRepo AttachmentRepository,
+	expenseRepo ExpenseRepository,
+	blobStore storage.BlobStore,
+	config AttachmentConfig,
+) AttachmentService {
+	return &attachmentService{
+		db:             db,
+		attachmentRepo: attachmentRepo,
+		expenseRepo:    expenseRepo,
+		blobStore:      blobStore,
+		config:         config,
+	}
+}
+
+func (s *attachmentService) GetAttachments(expenseID uint, authUserID uint) ([]AttachmentEntity, error) {
+	if err := s.checkOwner(expenseID, authUserID); err != nil {
+		return nil, err
+	}
+
+	return s.attachmentRepo.GetByExpense(expenseID)
+}
+
+func (s *attachmentService) GetUsage(authUserID uint) (*AttachmentUsage, error) {
+	used, err := s.attachmentRepo.SumSizeByUser(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AttachmentUsage{Used: used, Quota: s.config.UserQuota}, nil
+}
+
+// UploadAttachment stores the file and, for images, a thumbnail. The type is sniffed from the content,
+// and both files together must fit in what is left of the user's quota.
+func (s *attachmentService) UploadAttachment(expenseID uint, authUserID uint, fileName string, r io.Reader) (*AttachmentEntity, error) {
+	if err := s.checkOwner(expenseID, authUserID); err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, s.config.MaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > s.config.MaxSize {
+		return nil, apperror.ErrTooLarge
+	}
+	if len(data) == 0 {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	contentType, ok := sniffContentType(data)
+	if !ok {
+		return nil, apperror.ErrUnsupportedType
+	}
+
+	thumbnail, err := makeThumbnail(data, contentType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperror.ErrUnsupportedType, err)
+	}
+
+	used, err := s.attachmentRepo.SumSizeByUser(authUserID)
+	if err != nil {
+		return nil, err
+	}
+	if used+int64(len(data))+int64(len(thumbnail)) > s.config.UserQuota {
+		return nil, apperror.ErrQuotaExceeded
+	}
+
+	attachment := AttachmentEntity{
+		UserID:      authUserID,
+		ExpenseID:   expenseID,
+		FileName:    cleanFileName(fileName),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		Key:         fmt.Sprintf("attachments/%d/%s", authUserID, uuid.NewString()),
+	}
+
+	if err := s.blobStore.Put(attachment.Key, bytes.NewReader(data), attachment.Size, contentType); err != nil {
+		return nil, err
+	}
+
+	if thumbnail != nil {
+		attachment.ThumbnailKey = attachment.Key + "-thumb.jpg"
+		attachment.ThumbnailSize = int64(len(thumbnail))
+		if err := s.blobStore.Put(attachment.ThumbnailKey, bytes.NewReader(thumbnail), attachment.ThumbnailSize, "image/jpeg"); err != nil {
+			return nil, errors.Join(err, s.deleteBlobs(attachment))
+		}
+	}
+
+	// checked again under the user's lock, since another upload may have been stored meanwhile
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		attachmentRepo := s.attachmentRepo.WithTx(tx)
+		if err := attachmentRepo.LockUser(authUserID); err != nil {
+			return err
+		}
+
+		used, err := attachmentRepo.SumSizeByUser(authUserID)
+		if err != nil {
+			return err
+		}
+		if used+attachment.Size+attachment.ThumbnailSize > s.config.UserQuota {
+			return apperror.ErrQuotaExceeded
+		}
+
+		return attachmentRepo.Create(&attachment)
+	})
+	if err != nil {
+		return nil, errors.Join(err, s.deleteBlobs(attachment))
+	}
+
+	return &attachment, nil
+}
+
+// DeleteAttachment removes the blobs before the row, so a failed delete can simply be retried.
+func (s *attachmentService) DeleteAttachment(id uint, expenseID uint, authUserID uint) error {
+	attachment, err := s.attachmentRepo.GetByIDAndUser(id, authUserID)
+	if err != nil || attachment.ExpenseID != expenseID {
+		return apperror.ErrNotFound
+	}
+
+	if err := s.deleteBlobs(*attachment); err != nil {
+		return err
+	}
+
+	return s.attachmentRepo.Delete(attachment.ID)
+}
+
+// DownloadURL returns a path that serves the file without further authentication until it expires.
+func (s *attachmentService) DownloadURL(attachment AttachmentEntity, thumbnail bool) string {
+	expires := time.Now().Add(s.config.URLTTL).Unix()
+	url := fmt.Sprintf("/attachments/%d/download?expires=%d&signature=%s", attachment.ID, expires, s.sign(attachment.ID, expires, thumbnail))
+	if thumbnail {
+		url += "&thumbnail=true"
+	}
+
+	return url
+}
+
+// OpenAttachment checks a download URL's signature and expiry and opens the file it points at.
+func (s *attachmentService) OpenAttachment(id uint, dto DownloadAttachmentRequest) (*AttachmentEntity, io.ReadCloser, error) {
+	signature, err := hex.DecodeString(dto.Signature)
+	if err != nil {
+		return nil, nil, apperror.ErrInvalidToken
+	}
+	expected, _ := hex.DecodeString(s.sign(id, dto.Expires, dto.Thumbnail))
+	if !hmac.Equal(signature, expected) || time.Now().Unix() > dto.Expires {
+		return nil, nil, apperror.ErrInvalidToken
+	}
+
+	attachment, err := s.attachmentRepo.GetByID(id)
+	if err != nil {
+		return nil, nil, apperror.ErrNotFound
+	}
+
+	key := attachment.Key
+	if dto.Thumbnail {
+		if attachment.ThumbnailKey == "" {
+			return nil, nil, apperror.ErrNotFound
+		}
+		key = attachment.ThumbnailKey
+		attachment.ContentType = "image/jpeg"
+		attachment.Size = attachment.ThumbnailSize
+	}
+
+	reader, err := s.blobStore.Get(key)
+	if errors.Is(err, storage.ErrBlobNotFound) {
+		return nil, nil, apperror.ErrNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, reader, nil
+}
+
+func (s *attachmentService) checkOwner(expenseID uint, authUserID uint) error {
+	isOwner, err := s.expenseRepo.IsOwner(expenseID, authUserID)
+	if err != nil {
+		return err
+	}
+	if !isOwner {
+		return apperror.ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *attachmentService) deleteBlobs(attachment AttachmentEntity) error {
+	return deleteAttachmentBlobs(s.blobStore, []AttachmentEntity{attachment})
+}
+
+func (s *attachmentService) sign(id uint, expires int64, thumbnail bool) string {
+	mac := hmac.New(sha256.New, s.config.URLSecret)
+	fmt.Fprintf(mac, "%d:%d:%t", id, expires, thumbnail)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func deleteAttachmentBlobs(blobStore storage.BlobStore, attachments []AttachmentEntity) error {
+	for _, attachment := range attachments {
+		if err := blobStore.Delete(attachment.Key); err != nil {
+			return err
+		}
+		if attachment.ThumbnailKey == "" {
+			continue
+		}
+		if err := blobStore.Delete(attachment.ThumbnailKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cleanFileName keeps only the base name the client sent, since it ends up in a Content-Disposition header.
+func cleanFileName(fileName string) string {
+	fileName = filepath.Base(strings.ReplaceAll(fileName, "\\", "/"))
+	fileName = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == '"' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, fileName)
+	if fileName == "." || fileName == "/" || fileName == "" {
+		return "attachment"
+	}
+
+	return fileName
+}
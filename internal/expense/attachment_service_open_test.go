@@ -0,0 +1,95 @@
+package expense_test
+
+import (
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/storage"
+	storageMocks "github.com/Perajit/expense-tracker-go/internal/storage/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenAttachment(t *testing.T) {
+	attachment := expense.AttachmentEntity{ID: 3, ContentType: "application/pdf", Size: 8, Key: "attachments/11/a"}
+
+	t.Run("success", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockAttachmentRepo := new(mocks.MockAttachmentRepository)
+		mockAttachmentRepo.On("GetByID", attachment.ID).Return(&attachment, nil).Once()
+
+		mockBlobStore := new(storageMocks.MockBlobStore)
+		mockBlobStore.On("Get", attachment.Key).Return(io.NopCloser(strings.NewReader("%PDF-1.4")), nil).Once()
+
+		service := expense.NewAttachmentService(db, mockAttachmentRepo, new(mocks.MockExpenseRepository), mockBlobStore, attachmentConfig)
+		dto := downloadRequest(t, service.DownloadURL(attachment, false))
+		opened, reader, err := service.OpenAttachment(attachment.ID, dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &attachment, opened)
+		data, _ := io.ReadAll(reader)
+		assert.Equal(t, "%PDF-1.4", string(data))
+	})
+
+	t.Run("error_tampered", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		service := expense.NewAttachmentService(db, new(mocks.MockAttachmentRepository), new(mocks.MockExpenseRepository), new(storageMocks.MockBlobStore), attachmentConfig)
+		dto := downloadRequest(t, service.DownloadURL(attachment, false))
+		dto.Thumbnail = true
+		_, _, err := service.OpenAttachment(attachment.ID, dto)
+
+		assert.Equal(t, apperror.ErrInvalidToken, err)
+	})
+
+	t.Run("error_expired", func(t *testing.T) {
+		config := attachmentConfig
+		config.URLTTL = -time.Minute
+
+		db := testutil.SetupDB()
+
+		service := expense.NewAttachmentService(db, new(mocks.MockAttachmentRepository), new(mocks.MockExpenseRepository), new(storageMocks.MockBlobStore), config)
+		dto := downloadRequest(t, service.DownloadURL(attachment, false))
+		_, _, err := service.OpenAttachment(attachment.ID, dto)
+
+		assert.Equal(t, apperror.ErrInvalidToken, err)
+	})
+
+	t.Run("error_blob_missing", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockAttachmentRepo := new(mocks.MockAttachmentRepository)
+		mockAttachmentRepo.On("GetByID", attachment.ID).Return(&attachment, nil).Once()
+
+		mockBlobStore := new(storageMocks.MockBlobStore)
+		mockBlobStore.On("Get", attachment.Key).Return(nil, storage.ErrBlobNotFound).Once()
+
+		service := expense.NewAttachmentService(db, mockAttachmentRepo, new(mocks.MockExpenseRepository), mockBlobStore, attachmentConfig)
+		dto := downloadRequest(t, service.DownloadURL(attachment, false))
+		_, _, err := service.OpenAttachment(attachment.ID, dto)
+
+		assert.Equal(t, apperror.ErrNotFound, err)
+	})
+}
+
+func downloadRequest(t *testing.T, rawURL string) expense.DownloadAttachmentRequest {
+	parsed, err := url.Parse(rawURL)
+	assert.NoError(t, err)
+
+	query := parsed.Query()
+	expires, _ := strconv.ParseInt(query.Get("expires"), 10, 64)
+
+	return expense.DownloadAttachmentRequest{
+		Expires:   expires,
+		Signature: query.Get("signature"),
+		Thumbnail: query.Get("thumbnail") == "true",
+	}
+}
@@ -0,0 +1,199 @@
+package expense_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	storageMocks "github.com/Perajit/expense-tracker-go/internal/storage/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var attachmentConfig = expense.AttachmentConfig{
+	MaxSize:   1 << 20,
+	UserQuota: 2 << 20,
+	URLSecret: []byte("secret"),
+	URLTTL:    time.Minute,
+}
+
+func TestUploadAttachment(t *testing.T) {
+	var expenseID uint = 7
+	var userID uint = 11
+
+	t.Run("success", func(t *testing.T) {
+		var receipt bytes.Buffer
+		png.Encode(&receipt, image.NewRGBA(image.Rect(0, 0, 800, 400)))
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("IsOwner", expenseID, userID).Return(true, nil).Once()
+
+		mockAttachmentRepo := new(mocks.MockAttachmentRepository)
+		mockAttachmentRepo.On("SumSizeByUser", userID).Return(int64(1000), nil).Twice()
+		mockAttachmentRepo.On("WithTx", mock.Anything).Return(mockAttachmentRepo).Once()
+		mockAttachmentRepo.On("LockUser", userID).Return(nil).Once()
+		mockAttachmentRepo.On("Create", mock.AnythingOfType("*expense.AttachmentEntity")).Return(nil).Once()
+
+		mockBlobStore := new(storageMocks.MockBlobStore)
+		mockBlobStore.On("Put", mock.MatchedBy(func(key string) bool { return !strings.HasSuffix(key, "-thumb.jpg") }),
+			mock.Anything, int64(receipt.Len()), "image/png").Return(nil).Once()
+		mockBlobStore.On("Put", mock.MatchedBy(func(key string) bool { return strings.HasSuffix(key, "-thumb.jpg") }),
+			mock.Anything, mock.Anything, "image/jpeg").Return(nil).Once()
+
+		service := expense.NewAttachmentService(db, mockAttachmentRepo, mockExpenseRepo, mockBlobStore, attachmentConfig)
+		attachment, err := service.UploadAttachment(expenseID, userID, `C:\scans\receipt.png`, bytes.NewReader(receipt.Bytes()))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "receipt.png", attachment.FileName)
+		assert.Equal(t, "image/png", attachment.ContentType)
+		assert.Equal(t, int64(receipt.Len()), attachment.Size)
+		assert.Equal(t, attachment.Key+"-thumb.jpg", attachment.ThumbnailKey)
+		assert.Positive(t, attachment.ThumbnailSize)
+		mockBlobStore.AssertExpectations(t)
+		mockAttachmentRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_no_thumbnail_for_huge_image", func(t *testing.T) {
+		receipt := pngHeader(50000, 50000)
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("IsOwner", expenseID, userID).Return(true, nil).Once()
+
+		mockAttachmentRepo := new(mocks.MockAttachmentRepository)
+		mockAttachmentRepo.On("SumSizeByUser", userID).Return(int64(1000), nil).Twice()
+		mockAttachmentRepo.On("WithTx", mock.Anything).Return(mockAttachmentRepo).Once()
+		mockAttachmentRepo.On("LockUser", userID).Return(nil).Once()
+		mockAttachmentRepo.On("Create", mock.AnythingOfType("*expense.AttachmentEntity")).Return(nil).Once()
+
+		mockBlobStore := new(storageMocks.MockBlobStore)
+		mockBlobStore.On("Put", mock.Anything, mock.Anything, int64(len(receipt)), "image/png").Return(nil).Once()
+
+		service := expense.NewAttachmentService(db, mockAttachmentRepo, mockExpenseRepo, mockBlobStore, attachmentConfig)
+		attachment, err := service.UploadAttachment(expenseID, userID, "huge.png", bytes.NewReader(receipt))
+
+		assert.NoError(t, err)
+		assert.Empty(t, attachment.ThumbnailKey)
+		mockBlobStore.AssertExpectations(t)
+	})
+
+	t.Run("error_unsupported_type", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("IsOwner", expenseID, userID).Return(true, nil).Once()
+
+		mockBlobStore := new(storageMocks.MockBlobStore)
+
+		service := expense.NewAttachmentService(db, new(mocks.MockAttachmentRepository), mockExpenseRepo, mockBlobStore, attachmentConfig)
+		attachment, err := service.UploadAttachment(expenseID, userID, "receipt.pdf", strings.NewReader("<html><script>alert(1)</script></html>"))
+
+		assert.Nil(t, attachment)
+		assert.ErrorIs(t, err, apperror.ErrUnsupportedType)
+		mockBlobStore.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error_too_large", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("IsOwner", expenseID, userID).Return(true, nil).Once()
+
+		service := expense.NewAttachmentService(db, new(mocks.MockAttachmentRepository), mockExpenseRepo, new(storageMocks.MockBlobStore), attachmentConfig)
+		attachment, err := service.UploadAttachment(expenseID, userID, "scan.pdf", io.LimitReader(zeroReader{}, attachmentConfig.MaxSize+1))
+
+		assert.Nil(t, attachment)
+		assert.Equal(t, apperror.ErrTooLarge, err)
+	})
+
+	t.Run("error_quota_exceeded", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("IsOwner", expenseID, userID).Return(true, nil).Once()
+
+		mockAttachmentRepo := new(mocks.MockAttachmentRepository)
+		mockAttachmentRepo.On("SumSizeByUser", userID).Return(attachmentConfig.UserQuota-4, nil).Once()
+
+		mockBlobStore := new(storageMocks.MockBlobStore)
+
+		service := expense.NewAttachmentService(db, mockAttachmentRepo, mockExpenseRepo, mockBlobStore, attachmentConfig)
+		attachment, err := service.UploadAttachment(expenseID, userID, "scan.pdf", strings.NewReader("%PDF-1.4\n%%EOF"))
+
+		assert.Nil(t, attachment)
+		assert.Equal(t, apperror.ErrQuotaExceeded, err)
+		mockBlobStore.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error_quota_exceeded_meanwhile", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("IsOwner", expenseID, userID).Return(true, nil).Once()
+
+		mockAttachmentRepo := new(mocks.MockAttachmentRepository)
+		mockAttachmentRepo.On("SumSizeByUser", userID).Return(int64(1000), nil).Once()
+		mockAttachmentRepo.On("WithTx", mock.Anything).Return(mockAttachmentRepo).Once()
+		mockAttachmentRepo.On("LockUser", userID).Return(nil).Once()
+		mockAttachmentRepo.On("SumSizeByUser", userID).Return(attachmentConfig.UserQuota-4, nil).Once()
+
+		mockBlobStore := new(storageMocks.MockBlobStore)
+		mockBlobStore.On("Put", mock.Anything, mock.Anything, mock.Anything, "application/pdf").Return(nil).Once()
+		mockBlobStore.On("Delete", mock.Anything).Return(nil).Once()
+
+		service := expense.NewAttachmentService(db, mockAttachmentRepo, mockExpenseRepo, mockBlobStore, attachmentConfig)
+		attachment, err := service.UploadAttachment(expenseID, userID, "scan.pdf", strings.NewReader("%PDF-1.4\n%%EOF"))
+
+		assert.Nil(t, attachment)
+		assert.ErrorIs(t, err, apperror.ErrQuotaExceeded)
+		mockBlobStore.AssertExpectations(t)
+		mockAttachmentRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("error_not_owner", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("IsOwner", expenseID, userID).Return(false, nil).Once()
+
+		service := expense.NewAttachmentService(db, new(mocks.MockAttachmentRepository), mockExpenseRepo, new(storageMocks.MockBlobStore), attachmentConfig)
+		attachment, err := service.UploadAttachment(expenseID, userID, "scan.pdf", strings.NewReader("%PDF-1.4"))
+
+		assert.Nil(t, attachment)
+		assert.Equal(t, apperror.ErrNotFound, err)
+	})
+}
+
+// pngHeader builds a PNG that declares the given dimensions but carries no pixel data.
+func pngHeader(width uint32, height uint32) []byte {
+	ihdr := binary.BigEndian.AppendUint32([]byte("IHDR"), width)
+	ihdr = binary.BigEndian.AppendUint32(ihdr, height)
+	ihdr = append(ihdr, 8, 6, 0, 0, 0)
+
+	data := []byte("\x89PNG\r\n\x1a\n")
+	data = binary.BigEndian.AppendUint32(data, uint32(len(ihdr)-4))
+	data = append(data, ihdr...)
+	data = binary.BigEndian.AppendUint32(data, crc32.ChecksumIEEE(ihdr))
+
+	return data
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
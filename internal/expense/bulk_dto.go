@@ -0,0 +1,45 @@
+package expense
+
+type BulkOperation string
+
+const (
+	BulkSetCategory BulkOperation = "set_category"
+	BulkAddTags     BulkOperation = "add_tags"
+	BulkRemoveTags  BulkOperation = "remove_tags"
+	BulkShiftDate   BulkOperation = "shift_date"
+	BulkDelete      BulkOperation = "delete"
+)
+
+// maxBulkExpenses caps how many expenses one bulk request may touch.
+const maxBulkExpenses = 1000
+
+// BulkExpenseRequest picks expenses either by id or by the same filters the listing takes, then applies one operation to all of them.
+// Days shifts dates forward, or back when negative.
+type BulkExpenseRequest struct {
+	IDs        []uint              `json:"ids" validate:"required_without=Filter,omitempty,max=1000"`
+	Filter     *GetExpensesRequest `json:"filter"`
+	Operation  string              `json:"operation" validate:"required,oneof=set_category add_tags remove_tags shift_date delete"`
+	CategoryID uint                `json:"categoryId" validate:"required_if=Operation set_category"`
+	TagIDs     []uint              `json:"tagIds" validate:"required_if=Operation add_tags,required_if=Operation remove_tags"`
+	Days       int                 `json:"days" validate:"required_if=Operation shift_date"`
+}
+
+type BulkStatus string
+
+const (
+	BulkStatusUpdated   BulkStatus = "updated"
+	BulkStatusDeleted   BulkStatus = "deleted"
+	BulkStatusUnchanged BulkStatus = "unchanged"
+	BulkStatusNotFound  BulkStatus = "not_found"
+)
+
+type BulkItemResult struct {
+	ID     uint       `json:"id"`
+	Status BulkStatus `json:"status"`
+}
+
+type BulkResult struct {
+	Operation BulkOperation    `json:"operation"`
+	Changed   int              `json:"changed"`
+	Items     []BulkItemResult `json:"items"`
+}
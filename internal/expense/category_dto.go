@@ -1,15 +1,63 @@
 package expense
 
+import "github.com/shopspring/decimal"
+
+type DeleteStrategy string
+
+const (
+	DeleteStrategyReassign      DeleteStrategy = "reassign"
+	DeleteStrategyUncategorized DeleteStrategy = "uncategorized"
+	DeleteStrategyCascade       DeleteStrategy = "cascade"
+)
+
+// UncategorizedName is the default category that takes over expenses from deleted categories.
+const UncategorizedName = "Uncategorized"
+
 type CreateCategoryRequest struct {
-	Name string `json:"name" validate:"required"`
+	Name     string `json:"name" validate:"required"`
+	ParentID uint   `json:"parentId"`
 }
 
 type UpdateCategoryRequest struct {
 	Name *string `json:"name" validate:"required"`
 }
 
+// MoveCategoryRequest puts a category, with everything below it, under a new parent; 0 makes it top-level.
+type MoveCategoryRequest struct {
+	ParentID *uint `json:"parentId" validate:"required"`
+}
+
+// DeleteCategoryRequest says what happens to the expenses of a category in use; without a strategy the delete is refused.
+type DeleteCategoryRequest struct {
+	Strategy DeleteStrategy `query:"strategy" validate:"omitempty,oneof=reassign uncategorized cascade"`
+	TargetID uint           `query:"targetId" validate:"required_if=Strategy reassign"`
+}
+
+// CategoryUsage counts what still refers to a category, with expense totals per kind and currency.
+type CategoryUsage struct {
+	Expenses  int64                `json:"expenses"`
+	Splits    int64                `json:"splits"`
+	Recurring int64                `json:"recurring"`
+	Budgets   int64                `json:"budgets"`
+	Rules     int64                `json:"rules"`
+	Merchants int64                `json:"merchants"`
+	Totals    []CategoryUsageTotal `json:"totals"`
+}
+
+func (u CategoryUsage) InUse() bool {
+	return u.Expenses > 0 || u.Splits > 0 || u.Recurring > 0 || u.Budgets > 0 || u.Rules > 0 || u.Merchants > 0
+}
+
+type CategoryUsageTotal struct {
+	Kind     Kind            `json:"kind"`
+	Currency string          `json:"currency"`
+	Amount   decimal.Decimal `json:"amount"`
+	Count    int64           `json:"count"`
+}
+
 type CategoryResponse struct {
 	ID        uint   `json:"id"`
+	ParentID  uint   `json:"parentId"`
 	Name      string `json:"name"`
 	IsDefault bool   `json:"isDefault"`
 }
@@ -17,7 +65,13 @@ type CategoryResponse struct {
 func (CategoryResponse) FromEntity(category CategoryEntity) CategoryResponse {
 	return CategoryResponse{
 		ID:        category.ID,
+		ParentID:  category.ParentID,
 		Name:      category.Name,
 		IsDefault: category.IsDefault,
 	}
 }
+
+type CategoryTreeResponse struct {
+	CategoryResponse
+	Children []CategoryTreeResponse `json:"children"`
+}
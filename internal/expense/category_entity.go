@@ -4,10 +4,13 @@ import (
 	"gorm.io/gorm"
 )
 
+// CategoryEntity is a node in the user's category tree; a zero ParentID marks a top-level category.
+// Names only need to be unique among siblings, so "Other" can live under several parents.
 type CategoryEntity struct {
 	gorm.Model
-	UserID    uint   `gorm:"not null;uniqueIndex:idx_categories_user_name;default:0"`
-	Name      string `gorm:"not null;uniqueIndex:idx_categories_user_name"`
+	UserID    uint   `gorm:"not null;uniqueIndex:idx_categories_user_parent_name_active,where:deleted_at IS NULL;default:0"`
+	ParentID  uint   `gorm:"not null;uniqueIndex:idx_categories_user_parent_name_active,where:deleted_at IS NULL;index;default:0"`
+	Name      string `gorm:"not null;uniqueIndex:idx_categories_user_parent_name_active,where:deleted_at IS NULL"`
 	IsDefault bool   `gorm:"index;default:false"`
 }
 
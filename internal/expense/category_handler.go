@@ -22,11 +22,15 @@ func NewCategoryHandler(categoryService CategoryService, validate *validator.Val
 	}
 }
 
-func (h *CategoryHandler) RegisterRoutes(app *fiber.App) {
-	group := app.Group("/expenses")
+func (h *CategoryHandler) RegisterRoutes(app *fiber.App, authMiddleware fiber.Handler) {
+	group := app.Group("/categories", authMiddleware)
 	group.Get("/", h.GetCategories)
+	group.Get("/tree", h.GetCategoryTree)
 	group.Get("/:id", h.GetCategoryByID)
+	group.Get("/:id/usage", h.GetCategoryUsage)
 	group.Post("/", h.CreateCategory)
+	group.Post("/:id/move", h.MoveCategory)
+	group.Post("/:id/merge-into/:targetId", h.MergeCategory)
 	group.Patch("/:id", h.UpdateCategory)
 	group.Delete("/:id", h.DeleteCategory)
 }
@@ -47,6 +51,22 @@ func (h *CategoryHandler) GetCategories(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(categories)
 }
 
+func (h *CategoryHandler) GetCategoryTree(c *fiber.Ctx) error {
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	tree, err := h.categoryService.GetCategoryTree(authUserID)
+	if err != nil {
+		log.Error(err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(tree)
+}
+
 func (h *CategoryHandler) GetCategoryByID(c *fiber.Ctx) error {
 	id, errID := util.ExtractIDParam(c)
 	if errID != nil {
@@ -69,6 +89,31 @@ func (h *CategoryHandler) GetCategoryByID(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(category)
 }
 
+func (h *CategoryHandler) GetCategoryUsage(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	usage, err := h.categoryService.GetCategoryUsage(id, authUserID)
+	if err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(usage)
+}
+
 func (h *CategoryHandler) CreateCategory(c *fiber.Ctx) error {
 	userID, errUserID := util.GetAuthUserID(c)
 	if errUserID != nil {
@@ -85,6 +130,12 @@ func (h *CategoryHandler) CreateCategory(c *fiber.Ctx) error {
 	category, err := h.categoryService.CreateCategory(userID, dto)
 	if err != nil {
 		log.Error(err)
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrRecordDuplication) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
 	}
 
@@ -115,12 +166,94 @@ func (h *CategoryHandler) UpdateCategory(c *fiber.Ctx) error {
 		if errors.Is(err, apperror.ErrUnauthorized) {
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
 		}
+		if errors.Is(err, apperror.ErrRecordDuplication) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
 	}
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{"status": "success"})
 }
 
+func (h *CategoryHandler) MoveCategory(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractDto[MoveCategoryRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	if err := h.categoryService.MoveCategory(id, authUserID, dto); err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrRecordDuplication) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"status": "success"})
+}
+
+func (h *CategoryHandler) MergeCategory(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	targetID, errTargetID := util.ExtractNamedIDParam(c, "targetId")
+	if errTargetID != nil {
+		log.Error(errTargetID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractQuery[MergeRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	result, err := h.categoryService.MergeCategory(id, targetID, authUserID, dto)
+	if err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrRecordDuplication) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
 func (h *CategoryHandler) DeleteCategory(c *fiber.Ctx) error {
 	id, errID := util.ExtractIDParam(c)
 	if errID != nil {
@@ -134,11 +267,26 @@ func (h *CategoryHandler) DeleteCategory(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
 	}
 
-	if err := h.categoryService.DeleteCategory(id, authUserID); err != nil {
+	dto, errDTO := util.ExtractQuery[DeleteCategoryRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	if err := h.categoryService.DeleteCategory(id, authUserID, dto); err != nil {
 		log.Error(err)
 		if errors.Is(err, apperror.ErrUnauthorized) {
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
 		}
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrRecordDuplication) || errors.Is(err, apperror.ErrConflict) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
 	}
 
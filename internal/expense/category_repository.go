@@ -1,15 +1,33 @@
 package expense
 
-import "gorm.io/gorm"
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type CategoryRepository interface {
+	WithTx(tx *gorm.DB) CategoryRepository
 	GetByIDAndUser(id uint, userID *uint) (*CategoryEntity, error)
 	GetByUser(userID uint) ([]CategoryEntity, error)
+	GetByNames(userID uint, names []string) ([]CategoryEntity, error)
 	IsOwner(id uint, userID uint) (bool, error)
-	ExistsByName(userID uint, name string) (bool, error)
+	ExistsByName(userID uint, parentID uint, name string) (bool, error)
+	GetDescendantIDs(id uint) ([]uint, error)
+	Reparent(fromParentID uint, toParentID uint) error
+	CountExpenses(id uint) (int64, error)
+	GetExpenseIDs(id uint) ([]uint, error)
+	Reassign(fromID uint, toID uint) error
+	GetUsage(id uint, userID uint) (*CategoryUsage, error)
+	DeleteUsages(id uint) error
 	Create(category *CategoryEntity) error
 	Update(category *CategoryEntity) error
 	Delete(id uint) error
+	GetDeletedByUser(userID uint) ([]CategoryEntity, error)
+	GetDeletedByIDAndUser(id uint, userID uint) (*CategoryEntity, error)
+	Restore(id uint) error
+	Purge(before time.Time) (int64, error)
 }
 
 type categoryRepository struct {
@@ -20,6 +38,14 @@ func NewCategoryRepository(db *gorm.DB) CategoryRepository {
 	return &categoryRepository{db: db}
 }
 
+func (r *categoryRepository) WithTx(tx *gorm.DB) CategoryRepository {
+	if tx == nil {
+		return r
+	}
+
+	return &categoryRepository{db: tx}
+}
+
 func (r *categoryRepository) GetByIDAndUser(id uint, userID *uint) (*CategoryEntity, error) {
 	var category CategoryEntity
 	if err := r.db.Where("id = ?", id).Where("user_id = ?", userID).First(&category).Error; err != nil {
@@ -31,7 +57,24 @@ func (r *categoryRepository) GetByIDAndUser(id uint, userID *uint) (*CategoryEnt
 
 func (r *categoryRepository) GetByUser(userID uint) ([]CategoryEntity, error) {
 	var categories []CategoryEntity
-	if err := r.db.Where("user_id = ?", userID).Or("is_default = ?", false).Find(&categories).Error; err != nil {
+	if err := r.db.Where("user_id = ?", userID).Or("user_id = ?", 0).Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// GetByNames matches names case-insensitively against the user's own and the default categories.
+func (r *categoryRepository) GetByNames(userID uint, names []string) ([]CategoryEntity, error) {
+	var categories []CategoryEntity
+	if len(names) == 0 {
+		return categories, nil
+	}
+
+	if err := r.db.Where("LOWER(name) IN ?", lowerNames(names)).
+		Where(r.db.Where("user_id = ?", userID).Or("user_id = ?", 0)).
+		Find(&categories).
+		Error; err != nil {
 		return nil, err
 	}
 
@@ -40,20 +83,165 @@ func (r *categoryRepository) GetByUser(userID uint) ([]CategoryEntity, error) {
 
 func (r *categoryRepository) IsOwner(id uint, userID uint) (bool, error) {
 	var count int64
-	err := r.db.Where("id = ?", id).Where("user_id = ?", userID).Count(&count).Error
+	err := r.db.Model(&CategoryEntity{}).Where("id = ?", id).Where("user_id = ?", userID).Count(&count).Error
 
 	return count > 0, err
 }
 
-func (r *categoryRepository) ExistsByName(userID uint, name string) (bool, error) {
+// ExistsByName checks the name against the siblings under parentID, counting default categories too.
+func (r *categoryRepository) ExistsByName(userID uint, parentID uint, name string) (bool, error) {
 	var count int64
-	err := r.db.Where("name = ?", name).
+	err := r.db.Model(&CategoryEntity{}).
+		Where("name = ?", name).
+		Where("parent_id = ?", parentID).
 		Where(r.db.Where("user_id = ?", userID).Or("user_id = ?", 0)).
 		Count(&count).Error
 
 	return count > 0, err
 }
 
+// GetDescendantIDs walks down the tree from id, returning every category below it but not id itself.
+func (r *categoryRepository) GetDescendantIDs(id uint) ([]uint, error) {
+	var ids []uint
+	if err := r.db.Raw(`WITH RECURSIVE tree AS (
+			SELECT id FROM expense_categories WHERE parent_id = ? AND deleted_at IS NULL
+			UNION
+			SELECT c.id FROM expense_categories c JOIN tree ON c.parent_id = tree.id WHERE c.deleted_at IS NULL
+		) SELECT id FROM tree`, id).
+		Scan(&ids).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// Reparent moves every child of one category, deleted ones included, under another.
+func (r *categoryRepository) Reparent(fromParentID uint, toParentID uint) error {
+	return r.db.Unscoped().Model(&CategoryEntity{}).Where("parent_id = ?", fromParentID).Update("parent_id", toParentID).Error
+}
+
+func (r *categoryRepository) CountExpenses(id uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&ExpenseEntity{}).
+		Where("category_id = ? OR id IN (SELECT expense_id FROM expense_splits WHERE category_id = ?)", id, id).
+		Count(&count).
+		Error
+
+	return count, err
+}
+
+// GetExpenseIDs returns the expenses filed under the category, on the expense itself or on one of its splits.
+func (r *categoryRepository) GetExpenseIDs(id uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.Model(&ExpenseEntity{}).
+		Where("category_id = ? OR id IN (SELECT expense_id FROM expense_splits WHERE category_id = ?)", id, id).
+		Pluck("id", &ids).
+		Error
+
+	return ids, err
+}
+
+// Reassign files everything under one category, deleted expenses included, under another instead.
+func (r *categoryRepository) Reassign(fromID uint, toID uint) error {
+	for _, table := range []string{"expenses", "expense_splits", "recurring_expenses", "budgets"} {
+		if err := r.db.Exec("UPDATE "+table+" SET category_id = ? WHERE category_id = ?", toID, fromID).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := r.db.Exec("UPDATE rules SET set_category_id = ? WHERE set_category_id = ?", toID, fromID).Error; err != nil {
+		return err
+	}
+
+	return r.db.Exec("UPDATE merchants SET default_category_id = ? WHERE default_category_id = ?", toID, fromID).Error
+}
+
+func (r *categoryRepository) GetUsage(id uint, userID uint) (*CategoryUsage, error) {
+	usage := &CategoryUsage{Totals: []CategoryUsageTotal{}}
+	if err := r.db.Model(&ExpenseEntity{}).
+		Select("kind, currency, SUM(amount) AS amount, COUNT(*) AS count").
+		Where("category_id = ?", id).
+		Where("user_id = ?", userID).
+		Group("kind, currency").
+		Order("kind, currency").
+		Scan(&usage.Totals).
+		Error; err != nil {
+		return nil, err
+	}
+	for _, total := range usage.Totals {
+		usage.Expenses += total.Count
+	}
+
+	if err := r.db.Model(&ExpenseSplitEntity{}).
+		Joins("JOIN expenses e ON e.id = expense_splits.expense_id AND e.deleted_at IS NULL").
+		Where("expense_splits.category_id = ?", id).
+		Where("e.user_id = ?", userID).
+		Count(&usage.Splits).
+		Error; err != nil {
+		return nil, err
+	}
+
+	if err := r.db.Model(&RecurringExpenseEntity{}).
+		Where("category_id = ?", id).
+		Where("user_id = ?", userID).
+		Count(&usage.Recurring).
+		Error; err != nil {
+		return nil, err
+	}
+
+	if err := r.db.Table("budgets").
+		Where("category_id = ?", id).
+		Where("user_id = ?", userID).
+		Where("deleted_at IS NULL").
+		Count(&usage.Budgets).
+		Error; err != nil {
+		return nil, err
+	}
+
+	if err := r.db.Model(&RuleEntity{}).
+		Where("set_category_id = ?", id).
+		Where("user_id = ?", userID).
+		Count(&usage.Rules).
+		Error; err != nil {
+		return nil, err
+	}
+
+	if err := r.db.Model(&MerchantEntity{}).
+		Where("default_category_id = ?", id).
+		Where("user_id = ?", userID).
+		Count(&usage.Merchants).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// DeleteUsages soft-deletes the expenses, recurring expenses and budgets filed under the category,
+// including expenses with only a split filed there, and clears it from rules and merchants.
+func (r *categoryRepository) DeleteUsages(id uint) error {
+	if err := r.db.Where("category_id = ? OR id IN (SELECT expense_id FROM expense_splits WHERE category_id = ?)", id, id).
+		Delete(&ExpenseEntity{}).
+		Error; err != nil {
+		return err
+	}
+
+	if err := r.db.Where("category_id = ?", id).Delete(&RecurringExpenseEntity{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.Exec("UPDATE budgets SET deleted_at = ? WHERE category_id = ? AND deleted_at IS NULL", time.Now(), id).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.Exec("UPDATE rules SET set_category_id = NULL WHERE set_category_id = ?", id).Error; err != nil {
+		return err
+	}
+
+	return r.db.Exec("UPDATE merchants SET default_category_id = NULL WHERE default_category_id = ?", id).Error
+}
+
 func (r *categoryRepository) Create(category *CategoryEntity) error {
 	return r.db.Create(category).Error
 }
@@ -63,5 +251,62 @@ func (r *categoryRepository) Update(category *CategoryEntity) error {
 }
 
 func (r *categoryRepository) Delete(id uint) error {
-	return r.db.Delete(id).Error
+	return r.db.Delete(&CategoryEntity{}, id).Error
+}
+
+func (r *categoryRepository) GetDeletedByUser(userID uint) ([]CategoryEntity, error) {
+	var categories []CategoryEntity
+	if err := r.db.Unscoped().
+		Where("user_id = ?", userID).
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").
+		Find(&categories).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *categoryRepository) GetDeletedByIDAndUser(id uint, userID uint) (*CategoryEntity, error) {
+	var category CategoryEntity
+	if err := r.db.Unscoped().
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		Where("deleted_at IS NOT NULL").
+		First(&category).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+func (r *categoryRepository) Restore(id uint) error {
+	return r.db.Unscoped().Model(&CategoryEntity{}).Where("id = ?", id).Update("deleted_at", nil).Error
+}
+
+// Purge hard-deletes categories soft-deleted before the given time.
+// Categories still referenced by any expense, split, recurring expense, budget, rule or merchant are kept.
+func (r *categoryRepository) Purge(before time.Time) (int64, error) {
+	result := r.db.Unscoped().
+		Where("deleted_at < ?", before).
+		Where("NOT EXISTS (SELECT 1 FROM expenses e WHERE e.category_id = expense_categories.id)").
+		Where("NOT EXISTS (SELECT 1 FROM expense_splits s WHERE s.category_id = expense_categories.id)").
+		Where("NOT EXISTS (SELECT 1 FROM recurring_expenses re WHERE re.category_id = expense_categories.id)").
+		Where("NOT EXISTS (SELECT 1 FROM budgets b WHERE b.category_id = expense_categories.id)").
+		Where("NOT EXISTS (SELECT 1 FROM rules r WHERE r.set_category_id = expense_categories.id)").
+		Where("NOT EXISTS (SELECT 1 FROM merchants m WHERE m.default_category_id = expense_categories.id)").
+		Delete(&CategoryEntity{})
+
+	return result.RowsAffected, result.Error
+}
+
+func lowerNames(names []string) []string {
+	lowered := make([]string, len(names))
+	for i, name := range names {
+		lowered[i] = strings.ToLower(name)
+	}
+
+	return lowered
 }
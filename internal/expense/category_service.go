@@ -1,22 +1,37 @@
 package expense
 
-import "github.com/Perajit/expense-tracker-go/internal/apperror"
+import (
+	"errors"
+	"slices"
+	"sort"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"gorm.io/gorm"
+)
 
 type CategoryService interface {
 	GetCategoryByID(id uint, authUserID *uint) (*CategoryEntity, error)
 	GetCategories(authUserID uint) ([]CategoryEntity, error)
+	GetCategoriesByNames(names []string, authUserID uint) ([]CategoryEntity, error)
+	GetCategoryTree(authUserID uint) ([]CategoryTreeResponse, error)
 	IsCategoryOwner(id uint, authUserID uint) (bool, error)
 	CreateCategory(authUserID uint, dto CreateCategoryRequest) (*CategoryEntity, error)
 	UpdateCategory(id uint, authUserID uint, dto UpdateCategoryRequest) error
-	DeleteCategory(id uint, userId uint) error
+	MoveCategory(id uint, authUserID uint, dto MoveCategoryRequest) error
+	MergeCategory(id uint, targetID uint, authUserID uint, dto MergeRequest) (*MergeResult, error)
+	GetCategoryUsage(id uint, authUserID uint) (*CategoryUsage, error)
+	DeleteCategory(id uint, userId uint, dto DeleteCategoryRequest) error
 }
 
 type categoryService struct {
+	db           *gorm.DB
 	categoryRepo CategoryRepository
+	expenseRepo  ExpenseRepository
+	revisionRepo RevisionRepository
 }
 
-func NewCategoryService(categoryRepo CategoryRepository) CategoryService {
-	return &categoryService{categoryRepo: categoryRepo}
+func NewCategoryService(db *gorm.DB, categoryRepo CategoryRepository, expenseRepo ExpenseRepository, revisionRepo RevisionRepository) CategoryService {
+	return &categoryService{db: db, categoryRepo: categoryRepo, expenseRepo: expenseRepo, revisionRepo: revisionRepo}
 }
 
 func (s *categoryService) GetCategoryByID(id uint, authUserID *uint) (*CategoryEntity, error) {
@@ -27,12 +42,66 @@ func (s *categoryService) GetCategories(authUserID uint) ([]CategoryEntity, erro
 	return s.categoryRepo.GetByUser(authUserID)
 }
 
+func (s *categoryService) GetCategoriesByNames(names []string, authUserID uint) ([]CategoryEntity, error) {
+	return s.categoryRepo.GetByNames(authUserID, names)
+}
+
+func (s *categoryService) GetCategoryTree(authUserID uint) ([]CategoryTreeResponse, error) {
+	categories, err := s.categoryRepo.GetByUser(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := map[uint]bool{}
+	children := map[uint][]CategoryEntity{}
+	for _, category := range categories {
+		visible[category.ID] = true
+	}
+	for _, category := range categories {
+		// a category whose parent is out of sight is shown at the top
+		parentID := category.ParentID
+		if !visible[parentID] {
+			parentID = 0
+		}
+		children[parentID] = append(children[parentID], category)
+	}
+
+	var build func(parentID uint) []CategoryTreeResponse
+	build = func(parentID uint) []CategoryTreeResponse {
+		nodes := []CategoryTreeResponse{}
+		for _, category := range children[parentID] {
+			nodes = append(nodes, CategoryTreeResponse{
+				CategoryResponse: CategoryResponse{}.FromEntity(category),
+				Children:         build(category.ID),
+			})
+		}
+		sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+
+		return nodes
+	}
+
+	return build(0), nil
+}
+
+// IsCategoryOwner tells whether the user may file expenses under the category, which covers the default
+// categories as well as their own, the same rule merge, reassign and delete-to-Uncategorized go by.
 func (s *categoryService) IsCategoryOwner(id uint, authUserID uint) (bool, error) {
-	return s.categoryRepo.IsOwner(id, authUserID)
+	err := s.checkVisible(id, authUserID)
+	if errors.Is(err, apperror.ErrNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
 }
 
 func (s *categoryService) CreateCategory(authUserID uint, dto CreateCategoryRequest) (*CategoryEntity, error) {
-	duplicated, err := s.categoryRepo.ExistsByName(authUserID, dto.Name)
+	if dto.ParentID != 0 {
+		if err := s.checkVisible(dto.ParentID, authUserID); err != nil {
+			return nil, err
+		}
+	}
+
+	duplicated, err := s.categoryRepo.ExistsByName(authUserID, dto.ParentID, dto.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -41,8 +110,9 @@ func (s *categoryService) CreateCategory(authUserID uint, dto CreateCategoryRequ
 	}
 
 	category := &CategoryEntity{
-		UserID: authUserID,
-		Name:   dto.Name,
+		UserID:   authUserID,
+		ParentID: dto.ParentID,
+		Name:     dto.Name,
 	}
 	if err := s.categoryRepo.Create(category); err != nil {
 		return nil, err
@@ -58,7 +128,7 @@ func (s *categoryService) UpdateCategory(id uint, authUserID uint, dto UpdateCat
 	}
 
 	if dto.Name != nil {
-		duplicated, err := s.categoryRepo.ExistsByName(authUserID, *dto.Name)
+		duplicated, err := s.categoryRepo.ExistsByName(authUserID, category.ParentID, *dto.Name)
 		if err != nil {
 			return err
 		}
@@ -76,14 +146,278 @@ func (s *categoryService) UpdateCategory(id uint, authUserID uint, dto UpdateCat
 	return nil
 }
 
-func (s *categoryService) DeleteCategory(id uint, authUserID uint) error {
-	isOwner, err := s.categoryRepo.IsOwner(id, authUserID)
+func (s *categoryService) MoveCategory(id uint, authUserID uint, dto MoveCategoryRequest) error {
+	category, err := s.categoryRepo.GetByIDAndUser(id, &authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	parentID := *dto.ParentID
+	if parentID == category.ParentID {
+		return nil
+	}
+
+	if parentID != 0 {
+		if err := s.checkVisible(parentID, authUserID); err != nil {
+			return err
+		}
+
+		// a category cannot end up below itself
+		descendantIDs, err := s.categoryRepo.GetDescendantIDs(id)
+		if err != nil {
+			return err
+		}
+		if parentID == id || slices.Contains(descendantIDs, parentID) {
+			return apperror.ErrInvalidRequest
+		}
+	}
+
+	duplicated, err := s.categoryRepo.ExistsByName(authUserID, parentID, category.Name)
 	if err != nil {
 		return err
 	}
-	if !isOwner {
+	if duplicated {
+		return apperror.ErrRecordDuplication
+	}
+
+	category.ParentID = parentID
+
+	return s.categoryRepo.Update(category)
+}
+
+// MergeCategory files the category's expenses and children under the target, then deletes it.
+func (s *categoryService) MergeCategory(id uint, targetID uint, authUserID uint, dto MergeRequest) (*MergeResult, error) {
+	if _, err := s.categoryRepo.GetByIDAndUser(id, &authUserID); err != nil {
+		return nil, apperror.ErrNotFound
+	}
+
+	if err := s.checkVisible(targetID, authUserID); err != nil {
+		return nil, err
+	}
+
+	// the target must not sit below the source, or its children would be moved under themselves
+	descendantIDs, err := s.categoryRepo.GetDescendantIDs(id)
+	if err != nil {
+		return nil, err
+	}
+	if targetID == id || slices.Contains(descendantIDs, targetID) {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	children, err := s.getChildren(id, authUserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range children {
+		duplicated, err := s.categoryRepo.ExistsByName(authUserID, targetID, child.Name)
+		if err != nil {
+			return nil, err
+		}
+		if duplicated {
+			return nil, apperror.ErrRecordDuplication
+		}
+	}
+
+	count, err := s.categoryRepo.CountExpenses(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MergeResult{Expenses: count, Preview: dto.Preview}
+	if dto.Preview {
+		return result, nil
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		categoryRepo := s.categoryRepo.WithTx(tx)
+
+		if err := s.reassign(tx, categoryRepo, id, targetID, authUserID); err != nil {
+			return err
+		}
+
+		if err := categoryRepo.Reparent(id, targetID); err != nil {
+			return err
+		}
+
+		return categoryRepo.Delete(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *categoryService) GetCategoryUsage(id uint, authUserID uint) (*CategoryUsage, error) {
+	if err := s.checkVisible(id, authUserID); err != nil {
+		return nil, err
+	}
+
+	return s.categoryRepo.GetUsage(id, authUserID)
+}
+
+// DeleteCategory refuses a category still in use unless the strategy says where its expenses go.
+// Its children are handed over to its own parent so nothing is left dangling.
+func (s *categoryService) DeleteCategory(id uint, authUserID uint, dto DeleteCategoryRequest) error {
+	category, err := s.categoryRepo.GetByIDAndUser(id, &authUserID)
+	if err != nil {
 		return apperror.ErrUnauthorized
 	}
 
-	return s.categoryRepo.Delete(id)
+	children, err := s.getChildren(id, authUserID)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		duplicated, err := s.categoryRepo.ExistsByName(authUserID, category.ParentID, child.Name)
+		if err != nil {
+			return err
+		}
+		if duplicated {
+			return apperror.ErrRecordDuplication
+		}
+	}
+
+	usage, err := s.categoryRepo.GetUsage(id, authUserID)
+	if err != nil {
+		return err
+	}
+
+	strategy := dto.Strategy
+	if !usage.InUse() {
+		strategy = ""
+	}
+
+	var targetID uint
+	switch strategy {
+	case "":
+		if usage.InUse() {
+			return apperror.ErrConflict
+		}
+	case DeleteStrategyReassign:
+		if err := s.checkVisible(dto.TargetID, authUserID); err != nil {
+			return err
+		}
+		targetID = dto.TargetID
+	case DeleteStrategyUncategorized:
+		uncategorized, err := s.findUncategorized(authUserID)
+		if err != nil {
+			return err
+		}
+		// when missing, it is created for the user along with the delete
+		if uncategorized != nil {
+			targetID = uncategorized.ID
+		}
+	}
+	if targetID != 0 && targetID == id {
+		return apperror.ErrInvalidRequest
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		categoryRepo := s.categoryRepo.WithTx(tx)
+
+		if strategy == DeleteStrategyUncategorized && targetID == 0 {
+			uncategorized := &CategoryEntity{UserID: authUserID, Name: UncategorizedName}
+			if err := categoryRepo.Create(uncategorized); err != nil {
+				return err
+			}
+			targetID = uncategorized.ID
+		}
+
+		switch strategy {
+		case DeleteStrategyReassign, DeleteStrategyUncategorized:
+			if err := s.reassign(tx, categoryRepo, id, targetID, authUserID); err != nil {
+				return err
+			}
+		case DeleteStrategyCascade:
+			expenseIDs, err := categoryRepo.GetExpenseIDs(id)
+			if err != nil {
+				return err
+			}
+
+			if err := categoryRepo.DeleteUsages(id); err != nil {
+				return err
+			}
+
+			revisionRepo := s.revisionRepo.WithTx(tx)
+			for _, expenseID := range expenseIDs {
+				if err := RecordRevision(revisionRepo, ExpenseEntity{Model: gorm.Model{ID: expenseID}}, nil, authUserID, RevisionActionDelete); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := categoryRepo.Reparent(id, category.ParentID); err != nil {
+			return err
+		}
+
+		return categoryRepo.Delete(id)
+	})
+}
+
+// reassign files everything under one category under another, recording the change on each expense it moves.
+func (s *categoryService) reassign(tx *gorm.DB, categoryRepo CategoryRepository, fromID uint, toID uint, authUserID uint) error {
+	expenseIDs, err := categoryRepo.GetExpenseIDs(fromID)
+	if err != nil {
+		return err
+	}
+
+	return recordRevisions(s.expenseRepo.WithTx(tx), s.revisionRepo.WithTx(tx), expenseIDs, authUserID, func() error {
+		return categoryRepo.Reassign(fromID, toID)
+	})
+}
+
+// findUncategorized finds the top-level "Uncategorized" category, the user's own or else a default one.
+// It returns nil when there is neither.
+func (s *categoryService) findUncategorized(authUserID uint) (*CategoryEntity, error) {
+	categories, err := s.categoryRepo.GetByNames(authUserID, []string{UncategorizedName})
+	if err != nil {
+		return nil, err
+	}
+
+	var found *CategoryEntity
+	for i := range categories {
+		category := &categories[i]
+		if category.ParentID != 0 {
+			continue
+		}
+		// a user's own one wins over the default
+		if found == nil || category.UserID == authUserID {
+			found = category
+		}
+	}
+
+	return found, nil
+}
+
+// checkVisible accepts the user's own categories and the default ones.
+func (s *categoryService) checkVisible(id uint, authUserID uint) error {
+	var defaultUserID uint
+	for _, userID := range []uint{authUserID, defaultUserID} {
+		_, err := s.categoryRepo.GetByIDAndUser(id, &userID)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+	}
+
+	return apperror.ErrNotFound
+}
+
+func (s *categoryService) getChildren(id uint, authUserID uint) ([]CategoryEntity, error) {
+	categories, err := s.categoryRepo.GetByUser(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	children := []CategoryEntity{}
+	for _, category := range categories {
+		if category.ParentID == id {
+			children = append(children, category)
+		}
+	}
+
+	return children, nil
 }
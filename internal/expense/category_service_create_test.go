@@ -5,6 +5,7 @@ import (
 
 	"github.com/Perajit/expense-tracker-go/internal/expense"
 	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -17,8 +18,10 @@ func TestCreateCategory(t *testing.T) {
 		}
 		var newEntity *expense.CategoryEntity
 
+		db := testutil.SetupDB()
+
 		mockCategoryRepo := new(mocks.MockCategoryRepository)
-		mockCategoryRepo.On("ExistsByName", userID, dto.Name).Return(false, nil).Once()
+		mockCategoryRepo.On("ExistsByName", userID, uint(0), dto.Name).Return(false, nil).Once()
 		mockCategoryRepo.On("Create", mock.MatchedBy(func(e *expense.CategoryEntity) bool {
 			if e.UserID != userID || e.Name != dto.Name {
 				return false
@@ -27,7 +30,7 @@ func TestCreateCategory(t *testing.T) {
 			return true
 		})).Return(nil).Once()
 
-		service := expense.NewCategoryService(mockCategoryRepo)
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
 		entity, err := service.CreateCategory(userID, dto)
 
 		assert.Equal(t, newEntity, entity)
@@ -3,24 +3,201 @@ package expense_test
 import (
 	"testing"
 
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
 	"github.com/Perajit/expense-tracker-go/internal/expense"
 	expenseMocks "github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 func TestDeleteCategory(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		var id uint = 1
 		var userID uint = 11
+		category := &expense.CategoryEntity{Model: gorm.Model{ID: id}, UserID: userID, ParentID: 5, Name: "Food"}
+		categories := []expense.CategoryEntity{
+			*category,
+			{Model: gorm.Model{ID: 2}, UserID: userID, ParentID: id, Name: "Groceries"},
+			{Model: gorm.Model{ID: 3}, UserID: userID, Name: "Travel"},
+		}
+
+		db := testutil.SetupDB()
 
 		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
-		mockCategoryRepo.On("IsOwner", id, userID).Return(true, nil).Once()
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(category, nil).Once()
+		mockCategoryRepo.On("GetByUser", userID).Return(categories, nil).Once()
+		mockCategoryRepo.On("ExistsByName", userID, uint(5), "Groceries").Return(false, nil).Once()
+		mockCategoryRepo.On("GetUsage", id, userID).Return(&expense.CategoryUsage{}, nil).Once()
+		mockCategoryRepo.On("WithTx", mock.Anything).Return(mockCategoryRepo).Once()
+		mockCategoryRepo.On("Reparent", id, uint(5)).Return(nil).Once()
 		mockCategoryRepo.On("Delete", id).Return(nil).Once()
 
-		service := expense.NewCategoryService(mockCategoryRepo)
-		err := service.DeleteCategory(id, userID)
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(expenseMocks.MockExpenseRepository), new(expenseMocks.MockRevisionRepository))
+		err := service.DeleteCategory(id, userID, expense.DeleteCategoryRequest{})
 
 		assert.Nil(t, err)
 		mockCategoryRepo.AssertExpectations(t)
 	})
+
+	t.Run("error_child_name_taken", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+		category := &expense.CategoryEntity{Model: gorm.Model{ID: id}, UserID: userID, Name: "Food"}
+		categories := []expense.CategoryEntity{
+			*category,
+			{Model: gorm.Model{ID: 2}, UserID: userID, ParentID: id, Name: "Other"},
+		}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(category, nil).Once()
+		mockCategoryRepo.On("GetByUser", userID).Return(categories, nil).Once()
+		mockCategoryRepo.On("ExistsByName", userID, uint(0), "Other").Return(true, nil).Once()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(expenseMocks.MockExpenseRepository), new(expenseMocks.MockRevisionRepository))
+		err := service.DeleteCategory(id, userID, expense.DeleteCategoryRequest{})
+
+		assert.ErrorIs(t, err, apperror.ErrRecordDuplication)
+		mockCategoryRepo.AssertNotCalled(t, "Delete", id)
+	})
+
+	t.Run("error_in_use", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+		category := &expense.CategoryEntity{Model: gorm.Model{ID: id}, UserID: userID, Name: "Taxi"}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(category, nil).Once()
+		mockCategoryRepo.On("GetByUser", userID).Return([]expense.CategoryEntity{*category}, nil).Once()
+		mockCategoryRepo.On("GetUsage", id, userID).Return(&expense.CategoryUsage{Expenses: 2}, nil).Once()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(expenseMocks.MockExpenseRepository), new(expenseMocks.MockRevisionRepository))
+		err := service.DeleteCategory(id, userID, expense.DeleteCategoryRequest{})
+
+		assert.ErrorIs(t, err, apperror.ErrConflict)
+		mockCategoryRepo.AssertNotCalled(t, "Delete", id)
+	})
+
+	t.Run("error_in_use_by_split", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+		category := &expense.CategoryEntity{Model: gorm.Model{ID: id}, UserID: userID, Name: "Snacks"}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(category, nil).Once()
+		mockCategoryRepo.On("GetByUser", userID).Return([]expense.CategoryEntity{*category}, nil).Once()
+		mockCategoryRepo.On("GetUsage", id, userID).Return(&expense.CategoryUsage{Splits: 1}, nil).Once()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(expenseMocks.MockExpenseRepository), new(expenseMocks.MockRevisionRepository))
+		err := service.DeleteCategory(id, userID, expense.DeleteCategoryRequest{})
+
+		assert.ErrorIs(t, err, apperror.ErrConflict)
+		mockCategoryRepo.AssertNotCalled(t, "Delete", id)
+	})
+
+	t.Run("success_uncategorized", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+		category := &expense.CategoryEntity{Model: gorm.Model{ID: id}, UserID: userID, Name: "Taxi"}
+		uncategorized := expense.CategoryEntity{Model: gorm.Model{ID: 9}, Name: expense.UncategorizedName, IsDefault: true}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(category, nil).Once()
+		mockCategoryRepo.On("GetByUser", userID).Return([]expense.CategoryEntity{*category, uncategorized}, nil).Once()
+		mockCategoryRepo.On("GetUsage", id, userID).Return(&expense.CategoryUsage{Expenses: 2, Budgets: 1}, nil).Once()
+		mockCategoryRepo.On("GetByNames", userID, []string{expense.UncategorizedName}).Return([]expense.CategoryEntity{uncategorized}, nil).Once()
+		mockCategoryRepo.On("WithTx", mock.Anything).Return(mockCategoryRepo).Once()
+		mockCategoryRepo.On("GetExpenseIDs", id).Return([]uint{}, nil).Once()
+		mockCategoryRepo.On("Reassign", id, uncategorized.ID).Return(nil).Once()
+		mockCategoryRepo.On("Reparent", id, uint(0)).Return(nil).Once()
+		mockCategoryRepo.On("Delete", id).Return(nil).Once()
+
+		mockExpenseRepo := new(expenseMocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+
+		mockRevisionRepo := new(expenseMocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, mockExpenseRepo, mockRevisionRepo)
+		err := service.DeleteCategory(id, userID, expense.DeleteCategoryRequest{Strategy: expense.DeleteStrategyUncategorized})
+
+		assert.NoError(t, err)
+		mockCategoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_uncategorized_created", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+		var uncategorizedID uint = 12
+		category := &expense.CategoryEntity{Model: gorm.Model{ID: id}, UserID: userID, Name: "Taxi"}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(category, nil).Once()
+		mockCategoryRepo.On("GetByUser", userID).Return([]expense.CategoryEntity{*category}, nil).Once()
+		mockCategoryRepo.On("GetUsage", id, userID).Return(&expense.CategoryUsage{Expenses: 2}, nil).Once()
+		mockCategoryRepo.On("GetByNames", userID, []string{expense.UncategorizedName}).Return([]expense.CategoryEntity{}, nil).Once()
+		mockCategoryRepo.On("WithTx", mock.Anything).Return(mockCategoryRepo).Once()
+		mockCategoryRepo.On("Create", &expense.CategoryEntity{UserID: userID, Name: expense.UncategorizedName}).Run(func(args mock.Arguments) {
+			args.Get(0).(*expense.CategoryEntity).ID = uncategorizedID
+		}).Return(nil).Once()
+		mockCategoryRepo.On("GetExpenseIDs", id).Return([]uint{}, nil).Once()
+		mockCategoryRepo.On("Reassign", id, uncategorizedID).Return(nil).Once()
+		mockCategoryRepo.On("Reparent", id, uint(0)).Return(nil).Once()
+		mockCategoryRepo.On("Delete", id).Return(nil).Once()
+
+		mockExpenseRepo := new(expenseMocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+
+		mockRevisionRepo := new(expenseMocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, mockExpenseRepo, mockRevisionRepo)
+		err := service.DeleteCategory(id, userID, expense.DeleteCategoryRequest{Strategy: expense.DeleteStrategyUncategorized})
+
+		assert.NoError(t, err)
+		mockCategoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_cascade", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+		category := &expense.CategoryEntity{Model: gorm.Model{ID: id}, UserID: userID, Name: "Taxi"}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(category, nil).Once()
+		mockCategoryRepo.On("GetByUser", userID).Return([]expense.CategoryEntity{*category}, nil).Once()
+		mockCategoryRepo.On("GetUsage", id, userID).Return(&expense.CategoryUsage{Expenses: 2}, nil).Once()
+		mockCategoryRepo.On("WithTx", mock.Anything).Return(mockCategoryRepo).Once()
+		mockCategoryRepo.On("GetExpenseIDs", id).Return([]uint{4, 5}, nil).Once()
+		mockCategoryRepo.On("DeleteUsages", id).Return(nil).Once()
+		mockCategoryRepo.On("Reparent", id, uint(0)).Return(nil).Once()
+		mockCategoryRepo.On("Delete", id).Return(nil).Once()
+
+		mockRevisionRepo := new(expenseMocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.Action == expense.RevisionActionDelete && r.ActorID == userID
+		})).Return(nil).Twice()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(expenseMocks.MockExpenseRepository), mockRevisionRepo)
+		err := service.DeleteCategory(id, userID, expense.DeleteCategoryRequest{Strategy: expense.DeleteStrategyCascade})
+
+		assert.NoError(t, err)
+		mockCategoryRepo.AssertExpectations(t)
+		mockRevisionRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertNotCalled(t, "Reassign", mock.Anything, mock.Anything)
+	})
 }
@@ -1,10 +1,12 @@
 package expense_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/Perajit/expense-tracker-go/internal/expense"
 	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 )
@@ -19,10 +21,12 @@ func TestGetCategoryByID(t *testing.T) {
 			UserID: userID,
 		}
 
+		db := testutil.SetupDB()
+
 		mockCategoryRepo := new(mocks.MockCategoryRepository)
 		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(matchedCategory, nil).Once()
 
-		service := expense.NewCategoryService(mockCategoryRepo)
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
 		entity, err := service.GetCategoryByID(id, &userID)
 
 		assert.Equal(t, matchedCategory, entity)
@@ -31,6 +35,58 @@ func TestGetCategoryByID(t *testing.T) {
 	})
 }
 
+func TestIsCategoryOwner(t *testing.T) {
+	var id uint = 9
+	var userID uint = 11
+	var defaultUserID uint = 0
+
+	t.Run("success_default", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(nil, gorm.ErrRecordNotFound).Once()
+		mockCategoryRepo.On("GetByIDAndUser", id, &defaultUserID).Return(&expense.CategoryEntity{Model: gorm.Model{ID: id}, IsDefault: true}, nil).Once()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
+		isOwner, err := service.IsCategoryOwner(id, userID)
+
+		assert.True(t, isOwner)
+		assert.NoError(t, err)
+		mockCategoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_other_user", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(nil, gorm.ErrRecordNotFound).Once()
+		mockCategoryRepo.On("GetByIDAndUser", id, &defaultUserID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
+		isOwner, err := service.IsCategoryOwner(id, userID)
+
+		assert.False(t, isOwner)
+		assert.NoError(t, err)
+		mockCategoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_db", func(t *testing.T) {
+		expectedErr := errors.New("db error")
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(nil, expectedErr).Once()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
+		isOwner, err := service.IsCategoryOwner(id, userID)
+
+		assert.False(t, isOwner)
+		assert.Equal(t, expectedErr, err)
+		mockCategoryRepo.AssertNotCalled(t, "GetByIDAndUser", id, &defaultUserID)
+	})
+}
+
 func TestGetCategories(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		var userID uint = 11
@@ -39,10 +95,12 @@ func TestGetCategories(t *testing.T) {
 			{Model: gorm.Model{ID: 2}, UserID: userID, Name: "cat2"},
 		}
 
+		db := testutil.SetupDB()
+
 		mockCategoryRepo := new(mocks.MockCategoryRepository)
 		mockCategoryRepo.On("GetByUser", userID).Return(matchedList, nil).Once()
 
-		service := expense.NewCategoryService(mockCategoryRepo)
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
 		list, err := service.GetCategories(userID)
 
 		assert.Equal(t, matchedList, list)
@@ -50,3 +108,37 @@ func TestGetCategories(t *testing.T) {
 		mockCategoryRepo.AssertExpectations(t)
 	})
 }
+
+func TestGetCategoryTree(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var userID uint = 11
+		matchedList := []expense.CategoryEntity{
+			{Model: gorm.Model{ID: 1}, Name: "Food", IsDefault: true},
+			{Model: gorm.Model{ID: 2}, UserID: userID, ParentID: 1, Name: "Restaurants"},
+			{Model: gorm.Model{ID: 3}, UserID: userID, ParentID: 1, Name: "Groceries"},
+			{Model: gorm.Model{ID: 4}, UserID: userID, ParentID: 3, Name: "Other"},
+			{Model: gorm.Model{ID: 5}, UserID: userID, Name: "Other"},
+		}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByUser", userID).Return(matchedList, nil).Once()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
+		tree, err := service.GetCategoryTree(userID)
+
+		assert.NoError(t, err)
+		if assert.Len(t, tree, 2) {
+			assert.Equal(t, "Food", tree[0].Name)
+			assert.Equal(t, "Other", tree[1].Name)
+			assert.Empty(t, tree[1].Children)
+			if assert.Len(t, tree[0].Children, 2) {
+				assert.Equal(t, "Groceries", tree[0].Children[0].Name)
+				assert.Equal(t, "Restaurants", tree[0].Children[1].Name)
+				assert.Equal(t, uint(4), tree[0].Children[0].Children[0].ID)
+			}
+		}
+		mockCategoryRepo.AssertExpectations(t)
+	})
+}
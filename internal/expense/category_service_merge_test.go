@@ -0,0 +1,113 @@
+package expense_test
+
+import (
+	"testing"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestMergeCategory(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var id uint = 1
+		var targetID uint = 2
+		var userID uint = 11
+		source := &expense.CategoryEntity{Model: gorm.Model{ID: id}, UserID: userID, Name: "Taxis"}
+		target := &expense.CategoryEntity{Model: gorm.Model{ID: targetID}, UserID: userID, Name: "Taxi"}
+		categories := []expense.CategoryEntity{
+			*source,
+			*target,
+			{Model: gorm.Model{ID: 3}, UserID: userID, ParentID: id, Name: "Airport"},
+		}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(source, nil).Once()
+		mockCategoryRepo.On("GetByIDAndUser", targetID, &userID).Return(target, nil).Once()
+		mockCategoryRepo.On("GetDescendantIDs", id).Return([]uint{3}, nil).Once()
+		mockCategoryRepo.On("GetByUser", userID).Return(categories, nil).Once()
+		mockCategoryRepo.On("ExistsByName", userID, targetID, "Airport").Return(false, nil).Once()
+		mockCategoryRepo.On("CountExpenses", id).Return(int64(4), nil).Once()
+		mockCategoryRepo.On("WithTx", mock.Anything).Return(mockCategoryRepo).Once()
+		mockCategoryRepo.On("GetExpenseIDs", id).Return([]uint{7}, nil).Once()
+		mockCategoryRepo.On("Reassign", id, targetID).Return(nil).Once()
+		mockCategoryRepo.On("Reparent", id, targetID).Return(nil).Once()
+		mockCategoryRepo.On("Delete", id).Return(nil).Once()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("GetByIDs", []uint{7}).Return([]expense.ExpenseEntity{{Model: gorm.Model{ID: 7}, CategoryID: id}}, nil).Once()
+		mockExpenseRepo.On("GetByIDs", []uint{7}).Return([]expense.ExpenseEntity{{Model: gorm.Model{ID: 7}, CategoryID: targetID}}, nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", &expense.ExpenseRevisionEntity{
+			ExpenseID: 7,
+			ActorID:   userID,
+			Action:    expense.RevisionActionUpdate,
+			Changes:   expense.FieldChanges{{Field: "categoryId", Before: "1", After: "2"}},
+		}).Return(nil).Once()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, mockExpenseRepo, mockRevisionRepo)
+		result, err := service.MergeCategory(id, targetID, userID, expense.MergeRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &expense.MergeResult{Expenses: 4}, result)
+		mockCategoryRepo.AssertExpectations(t)
+		mockRevisionRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_preview", func(t *testing.T) {
+		var id uint = 1
+		var targetID uint = 2
+		var userID uint = 11
+		source := &expense.CategoryEntity{Model: gorm.Model{ID: id}, UserID: userID, Name: "Taxis"}
+		target := &expense.CategoryEntity{Model: gorm.Model{ID: targetID}, UserID: userID, Name: "Taxi"}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(source, nil).Once()
+		mockCategoryRepo.On("GetByIDAndUser", targetID, &userID).Return(target, nil).Once()
+		mockCategoryRepo.On("GetDescendantIDs", id).Return([]uint{}, nil).Once()
+		mockCategoryRepo.On("GetByUser", userID).Return([]expense.CategoryEntity{*source, *target}, nil).Once()
+		mockCategoryRepo.On("CountExpenses", id).Return(int64(4), nil).Once()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
+		result, err := service.MergeCategory(id, targetID, userID, expense.MergeRequest{Preview: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &expense.MergeResult{Expenses: 4, Preview: true}, result)
+		mockCategoryRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertNotCalled(t, "Reassign", mock.Anything, mock.Anything)
+		mockCategoryRepo.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+
+	t.Run("error_target_below_source", func(t *testing.T) {
+		var id uint = 1
+		var targetID uint = 3
+		var userID uint = 11
+		source := &expense.CategoryEntity{Model: gorm.Model{ID: id}, UserID: userID, Name: "Transport"}
+		target := &expense.CategoryEntity{Model: gorm.Model{ID: targetID}, UserID: userID, ParentID: id, Name: "Taxi"}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(source, nil).Once()
+		mockCategoryRepo.On("GetByIDAndUser", targetID, &userID).Return(target, nil).Once()
+		mockCategoryRepo.On("GetDescendantIDs", id).Return([]uint{targetID}, nil).Once()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
+		result, err := service.MergeCategory(id, targetID, userID, expense.MergeRequest{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockCategoryRepo.AssertNotCalled(t, "Reassign", mock.Anything, mock.Anything)
+	})
+}
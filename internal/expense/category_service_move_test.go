@@ -0,0 +1,82 @@
+package expense_test
+
+import (
+	"testing"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestMoveCategory(t *testing.T) {
+	t.Run("success_under_default", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+		var defaultUserID uint = 0
+		var parentID uint = 7
+		category := &expense.CategoryEntity{Model: gorm.Model{ID: id}, UserID: userID, Name: "Groceries"}
+		parent := &expense.CategoryEntity{Model: gorm.Model{ID: parentID}, Name: "Food", IsDefault: true}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(category, nil).Once()
+		mockCategoryRepo.On("GetByIDAndUser", parentID, &userID).Return(nil, gorm.ErrRecordNotFound).Once()
+		mockCategoryRepo.On("GetByIDAndUser", parentID, &defaultUserID).Return(parent, nil).Once()
+		mockCategoryRepo.On("GetDescendantIDs", id).Return([]uint{2, 3}, nil).Once()
+		mockCategoryRepo.On("ExistsByName", userID, parentID, "Groceries").Return(false, nil).Once()
+		mockCategoryRepo.On("Update", mock.MatchedBy(func(e *expense.CategoryEntity) bool {
+			return e.ID == id && e.ParentID == parentID
+		})).Return(nil).Once()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
+		err := service.MoveCategory(id, userID, expense.MoveCategoryRequest{ParentID: &parentID})
+
+		assert.NoError(t, err)
+		mockCategoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_cycle", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+		var parentID uint = 3
+		category := &expense.CategoryEntity{Model: gorm.Model{ID: id}, UserID: userID, Name: "Food"}
+		child := &expense.CategoryEntity{Model: gorm.Model{ID: parentID}, UserID: userID, ParentID: 2, Name: "Snacks"}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(category, nil).Once()
+		mockCategoryRepo.On("GetByIDAndUser", parentID, &userID).Return(child, nil).Once()
+		mockCategoryRepo.On("GetDescendantIDs", id).Return([]uint{2, 3}, nil).Once()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
+		err := service.MoveCategory(id, userID, expense.MoveCategoryRequest{ParentID: &parentID})
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockCategoryRepo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("error_name_taken", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+		var parentID uint = 0
+		category := &expense.CategoryEntity{Model: gorm.Model{ID: id}, UserID: userID, ParentID: 5, Name: "Other"}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(category, nil).Once()
+		mockCategoryRepo.On("ExistsByName", userID, parentID, "Other").Return(true, nil).Once()
+
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
+		err := service.MoveCategory(id, userID, expense.MoveCategoryRequest{ParentID: &parentID})
+
+		assert.ErrorIs(t, err, apperror.ErrRecordDuplication)
+		mockCategoryRepo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Perajit/expense-tracker-go/internal/expense"
 	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
@@ -23,9 +24,11 @@ func TestUpdateCategory(t *testing.T) {
 			Name:   "cat1",
 		}
 
+		db := testutil.SetupDB()
+
 		mockCategoryRepo := new(mocks.MockCategoryRepository)
 		mockCategoryRepo.On("GetByIDAndUser", id, &userID).Return(existingEntity, nil).Once()
-		mockCategoryRepo.On("ExistsByName", userID, newName).Return(false, nil).Once()
+		mockCategoryRepo.On("ExistsByName", userID, uint(0), newName).Return(false, nil).Once()
 		mockCategoryRepo.On("Update", mock.MatchedBy(func(e *expense.CategoryEntity) bool {
 			if e.ID != id || e.UserID != userID {
 				return false
@@ -36,7 +39,7 @@ func TestUpdateCategory(t *testing.T) {
 			return true
 		})).Return(nil).Once()
 
-		service := expense.NewCategoryService(mockCategoryRepo)
+		service := expense.NewCategoryService(db, mockCategoryRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
 		err := service.UpdateCategory(id, userID, expense.UpdateCategoryRequest(dto))
 
 		assert.Nil(t, err)
@@ -0,0 +1,55 @@
+package expense
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// DefaultDuplicateWindowDays is how far apart two expenses may be dated and still count as duplicates.
+	DefaultDuplicateWindowDays = 3
+	// duplicateNoteThreshold is the least note similarity two expenses need to count as duplicates.
+	duplicateNoteThreshold = 0.5
+)
+
+// NoteSimilarity compares the words of two notes, ignoring case and punctuation,
+// and returns the share of words they have in common, from 0 to 1. Two blank notes are alike.
+func NoteSimilarity(a string, b string) float64 {
+	wordsA, wordsB := noteWords(a), noteWords(b)
+	if len(wordsA) == 0 && len(wordsB) == 0 {
+		return 1
+	}
+
+	common := 0
+	for word := range wordsA {
+		if wordsB[word] {
+			common++
+		}
+	}
+
+	return float64(common) / float64(len(wordsA)+len(wordsB)-common)
+}
+
+func noteWords(note string) map[string]bool {
+	words := map[string]bool{}
+	for _, word := range strings.FieldsFunc(strings.ToLower(note), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) {
+		words[word] = true
+	}
+
+	return words
+}
+
+// mergeNotes keeps both notes unless one already says what the other does.
+func mergeNotes(a string, b string) string {
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+	switch {
+	case b == "" || strings.Contains(strings.ToLower(a), strings.ToLower(b)):
+		return a
+	case a == "" || strings.Contains(strings.ToLower(b), strings.ToLower(a)):
+		return b
+	default:
+		return a + "; " + b
+	}
+}
@@ -0,0 +1,86 @@
+package expense
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type DuplicateAction string
+
+const (
+	DuplicateActionMerge   DuplicateAction = "merge"
+	DuplicateActionDismiss DuplicateAction = "dismiss"
+)
+
+type GetDuplicatesRequest struct {
+	Days *int `query:"days" validate:"omitempty,min=0,max=31"`
+}
+
+func (dto GetDuplicatesRequest) Window() int64 {
+	days := DefaultDuplicateWindowDays
+	if dto.Days != nil {
+		days = *dto.Days
+	}
+
+	return int64(days) * 24 * 60 * 60
+}
+
+// ResolveDuplicateRequest either merges OtherID into ExpenseID, or marks the pair as not duplicates.
+type ResolveDuplicateRequest struct {
+	ExpenseID uint   `json:"expenseId" validate:"required"`
+	OtherID   uint   `json:"otherId" validate:"required,nefield=ExpenseID"`
+	Action    string `json:"action" validate:"required,oneof=merge dismiss"`
+}
+
+// DuplicateRow is a pair of live expenses with the same kind, amount and currency dated close together.
+type DuplicateRow struct {
+	ExpenseID uint
+	OtherID   uint
+	Kind      Kind
+	Amount    decimal.Decimal
+	Currency  string
+	Date      int64
+	OtherDate int64
+	Note      string
+	OtherNote string
+}
+
+type DuplicateResponse struct {
+	ExpenseID  uint            `json:"expenseId"`
+	OtherID    uint            `json:"otherId"`
+	Kind       Kind            `json:"kind"`
+	Amount     decimal.Decimal `json:"amount"`
+	Currency   string          `json:"currency"`
+	Date       time.Time       `json:"date"`
+	OtherDate  time.Time       `json:"otherDate"`
+	Note       string          `json:"note"`
+	OtherNote  string          `json:"otherNote"`
+	Similarity float64         `json:"similarity"`
+}
+
+func (DuplicateResponse) FromRow(row DuplicateRow) DuplicateResponse {
+	return DuplicateResponse{
+		ExpenseID:  row.ExpenseID,
+		OtherID:    row.OtherID,
+		Kind:       row.Kind,
+		Amount:     row.Amount,
+		Currency:   row.Currency,
+		Date:       time.Unix(row.Date, 0),
+		OtherDate:  time.Unix(row.OtherDate, 0),
+		Note:       row.Note,
+		OtherNote:  row.OtherNote,
+		Similarity: NoteSimilarity(row.Note, row.OtherNote),
+	}
+}
+
+// DuplicateWarning flags a newly created expense that looks like one the user already has.
+type DuplicateWarning struct {
+	Message      string `json:"message"`
+	CandidateIDs []uint `json:"candidateIds"`
+}
+
+type CreateExpenseResponse struct {
+	*ExpenseEntity
+	Warning *DuplicateWarning `json:"warning,omitempty"`
+}
@@ -0,0 +1,17 @@
+package expense
+
+import "time"
+
+// DuplicateDismissalEntity records a pair of expenses the user said are not duplicates.
+// ExpenseID is always the lower of the two ids.
+type DuplicateDismissalEntity struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UserID    uint `gorm:"not null;index"`
+	ExpenseID uint `gorm:"not null;uniqueIndex:idx_duplicate_dismissals_pair"`
+	OtherID   uint `gorm:"not null;uniqueIndex:idx_duplicate_dismissals_pair"`
+}
+
+func (DuplicateDismissalEntity) TableName() string {
+	return "duplicate_dismissals"
+}
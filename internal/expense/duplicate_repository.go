@@ -0,0 +1,60 @@
+package expense
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DuplicateRepository interface {
+	WithTx(tx *gorm.DB) DuplicateRepository
+	FindPairs(userID uint, window int64, expenseID *uint) ([]DuplicateRow, error)
+	Dismiss(dismissal *DuplicateDismissalEntity) error
+}
+
+type duplicateRepository struct {
+	db *gorm.DB
+}
+
+func NewDuplicateRepository(db *gorm.DB) DuplicateRepository {
+	return &duplicateRepository{db: db}
+}
+
+func (r *duplicateRepository) WithTx(tx *gorm.DB) DuplicateRepository {
+	if tx == nil {
+		return r
+	}
+
+	return &duplicateRepository{db: tx}
+}
+
+// FindPairs returns the pairs of live expenses that have the same kind, amount and currency
+// and are dated at most window seconds apart, leaving out dismissed pairs.
+// With an expense id only the pairs that include that expense are returned.
+func (r *duplicateRepository) FindPairs(userID uint, window int64, expenseID *uint) ([]DuplicateRow, error) {
+	db := r.db.Table("expenses a").
+		Select("a.id AS expense_id, b.id AS other_id, a.kind, a.amount, a.currency, a.date, b.date AS other_date, a.note, b.note AS other_note").
+		Joins("JOIN expenses b ON b.user_id = a.user_id AND b.id > a.id AND b.deleted_at IS NULL "+
+			"AND b.kind = a.kind AND b.amount = a.amount AND b.currency = a.currency AND ABS(b.date - a.date) <= ?", window).
+		Where("a.user_id = ?", userID).
+		Where("a.deleted_at IS NULL").
+		Where("NOT EXISTS (SELECT 1 FROM duplicate_dismissals d WHERE d.expense_id = a.id AND d.other_id = b.id)")
+
+	if expenseID != nil {
+		db = db.Where("a.id = ? OR b.id = ?", *expenseID, *expenseID)
+	}
+
+	var rows []DuplicateRow
+	if err := db.Order("a.date DESC, a.id, b.id").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (r *duplicateRepository) Dismiss(dismissal *DuplicateDismissalEntity) error {
+	if dismissal.ExpenseID > dismissal.OtherID {
+		dismissal.ExpenseID, dismissal.OtherID = dismissal.OtherID, dismissal.ExpenseID
+	}
+
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(dismissal).Error
+}
@@ -0,0 +1,138 @@
+package expense
+
+import (
+	"slices"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"gorm.io/gorm"
+)
+
+type DuplicateService interface {
+	GetDuplicates(authUserID uint, dto GetDuplicatesRequest) ([]DuplicateRow, error)
+	FindDuplicates(id uint, authUserID uint) ([]uint, error)
+	ResolveDuplicate(authUserID uint, dto ResolveDuplicateRequest) error
+}
+
+type duplicateService struct {
+	db            *gorm.DB
+	expenseRepo   ExpenseRepository
+	revisionRepo  RevisionRepository
+	duplicateRepo DuplicateRepository
+}
+
+func NewDuplicateService(db *gorm.DB, expenseRepo ExpenseRepository, revisionRepo RevisionRepository, duplicateRepo DuplicateRepository) DuplicateService {
+	return &duplicateService{
+		db:            db,
+		expenseRepo:   expenseRepo,
+		revisionRepo:  revisionRepo,
+		duplicateRepo: duplicateRepo,
+	}
+}
+
+func (s *duplicateService) GetDuplicates(authUserID uint, dto GetDuplicatesRequest) ([]DuplicateRow, error) {
+	rows, err := s.duplicateRepo.FindPairs(authUserID, dto.Window(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicates := []DuplicateRow{}
+	for _, row := range rows {
+		if NoteSimilarity(row.Note, row.OtherNote) >= duplicateNoteThreshold {
+			duplicates = append(duplicates, row)
+		}
+	}
+
+	return duplicates, nil
+}
+
+// FindDuplicates returns the ids of the expenses that look like duplicates of the given one.
+func (s *duplicateService) FindDuplicates(id uint, authUserID uint) ([]uint, error) {
+	rows, err := s.duplicateRepo.FindPairs(authUserID, GetDuplicatesRequest{}.Window(), &id)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []uint{}
+	for _, row := range rows {
+		if NoteSimilarity(row.Note, row.OtherNote) < duplicateNoteThreshold {
+			continue
+		}
+		if row.ExpenseID == id {
+			ids = append(ids, row.OtherID)
+		} else {
+			ids = append(ids, row.ExpenseID)
+		}
+	}
+
+	return ids, nil
+}
+
+// ResolveDuplicate either folds the other expense's tags and note into the expense and deletes the other,
+// or remembers that the two are not duplicates so the pair is never flagged again.
+// The other expense cannot be merged away while it has splits, as they would be lost; the pair can be resolved
+// the other way round, keeping it.
+func (s *duplicateService) ResolveDuplicate(authUserID uint, dto ResolveDuplicateRequest) error {
+	if dto.ExpenseID == dto.OtherID {
+		return apperror.ErrInvalidRequest
+	}
+
+	expense, err := s.expenseRepo.GetByIDAndUser(dto.ExpenseID, authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	other, err := s.expenseRepo.GetByIDAndUser(dto.OtherID, authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	if DuplicateAction(dto.Action) == DuplicateActionDismiss {
+		return s.duplicateRepo.Dismiss(&DuplicateDismissalEntity{
+			UserID:    authUserID,
+			ExpenseID: expense.ID,
+			OtherID:   other.ID,
+		})
+	}
+
+	if len(other.Splits) > 0 {
+		return apperror.ErrInvalidRequest
+	}
+
+	before := revisionFields(*expense)
+	tags := slices.Clone(expense.Tags)
+	for _, tag := range other.Tags {
+		if !slices.ContainsFunc(tags, func(t TagEntity) bool { return t.ID == tag.ID }) {
+			tags = append(tags, tag)
+		}
+	}
+
+	expense.Note = mergeNotes(expense.Note, other.Note)
+	expense.Tags = tags
+	revised := *expense
+	expense.Category = CategoryEntity{}
+	expense.Tags = nil
+	expense.Splits = nil
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		expenseRepo := s.expenseRepo.WithTx(tx)
+		revisionRepo := s.revisionRepo.WithTx(tx)
+
+		if err := expenseRepo.Update(expense); err != nil {
+			return err
+		}
+
+		if err := expenseRepo.UpdateTags(expense, tags); err != nil {
+			return err
+		}
+
+		if err := RecordRevision(revisionRepo, revised, before, authUserID, RevisionActionUpdate); err != nil {
+			return err
+		}
+
+		if err := expenseRepo.Delete(other.ID); err != nil {
+			return err
+		}
+
+		return RecordRevision(revisionRepo, *other, nil, authUserID, RevisionActionDelete)
+	})
+}
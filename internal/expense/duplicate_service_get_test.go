@@ -0,0 +1,59 @@
+package expense_test
+
+import (
+	"testing"
+
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDuplicates(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var userID uint = 11
+		days := 1
+		rows := []expense.DuplicateRow{
+			{ExpenseID: 1, OtherID: 2, Amount: decimal.NewFromInt(4), Note: "Starbucks coffee", OtherNote: "STARBUCKS #1021 coffee"},
+			{ExpenseID: 3, OtherID: 4, Amount: decimal.NewFromInt(4), Note: "Starbucks", OtherNote: "Bus ticket"},
+			{ExpenseID: 5, OtherID: 6, Amount: decimal.NewFromInt(9), Note: "", OtherNote: ""},
+		}
+
+		db := testutil.SetupDB()
+
+		mockDuplicateRepo := new(mocks.MockDuplicateRepository)
+		mockDuplicateRepo.On("FindPairs", userID, int64(24*60*60), (*uint)(nil)).Return(rows, nil).Once()
+
+		service := expense.NewDuplicateService(db, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository), mockDuplicateRepo)
+		duplicates, err := service.GetDuplicates(userID, expense.GetDuplicatesRequest{Days: &days})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []expense.DuplicateRow{rows[0], rows[2]}, duplicates)
+		mockDuplicateRepo.AssertExpectations(t)
+	})
+}
+
+func TestFindDuplicates(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var id uint = 7
+		var userID uint = 11
+		rows := []expense.DuplicateRow{
+			{ExpenseID: 2, OtherID: id, Note: "Lunch", OtherNote: "lunch"},
+			{ExpenseID: id, OtherID: 9, Note: "lunch", OtherNote: "Lunch with Ann"},
+			{ExpenseID: id, OtherID: 12, Note: "lunch", OtherNote: "Taxi"},
+		}
+
+		db := testutil.SetupDB()
+
+		mockDuplicateRepo := new(mocks.MockDuplicateRepository)
+		mockDuplicateRepo.On("FindPairs", userID, int64(expense.DefaultDuplicateWindowDays*24*60*60), &id).Return(rows, nil).Once()
+
+		service := expense.NewDuplicateService(db, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository), mockDuplicateRepo)
+		ids, err := service.FindDuplicates(id, userID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{2}, ids)
+		mockDuplicateRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,116 @@
+package expense_test
+
+import (
+	"testing"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestResolveDuplicate(t *testing.T) {
+	t.Run("success_merge", func(t *testing.T) {
+		var userID uint = 11
+		tags := []expense.TagEntity{
+			{Model: gorm.Model{ID: 3}, UserID: userID, Name: "food"},
+			{Model: gorm.Model{ID: 4}, UserID: userID, Name: "work"},
+		}
+		kept := &expense.ExpenseEntity{Model: gorm.Model{ID: 1}, UserID: userID, Note: "Lunch", Tags: []expense.TagEntity{tags[0]},
+			Splits: []expense.ExpenseSplitEntity{{ID: 5, ExpenseID: 1, CategoryID: 2}}}
+		other := &expense.ExpenseEntity{Model: gorm.Model{ID: 2}, UserID: userID, Note: "with the team", Tags: tags}
+		dto := expense.ResolveDuplicateRequest{ExpenseID: 1, OtherID: 2, Action: "merge"}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("GetByIDAndUser", dto.ExpenseID, userID).Return(kept, nil).Once()
+		mockExpenseRepo.On("GetByIDAndUser", dto.OtherID, userID).Return(other, nil).Once()
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Update", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.ID == dto.ExpenseID && e.Note == "Lunch; with the team" && e.Splits == nil
+		})).Return(nil).Once()
+		mockExpenseRepo.On("UpdateTags", kept, tags).Return(nil).Once()
+		mockExpenseRepo.On("Delete", dto.OtherID).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.ExpenseID == dto.ExpenseID && r.Action == expense.RevisionActionUpdate && len(r.Changes) == 2
+		})).Return(nil).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.ExpenseID == dto.OtherID && r.Action == expense.RevisionActionDelete
+		})).Return(nil).Once()
+
+		mockDuplicateRepo := new(mocks.MockDuplicateRepository)
+
+		service := expense.NewDuplicateService(db, mockExpenseRepo, mockRevisionRepo, mockDuplicateRepo)
+		err := service.ResolveDuplicate(userID, dto)
+
+		assert.NoError(t, err)
+		mockExpenseRepo.AssertExpectations(t)
+		mockRevisionRepo.AssertExpectations(t)
+		mockDuplicateRepo.AssertNotCalled(t, "Dismiss", mock.Anything)
+	})
+
+	t.Run("success_dismiss", func(t *testing.T) {
+		var userID uint = 11
+		dto := expense.ResolveDuplicateRequest{ExpenseID: 5, OtherID: 2, Action: "dismiss"}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("GetByIDAndUser", dto.ExpenseID, userID).Return(&expense.ExpenseEntity{Model: gorm.Model{ID: 5}}, nil).Once()
+		mockExpenseRepo.On("GetByIDAndUser", dto.OtherID, userID).Return(&expense.ExpenseEntity{Model: gorm.Model{ID: 2}}, nil).Once()
+
+		mockDuplicateRepo := new(mocks.MockDuplicateRepository)
+		mockDuplicateRepo.On("Dismiss", &expense.DuplicateDismissalEntity{UserID: userID, ExpenseID: 5, OtherID: 2}).Return(nil).Once()
+
+		service := expense.NewDuplicateService(db, mockExpenseRepo, new(mocks.MockRevisionRepository), mockDuplicateRepo)
+		err := service.ResolveDuplicate(userID, dto)
+
+		assert.NoError(t, err)
+		mockDuplicateRepo.AssertExpectations(t)
+		mockExpenseRepo.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+
+	t.Run("error_other_has_splits", func(t *testing.T) {
+		var userID uint = 11
+		dto := expense.ResolveDuplicateRequest{ExpenseID: 1, OtherID: 2, Action: "merge"}
+		other := &expense.ExpenseEntity{Model: gorm.Model{ID: 2}, UserID: userID, Splits: []expense.ExpenseSplitEntity{{ID: 5, ExpenseID: 2, CategoryID: 2}}}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("GetByIDAndUser", dto.ExpenseID, userID).Return(&expense.ExpenseEntity{Model: gorm.Model{ID: 1}}, nil).Once()
+		mockExpenseRepo.On("GetByIDAndUser", dto.OtherID, userID).Return(other, nil).Once()
+
+		service := expense.NewDuplicateService(db, mockExpenseRepo, new(mocks.MockRevisionRepository), new(mocks.MockDuplicateRepository))
+		err := service.ResolveDuplicate(userID, dto)
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockExpenseRepo.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+
+	t.Run("error_not_found", func(t *testing.T) {
+		var userID uint = 11
+		dto := expense.ResolveDuplicateRequest{ExpenseID: 1, OtherID: 2, Action: "merge"}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("GetByIDAndUser", dto.ExpenseID, userID).Return(&expense.ExpenseEntity{Model: gorm.Model{ID: 1}}, nil).Once()
+		mockExpenseRepo.On("GetByIDAndUser", dto.OtherID, userID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		mockDuplicateRepo := new(mocks.MockDuplicateRepository)
+
+		service := expense.NewDuplicateService(db, mockExpenseRepo, new(mocks.MockRevisionRepository), mockDuplicateRepo)
+		err := service.ResolveDuplicate(userID, dto)
+
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+		mockExpenseRepo.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+}
@@ -1,5 +1,44 @@
 package expense
 
+import "gorm.io/gorm"
+
 func GetModels() []any {
-	return []any{&ExpenseEntity{}, &CategoryEntity{}, &TagEntity{}}
+	return []any{&ExpenseEntity{}, &CategoryEntity{}, &TagEntity{}, &RecurringExpenseEntity{}, &RecurringOccurrenceEntity{}, &RuleEntity{}, &DuplicateDismissalEntity{}, &ExpenseRevisionEntity{}, &AttachmentEntity{}, &ExpenseSplitEntity{}, &MerchantEntity{}, &MerchantAliasEntity{}}
+}
+
+// MigrateData fixes up rows and indexes that AutoMigrate cannot:
+// negative amounts, which used to stand in for income, become income rows, and the
+// unique name indexes that also covered deleted rows, or made category names unique per user
+// rather than per parent, are dropped. The partial indexes over live rows that replace them are
+// created here from the entities' idx_*_active tags too, whether or not AutoMigrate has already run.
+func MigrateData(db *gorm.DB) error {
+	for _, table := range []string{"expenses", "recurring_expenses"} {
+		if err := db.Exec("UPDATE "+table+" SET kind = ?, amount = -amount WHERE amount < 0", KindIncome).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, index := range []string{"idx_categories_user_name", "idx_categories_user_name_active", "idx_tags_user_name"} {
+		if err := db.Exec("DROP INDEX IF EXISTS " + index).Error; err != nil {
+			return err
+		}
+	}
+
+	activeIndexes := []struct {
+		model any
+		name  string
+	}{
+		{&CategoryEntity{}, "idx_categories_user_parent_name_active"},
+		{&TagEntity{}, "idx_tags_user_name_active"},
+	}
+	for _, index := range activeIndexes {
+		if db.Migrator().HasIndex(index.model, index.name) {
+			continue
+		}
+		if err := db.Migrator().CreateIndex(index.model, index.name); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
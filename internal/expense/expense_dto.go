@@ -1,48 +1,264 @@
 package expense
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
 	"github.com/shopspring/decimal"
 )
 
 type CreateExpenseRequest struct {
 	Date       time.Time       `json:"date" validate:"required"`
+	Kind       string          `json:"kind" validate:"omitempty,oneof=expense income"`
 	Amount     decimal.Decimal `json:"amount" validate:"required"`
+	Currency   string          `json:"currency" validate:"omitempty,iso4217"`
+	AccountID  *uint           `json:"accountId"`
+	MerchantID *uint           `json:"merchantId"`
 	Note       string          `json:"note"`
 	CategoryID uint            `json:"categoyId"`
 	TagIDs     []uint          `json:"tagIds"`
+	Splits     []SplitRequest  `json:"splits" validate:"omitempty,dive"`
+}
+
+// SplitRequest is one line of a split expense.
+type SplitRequest struct {
+	Amount     decimal.Decimal `json:"amount" validate:"required"`
+	CategoryID uint            `json:"categoryId" validate:"required"`
+	Note       string          `json:"note"`
+	TagIDs     []uint          `json:"tagIds"`
+}
+
+// ValidateSplits checks that the splits, if any, are positive and add up to the amount.
+func (dto CreateExpenseRequest) ValidateSplits() error {
+	return validateSplits(dto.Amount, dto.Splits)
 }
 
 type UpdateExpenseRequest struct {
 	Date       *time.Time       `json:"date"`
+	Kind       *string          `json:"kind" validate:"omitempty,oneof=expense income"`
 	Amount     *decimal.Decimal `json:"amount"`
+	Currency   *string          `json:"currency" validate:"omitempty,iso4217"`
+	AccountID  *uint            `json:"accountId"`
+	MerchantID *uint            `json:"merchantId"`
 	Note       *string          `json:"note"`
 	CategoryID *uint            `json:"categoyId"`
 	TagIDs     *[]uint          `json:"tagIds"`
+	Splits     *[]SplitRequest  `json:"splits" validate:"omitempty,dive"`
+}
+
+// ValidateSplits checks the splits against the amount the expense ends up with, given the one it has now.
+// An empty list removes the splits.
+func (dto UpdateExpenseRequest) ValidateSplits(current decimal.Decimal) error {
+	if dto.Splits == nil {
+		return nil
+	}
+
+	amount := current
+	if dto.Amount != nil {
+		amount = *dto.Amount
+	}
+
+	return validateSplits(amount, *dto.Splits)
+}
+
+func validateSplits(amount decimal.Decimal, splits []SplitRequest) error {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	total := decimal.Zero
+	for _, split := range splits {
+		if !split.Amount.IsPositive() || split.CategoryID == 0 {
+			return apperror.ErrInvalidRequest
+		}
+		total = total.Add(split.Amount)
+	}
+
+	if !total.Equal(amount) {
+		return fmt.Errorf("%w: splits add up to %s, not %s", apperror.ErrInvalidRequest, total, amount)
+	}
+
+	return nil
+}
+
+type GetExpensesRequest struct {
+	From        *time.Time       `query:"from"`
+	To          *time.Time       `query:"to"`
+	Kind        string           `query:"kind" validate:"omitempty,oneof=expense income"`
+	AccountID   *uint            `query:"accountId"`
+	CategoryIDs []uint           `query:"categoryIds"`
+	TagIDs      []uint           `query:"tagIds"`
+	TagMatch    string           `query:"tagMatch" validate:"omitempty,oneof=any all"`
+	AmountMin   *decimal.Decimal `query:"amountMin"`
+	AmountMax   *decimal.Decimal `query:"amountMax"`
+	Note        string           `query:"note"`
+	Sort        string           `query:"sort" validate:"omitempty,oneof=date amount created"`
+	Order       string           `query:"order" validate:"omitempty,oneof=asc desc"`
+	Cursor      string           `query:"cursor"`
+	Limit       int              `query:"limit" validate:"omitempty,min=1"`
+	Currency    string           `query:"currency" validate:"omitempty,iso4217"`
+}
+
+type ExportExpensesRequest struct {
+	GetExpensesRequest
+	Format string `query:"format" validate:"required,oneof=csv jsonl xlsx"`
+}
+
+// ToQuery reuses the listing filters but exports every match oldest first, so cursor and limit are ignored.
+func (dto ExportExpensesRequest) ToQuery(userID uint) (ExpenseQuery, error) {
+	filters := dto.GetExpensesRequest
+	filters.Cursor = ""
+	if filters.Order == "" {
+		filters.Order = "asc"
+	}
+
+	query, err := filters.ToQuery(userID)
+	query.Limit = 0
+
+	return query, err
+}
+
+func (dto GetExpensesRequest) ToQuery(userID uint) (ExpenseQuery, error) {
+	query := ExpenseQuery{
+		UserID:      userID,
+		Kind:        Kind(dto.Kind),
+		AccountID:   dto.AccountID,
+		CategoryIDs: dto.CategoryIDs,
+		TagIDs:      dto.TagIDs,
+		TagMatch:    TagMatchAny,
+		AmountMin:   dto.AmountMin,
+		AmountMax:   dto.AmountMax,
+		Note:        dto.Note,
+		SortBy:      SortByDate,
+		SortDesc:    dto.Order != "asc",
+		Limit:       min(max(dto.Limit, 0), maxPageLimit),
+	}
+
+	if dto.From != nil {
+		from := dto.From.Unix()
+		query.DateFrom = &from
+	}
+
+	if dto.To != nil {
+		to := dto.To.Unix()
+		query.DateTo = &to
+	}
+
+	if dto.TagMatch != "" {
+		query.TagMatch = TagMatch(dto.TagMatch)
+	}
+
+	if dto.Sort != "" {
+		query.SortBy = ExpenseSortField(dto.Sort)
+	}
+
+	if query.Limit == 0 {
+		query.Limit = defaultPageLimit
+	}
+
+	if dto.Cursor != "" {
+		cursor, err := DecodeExpenseCursor(dto.Cursor)
+		if err != nil || cursor.SortBy != query.SortBy || !cursor.valid() {
+			return query, apperror.ErrInvalidRequest
+		}
+		query.Cursor = cursor
+	}
+
+	return query, nil
 }
 
 type ExpenseResponse struct {
+	ID              uint             `json:"id"`
+	Date            time.Time        `json:"date"`
+	Kind            Kind             `json:"kind"`
+	Amount          decimal.Decimal  `json:"amount"`
+	Currency        string           `json:"currency"`
+	ConvertedAmount *decimal.Decimal `json:"convertedAmount,omitempty"`
+	AccountID       *uint            `json:"accountId"`
+	MerchantID      *uint            `json:"merchantId"`
+	Note            string           `json:"note"`
+	Category        CategoryResponse `json:"categoy"`
+	Tags            []TagResponse    `json:"tags"`
+	Splits          []SplitResponse  `json:"splits"`
+}
+
+type SplitResponse struct {
 	ID       uint             `json:"id"`
-	Date     time.Time        `json:"date"`
 	Amount   decimal.Decimal  `json:"amount"`
+	Category CategoryResponse `json:"category"`
 	Note     string           `json:"note"`
-	Category CategoryResponse `json:"categoy"`
 	Tags     []TagResponse    `json:"tags"`
 }
 
+func (SplitResponse) FromEntity(split ExpenseSplitEntity) SplitResponse {
+	tagResponses := []TagResponse{}
+	for _, tag := range split.Tags {
+		tagResponses = append(tagResponses, TagResponse{}.FromEntity(tag))
+	}
+
+	return SplitResponse{
+		ID:       split.ID,
+		Amount:   split.Amount,
+		Category: CategoryResponse{}.FromEntity(split.Category),
+		Note:     split.Note,
+		Tags:     tagResponses,
+	}
+}
+
 func (ExpenseResponse) FromEntity(expense ExpenseEntity) ExpenseResponse {
 	tagResponses := []TagResponse{}
 	for _, tag := range expense.Tags {
 		tagResponses = append(tagResponses, TagResponse{}.FromEntity(tag))
 	}
 
+	splitResponses := []SplitResponse{}
+	for _, split := range expense.Splits {
+		splitResponses = append(splitResponses, SplitResponse{}.FromEntity(split))
+	}
+
 	return ExpenseResponse{
-		ID:       expense.ID,
-		Date:     time.Unix(expense.Date, 0),
-		Amount:   expense.Amount,
-		Note:     expense.Note,
-		Category: CategoryResponse{}.FromEntity(expense.Category),
-		Tags:     tagResponses,
+		ID:         expense.ID,
+		Date:       time.Unix(expense.Date, 0),
+		Kind:       expense.Kind,
+		Amount:     expense.Amount,
+		Currency:   expense.Currency,
+		AccountID:  expense.AccountID,
+		MerchantID: expense.MerchantID,
+		Note:       expense.Note,
+		Category:   CategoryResponse{}.FromEntity(expense.Category),
+		Tags:       tagResponses,
+		Splits:     splitResponses,
+	}
+}
+
+type ExpenseListResponse struct {
+	Items    []ExpenseResponse `json:"items"`
+	Next     *string           `json:"next"`
+	Total    int64             `json:"total"`
+	Currency string            `json:"currency"`
+}
+
+func (ExpenseListResponse) FromPage(page ExpensePage) ExpenseListResponse {
+	items := []ExpenseResponse{}
+	for _, expense := range page.Items {
+		item := ExpenseResponse{}.FromEntity(expense)
+		if converted, ok := page.Converted[expense.ID]; ok {
+			item.ConvertedAmount = &converted
+		}
+		items = append(items, item)
+	}
+
+	var next *string
+	if page.Next != nil {
+		encoded := page.Next.Encode()
+		next = &encoded
+	}
+
+	return ExpenseListResponse{
+		Items:    items,
+		Next:     next,
+		Total:    page.Total,
+		Currency: page.Currency,
 	}
 }
@@ -6,18 +6,55 @@ import (
 	"gorm.io/gorm"
 )
 
+// Kind tells money spent from money received; amounts are always positive.
+type Kind string
+
+const (
+	KindExpense Kind = "expense"
+	KindIncome  Kind = "income"
+)
+
 type ExpenseEntity struct {
 	gorm.Model
-	UserID     uint            `gorm:"not null;index:idx_expenses_user_date"`
-	Date       int64           `gorm:"not null;index:idx_expenses_user_date"`
-	Amount     decimal.Decimal `gorm:"type:decimal(15,2);not null"`
-	User       user.UserEntity `gorm:"foreignKey:UserID"`
-	Note       string          `gorm:"type:text"`
-	CategoryID uint            `gorm:"not null;index:idx_expenses_category"`
-	Category   CategoryEntity  `gorm:"foreignKey:CategoryID"`
-	Tags       []TagEntity     `gorm:"many2many:expenses_tags;"`
+	UserID     uint                 `gorm:"not null;index:idx_expenses_user_date;uniqueIndex:idx_expenses_user_external"`
+	Date       int64                `gorm:"not null;index:idx_expenses_user_date"`
+	Kind       Kind                 `gorm:"type:varchar(10);not null;default:'expense'"`
+	Amount     decimal.Decimal      `gorm:"type:decimal(15,2);not null"`
+	Currency   string               `gorm:"type:char(3);not null;default:'USD'"`
+	AccountID  *uint                `gorm:"index:idx_expenses_account"`
+	MerchantID *uint                `gorm:"index:idx_expenses_merchant"`
+	User       user.UserEntity      `gorm:"foreignKey:UserID"`
+	Note       string               `gorm:"type:text"`
+	CategoryID uint                 `gorm:"not null;index:idx_expenses_category"`
+	Category   CategoryEntity       `gorm:"foreignKey:CategoryID"`
+	Tags       []TagEntity          `gorm:"many2many:expenses_tags;"`
+	Splits     []ExpenseSplitEntity `gorm:"foreignKey:ExpenseID"`
+	// ExternalID identifies an imported bank transaction so importing the same statement again adds nothing.
+	ExternalID *string `gorm:"type:varchar(255);uniqueIndex:idx_expenses_user_external"`
 }
 
 func (ExpenseEntity) TableName() string {
 	return "expenses"
 }
+
+func (e *ExpenseEntity) BeforeCreate(tx *gorm.DB) error {
+	if e.Kind == "" {
+		e.Kind = KindExpense
+	}
+
+	return fillDefaultCurrency(tx, e.UserID, &e.Currency)
+}
+
+// fillDefaultCurrency falls back to the owner's default currency when none was given.
+func fillDefaultCurrency(tx *gorm.DB, userID uint, currency *string) error {
+	if *currency != "" {
+		return nil
+	}
+
+	return tx.Session(&gorm.Session{NewDB: true}).
+		Model(&user.UserEntity{}).
+		Select("default_currency").
+		Where("id = ?", userID).
+		Scan(currency).
+		Error
+}
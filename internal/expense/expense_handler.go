@@ -1,9 +1,13 @@
 package expense
 
 import (
+	"bufio"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/export"
 	"github.com/Perajit/expense-tracker-go/internal/util"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -11,31 +15,41 @@ import (
 )
 
 type ExpenseHandler struct {
-	expenseService  ExpenseService
-	categoryService CategoryService
-	tagService      TagService
-	validate        *validator.Validate
+	expenseService   ExpenseService
+	categoryService  CategoryService
+	tagService       TagService
+	duplicateService DuplicateService
+	validate         *validator.Validate
 }
 
 func NewExpenseHandler(
 	expenseService ExpenseService,
 	categoryService CategoryService,
 	tagService TagService,
+	duplicateService DuplicateService,
 	validate *validator.Validate,
 ) *ExpenseHandler {
 	return &ExpenseHandler{
-		expenseService:  expenseService,
-		categoryService: categoryService,
-		tagService:      tagService,
-		validate:        validate,
+		expenseService:   expenseService,
+		categoryService:  categoryService,
+		tagService:       tagService,
+		duplicateService: duplicateService,
+		validate:         validate,
 	}
 }
 
-func (h *ExpenseHandler) RegisterRoutes(app *fiber.App) {
-	group := app.Group("/expenses")
+func (h *ExpenseHandler) RegisterRoutes(app *fiber.App, authMiddleware fiber.Handler) {
+	group := app.Group("/expenses", authMiddleware)
 	group.Get("/", h.GetExpenses)
+	group.Get("/export", h.ExportExpenses)
+	group.Get("/duplicates", h.GetDuplicates)
+	group.Post("/duplicates/resolve", h.ResolveDuplicate)
 	group.Get("/:id", h.GetExpenseByID)
+	group.Get("/:id/history", h.GetExpenseHistory)
 	group.Post("/", h.CreateExpense)
+	group.Post("/quick", h.QuickAddExpense)
+	group.Post("/bulk", h.BulkUpdateExpenses)
+	group.Post("/:id/revert/:revisionId", h.RevertExpense)
 	group.Patch("/:id", h.UpdateExpense)
 	group.Delete("/:id", h.DeleteExpense)
 }
@@ -47,12 +61,53 @@ func (h *ExpenseHandler) GetExpenses(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
 	}
 
-	expenses, err := h.expenseService.GetExpenses(authUserID)
+	dto, errDTO := util.ExtractQuery[GetExpensesRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	page, err := h.expenseService.GetExpenses(authUserID, dto)
 	if err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
 	}
 
-	return c.Status(fiber.StatusOK).JSON(expenses)
+	return c.Status(fiber.StatusOK).JSON(ExpenseListResponse{}.FromPage(*page))
+}
+
+func (h *ExpenseHandler) ExportExpenses(c *fiber.Ctx) error {
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractQuery[ExportExpensesRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	format := export.Format(dto.Format)
+	fileName := format.FileName(fmt.Sprintf("expenses-%s", time.Now().Format("20060102")))
+
+	c.Set(fiber.HeaderContentType, format.ContentType())
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, fileName))
+	c.Status(fiber.StatusOK).Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		// the status line is already sent once streaming starts, so failures can only be logged
+		if err := h.expenseService.ExportExpenses(authUserID, dto, w); err != nil {
+			log.Error(err)
+		}
+		if err := w.Flush(); err != nil {
+			log.Error(err)
+		}
+	})
+
+	return nil
 }
 
 func (h *ExpenseHandler) GetExpenseByID(c *fiber.Ctx) error {
@@ -92,10 +147,139 @@ func (h *ExpenseHandler) CreateExpense(c *fiber.Ctx) error {
 	expense, err := h.expenseService.CreateExpense(authUserID, dto)
 	if err != nil {
 		log.Error(err)
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	response := CreateExpenseResponse{ExpenseEntity: expense}
+
+	// the expense is saved either way, so a failed check only loses the warning
+	duplicateIDs, err := h.duplicateService.FindDuplicates(expense.ID, authUserID)
+	if err != nil {
+		log.Error(err)
+	} else if len(duplicateIDs) > 0 {
+		response.Warning = &DuplicateWarning{Message: "possible duplicate", CandidateIDs: duplicateIDs}
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response)
+}
+
+func (h *ExpenseHandler) QuickAddExpense(c *fiber.Ctx) error {
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractDto[QuickAddRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	expense, tokens, err := h.expenseService.QuickAddExpense(authUserID, dto)
+	if err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	response := QuickAddResponse{Expense: expense, Tokens: tokens}
+
+	duplicateIDs, err := h.duplicateService.FindDuplicates(expense.ID, authUserID)
+	if err != nil {
+		log.Error(err)
+	} else if len(duplicateIDs) > 0 {
+		response.Warning = &DuplicateWarning{Message: "possible duplicate", CandidateIDs: duplicateIDs}
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response)
+}
+
+func (h *ExpenseHandler) GetDuplicates(c *fiber.Ctx) error {
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractQuery[GetDuplicatesRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	rows, err := h.duplicateService.GetDuplicates(authUserID, dto)
+	if err != nil {
+		log.Error(err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	duplicateResponses := []DuplicateResponse{}
+	for _, row := range rows {
+		duplicateResponses = append(duplicateResponses, DuplicateResponse{}.FromRow(row))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(duplicateResponses)
+}
+
+func (h *ExpenseHandler) ResolveDuplicate(c *fiber.Ctx) error {
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractDto[ResolveDuplicateRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	if err := h.duplicateService.ResolveDuplicate(authUserID, dto); err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"status": "success"})
+}
+
+func (h *ExpenseHandler) BulkUpdateExpenses(c *fiber.Ctx) error {
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractDto[BulkExpenseRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	result, err := h.expenseService.BulkUpdateExpenses(authUserID, dto)
+	if err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(expense)
+	return c.Status(fiber.StatusOK).JSON(result)
 }
 
 func (h *ExpenseHandler) UpdateExpense(c *fiber.Ctx) error {
@@ -122,6 +306,9 @@ func (h *ExpenseHandler) UpdateExpense(c *fiber.Ctx) error {
 		if errors.Is(err, apperror.ErrUnauthorized) {
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
 		}
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
 	}
 
@@ -151,3 +338,69 @@ func (h *ExpenseHandler) DeleteExpense(c *fiber.Ctx) error {
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{"status": "success"})
 }
+
+func (h *ExpenseHandler) GetExpenseHistory(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	revisions, err := h.expenseService.GetExpenseHistory(id, authUserID)
+	if err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	revisionResponses := []ExpenseRevisionResponse{}
+	for _, revision := range revisions {
+		revisionResponses = append(revisionResponses, ExpenseRevisionResponse{}.FromEntity(revision))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(revisionResponses)
+}
+
+func (h *ExpenseHandler) RevertExpense(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	revisionID, errRevisionID := util.ExtractNamedIDParam(c, "revisionId")
+	if errRevisionID != nil {
+		log.Error(errRevisionID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	if err := h.expenseService.RevertExpense(id, revisionID, authUserID); err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"status": "success"})
+}
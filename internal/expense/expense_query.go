@@ -0,0 +1,228 @@
+package expense
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type ExpenseSortField string
+
+const (
+	SortByDate    ExpenseSortField = "date"
+	SortByAmount  ExpenseSortField = "amount"
+	SortByCreated ExpenseSortField = "created"
+)
+
+type TagMatch string
+
+const (
+	TagMatchAny TagMatch = "any"
+	TagMatchAll TagMatch = "all"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 500
+)
+
+type ExpenseQuery struct {
+	UserID      uint
+	IDs         []uint
+	Kind        Kind
+	AccountID   *uint
+	DateFrom    *int64
+	DateTo      *int64
+	CategoryIDs []uint
+	TagIDs      []uint
+	TagMatch    TagMatch
+	AmountMin   *decimal.Decimal
+	AmountMax   *decimal.Decimal
+	Note        string
+	SortBy      ExpenseSortField
+	SortDesc    bool
+	Cursor      *ExpenseCursor
+	Limit       int
+}
+
+type ExpenseCursor struct {
+	SortBy ExpenseSortField `json:"s"`
+	Value  string           `json:"v"`
+	ID     uint             `json:"i"`
+}
+
+type ExpensePage struct {
+	Items     []ExpenseEntity
+	Next      *ExpenseCursor
+	Total     int64
+	Currency  string
+	Converted map[uint]decimal.Decimal
+}
+
+func (c ExpenseCursor) Encode() string {
+	data, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeExpenseCursor(s string) (*ExpenseCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor ExpenseCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+
+	return &cursor, nil
+}
+
+func NewExpenseCursor(sortBy ExpenseSortField, expense ExpenseEntity) *ExpenseCursor {
+	cursor := &ExpenseCursor{SortBy: sortBy, ID: expense.ID}
+	switch sortBy {
+	case SortByDate:
+		cursor.Value = fmt.Sprint(expense.Date)
+	case SortByAmount:
+		cursor.Value = expense.Amount.String()
+	}
+
+	return cursor
+}
+
+func (c ExpenseCursor) value() any {
+	if c.SortBy == SortByAmount {
+		amount, _ := decimal.NewFromString(c.Value)
+		return amount
+	}
+
+	date, _ := strconv.ParseInt(c.Value, 10, 64)
+	return date
+}
+
+func (c ExpenseCursor) valid() bool {
+	switch c.SortBy {
+	case SortByDate:
+		_, err := strconv.ParseInt(c.Value, 10, 64)
+		return err == nil
+	case SortByAmount:
+		_, err := decimal.NewFromString(c.Value)
+		return err == nil
+	case SortByCreated:
+		return true
+	}
+
+	return false
+}
+
+func (f ExpenseSortField) column() string {
+	switch f {
+	case SortByAmount:
+		return "expenses.amount"
+	case SortByCreated:
+		return "expenses.id"
+	default:
+		return "expenses.date"
+	}
+}
+
+// applyFilters narrows db down to the expenses matched by q, ignoring sort and pagination.
+func (q ExpenseQuery) applyFilters(db *gorm.DB) *gorm.DB {
+	db = db.Where("expenses.user_id = ?", q.UserID)
+
+	if len(q.IDs) > 0 {
+		db = db.Where("expenses.id IN ?", q.IDs)
+	}
+
+	if q.Kind != "" {
+		db = db.Where("expenses.kind = ?", q.Kind)
+	}
+
+	if q.AccountID != nil {
+		db = db.Where("expenses.account_id = ?", *q.AccountID)
+	}
+
+	if q.DateFrom != nil {
+		db = db.Where("expenses.date >= ?", *q.DateFrom)
+	}
+
+	if q.DateTo != nil {
+		db = db.Where("expenses.date <= ?", *q.DateTo)
+	}
+
+	if len(q.CategoryIDs) > 0 {
+		db = db.Where("expenses.category_id IN ?", q.CategoryIDs)
+	}
+
+	if len(q.TagIDs) > 0 {
+		sub := db.Session(&gorm.Session{NewDB: true}).
+			Table("expenses_tags").
+			Select("expense_entity_id").
+			Where("tag_entity_id IN ?", q.TagIDs)
+		if q.TagMatch == TagMatchAll {
+			sub = sub.Group("expense_entity_id").Having("COUNT(DISTINCT tag_entity_id) = ?", len(q.TagIDs))
+		}
+		db = db.Where("expenses.id IN (?)", sub)
+	}
+
+	if q.AmountMin != nil {
+		db = db.Where("expenses.amount >= ?", *q.AmountMin)
+	}
+
+	if q.AmountMax != nil {
+		db = db.Where("expenses.amount <= ?", *q.AmountMax)
+	}
+
+	if q.Note != "" {
+		db = db.Where("expenses.note ILIKE ?", "%"+escapeLike(q.Note)+"%")
+	}
+
+	return db
+}
+
+// applyPage adds the keyset condition for the cursor along with ordering and limit.
+func (q ExpenseQuery) applyPage(db *gorm.DB) *gorm.DB {
+	col := q.SortBy.column()
+	op := ">"
+	if q.SortDesc {
+		op = "<"
+	}
+
+	if q.Cursor != nil {
+		if q.SortBy == SortByCreated {
+			db = db.Where(fmt.Sprintf("expenses.id %s ?", op), q.Cursor.ID)
+		} else {
+			value := q.Cursor.value()
+			db = db.Where(
+				fmt.Sprintf("(%s %s ? OR (%s = ? AND expenses.id %s ?))", col, op, col, op),
+				value, value, q.Cursor.ID,
+			)
+		}
+	}
+
+	return q.applyOrder(db).Limit(q.Limit + 1)
+}
+
+// applyOrder sorts by the requested field, breaking ties by id so the order is stable.
+func (q ExpenseQuery) applyOrder(db *gorm.DB) *gorm.DB {
+	dir := "ASC"
+	if q.SortDesc {
+		dir = "DESC"
+	}
+
+	if q.SortBy != SortByCreated {
+		db = db.Order(fmt.Sprintf("%s %s", q.SortBy.column(), dir))
+	}
+
+	return db.Order(fmt.Sprintf("expenses.id %s", dir))
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
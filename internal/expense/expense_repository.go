@@ -1,17 +1,36 @@
 package expense
 
-import "gorm.io/gorm"
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/currency"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
 
 type ExpenseRepository interface {
 	WithTx(tx *gorm.DB) ExpenseRepository
-	GetByUser(userID uint) ([]ExpenseEntity, error)
+	Find(query ExpenseQuery) ([]ExpenseEntity, error)
+	Count(query ExpenseQuery) (int64, error)
+	Stream(query ExpenseQuery, fn func(ExpenseExportRow) error) error
+	ConvertAmounts(ids []uint, target string) (map[uint]decimal.Decimal, error)
 	GetByIDAndUser(id uint, userID uint) (*ExpenseEntity, error)
 	GetByIDAndUserNoAssociation(id uint, userID uint) (*ExpenseEntity, error)
+	GetByIDs(ids []uint) ([]ExpenseEntity, error)
 	IsOwner(id uint, userID uint) (bool, error)
+	GetExternalIDs(userID uint, externalIDs []string) ([]string, error)
 	Create(expense *ExpenseEntity) error
 	Update(expense *ExpenseEntity) error
 	UpdateTags(expense *ExpenseEntity, tags []TagEntity) error
+	ReplaceSplits(expenseID uint, splits []ExpenseSplitEntity) error
 	Delete(id uint) error
+	GetDeletedByUser(userID uint) ([]ExpenseEntity, error)
+	GetDeletedByIDAndUser(id uint, userID uint) (*ExpenseEntity, error)
+	Restore(id uint) error
+	Purge(before time.Time) (int64, error)
 }
 
 type expenseRepository struct {
@@ -30,11 +49,15 @@ func (r *expenseRepository) WithTx(tx *gorm.DB) ExpenseRepository {
 	return &expenseRepository{db: tx}
 }
 
-func (r *expenseRepository) GetByUser(userID uint) ([]ExpenseEntity, error) {
+func (r *expenseRepository) Find(query ExpenseQuery) ([]ExpenseEntity, error) {
 	var expenses []ExpenseEntity
-	if err := r.db.Preload("Category").
+	db := query.applyFilters(r.db.Model(&ExpenseEntity{}))
+	if err := query.applyPage(db).
+		Preload("Category").
 		Preload("Tags").
-		Where("user_id = ?", userID).
+		Preload("Splits", orderByID).
+		Preload("Splits.Category").
+		Preload("Splits.Tags").
 		Find(&expenses).
 		Error; err != nil {
 		return nil, err
@@ -43,10 +66,83 @@ func (r *expenseRepository) GetByUser(userID uint) ([]ExpenseEntity, error) {
 	return expenses, nil
 }
 
+func (r *expenseRepository) Count(query ExpenseQuery) (int64, error) {
+	var count int64
+	err := query.applyFilters(r.db.Model(&ExpenseEntity{})).Count(&count).Error
+
+	return count, err
+}
+
+// Stream walks the matched expenses row by row through a database cursor instead of loading them all at once.
+func (r *expenseRepository) Stream(query ExpenseQuery, fn func(ExpenseExportRow) error) error {
+	tags := r.db.Session(&gorm.Session{NewDB: true}).
+		Table("expenses_tags et").
+		Select("json_agg(t.name ORDER BY t.name)").
+		Joins("JOIN expense_tags t ON t.id = et.tag_entity_id AND t.deleted_at IS NULL").
+		Where("et.expense_entity_id = expenses.id")
+
+	db := query.applyFilters(r.db.Model(&ExpenseEntity{})).
+		Select("expenses.id, expenses.date, expenses.kind, expenses.amount, expenses.currency, expenses.note, c.name AS category, "+
+			"expenses.merchant_id, COALESCE(m.name, '') AS merchant, COALESCE((?), '[]') AS tags", tags).
+		Joins("LEFT JOIN expense_categories c ON c.id = expenses.category_id").
+		Joins("LEFT JOIN merchants m ON m.id = expenses.merchant_id")
+
+	rows, err := query.applyOrder(db).Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row ExpenseExportRow
+		if err := r.db.ScanRows(rows, &row); err != nil {
+			return err
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// ConvertAmounts converts the given expenses into target with the rate effective on each expense date.
+// Expenses without a known rate are left out of the result.
+func (r *expenseRepository) ConvertAmounts(ids []uint, target string) (map[uint]decimal.Decimal, error) {
+	converted := map[uint]decimal.Decimal{}
+	if len(ids) == 0 {
+		return converted, nil
+	}
+
+	var rows []struct {
+		ID     uint
+		Amount decimal.NullDecimal
+	}
+	expr := fmt.Sprintf("expenses.id, ROUND(expenses.amount * %s, 2) AS amount", currency.RateExpr("expenses.currency", "expenses.date"))
+	if err := r.db.Model(&ExpenseEntity{}).
+		Select(expr, currency.RateArgs(target)...).
+		Where("expenses.id IN ?", ids).
+		Scan(&rows).
+		Error; err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if row.Amount.Valid {
+			converted[row.ID] = row.Amount.Decimal
+		}
+	}
+
+	return converted, nil
+}
+
 func (r *expenseRepository) GetByIDAndUser(id uint, userID uint) (*ExpenseEntity, error) {
 	var expense ExpenseEntity
 	if err := r.db.Preload("Category").
 		Preload("Tags").
+		Preload("Splits", orderByID).
+		Preload("Splits.Category").
+		Preload("Splits.Tags").
 		Where("id = ?", id).
 		Where("user_id = ?", userID).
 		First(&expense).
@@ -59,13 +155,29 @@ func (r *expenseRepository) GetByIDAndUser(id uint, userID uint) (*ExpenseEntity
 
 func (r *expenseRepository) GetByIDAndUserNoAssociation(id uint, userID uint) (*ExpenseEntity, error) {
 	var expense ExpenseEntity
-	if err := r.db.First(&expense, id).Error; err != nil {
+	if err := r.db.Where("user_id = ?", userID).First(&expense, id).Error; err != nil {
 		return nil, err
 	}
 
 	return &expense, nil
 }
 
+// GetByIDs loads expenses with their tags and splits, leaving out deleted ones.
+func (r *expenseRepository) GetByIDs(ids []uint) ([]ExpenseEntity, error) {
+	var expenses []ExpenseEntity
+	if err := r.db.Preload("Tags").
+		Preload("Splits", orderByID).
+		Preload("Splits.Tags").
+		Where("id IN ?", ids).
+		Order("id").
+		Find(&expenses).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return expenses, nil
+}
+
 func (r *expenseRepository) IsOwner(id uint, userID uint) (bool, error) {
 	var count int64
 	err := r.db.Model(&ExpenseEntity{}).Where("id = ?", id).Where("user_id = ?", userID).Count(&count).Error
@@ -73,6 +185,26 @@ func (r *expenseRepository) IsOwner(id uint, userID uint) (bool, error) {
 	return count > 0, err
 }
 
+// GetExternalIDs returns which of the given external IDs the user already has, counting expenses in the trash
+// so a deleted import does not come back.
+func (r *expenseRepository) GetExternalIDs(userID uint, externalIDs []string) ([]string, error) {
+	existing := []string{}
+	if len(externalIDs) == 0 {
+		return existing, nil
+	}
+
+	if err := r.db.Unscoped().
+		Model(&ExpenseEntity{}).
+		Where("user_id = ?", userID).
+		Where("external_id IN ?", externalIDs).
+		Pluck("external_id", &existing).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
 func (r *expenseRepository) Create(expense *ExpenseEntity) error {
 	return r.db.Create(expense).Error
 }
@@ -93,6 +225,149 @@ func (r *expenseRepository) UpdateTags(expense *ExpenseEntity, tags []TagEntity)
 	return nil
 }
 
+func (r *expenseRepository) ReplaceSplits(expenseID uint, splits []ExpenseSplitEntity) error {
+	ids := r.db.Model(&ExpenseSplitEntity{}).Select("id").Where("expense_id = ?", expenseID)
+
+	if err := r.db.Exec("DELETE FROM expense_splits_tags WHERE expense_split_entity_id IN (?)", ids).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.Where("expense_id = ?", expenseID).Delete(&ExpenseSplitEntity{}).Error; err != nil {
+		return err
+	}
+
+	if len(splits) == 0 {
+		return nil
+	}
+
+	for i := range splits {
+		splits[i].ExpenseID = expenseID
+	}
+
+	return r.db.Omit("Category").Create(&splits).Error
+}
+
 func (r *expenseRepository) Delete(id uint) error {
-	return r.db.Delete(id).Error
+	return r.db.Delete(&ExpenseEntity{}, id).Error
+}
+
+func (r *expenseRepository) GetDeletedByUser(userID uint) ([]ExpenseEntity, error) {
+	var expenses []ExpenseEntity
+	if err := r.db.Unscoped().
+		Preload("Category", unscoped).
+		Where("user_id = ?", userID).
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").
+		Find(&expenses).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return expenses, nil
+}
+
+func (r *expenseRepository) GetDeletedByIDAndUser(id uint, userID uint) (*ExpenseEntity, error) {
+	var expense ExpenseEntity
+	if err := r.db.Unscoped().
+		Preload("Category", unscoped).
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		Where("deleted_at IS NOT NULL").
+		First(&expense).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &expense, nil
+}
+
+// Restore clears deleted_at; tag links are never removed on soft delete, so they come back as they were.
+func (r *expenseRepository) Restore(id uint) error {
+	return r.db.Unscoped().Model(&ExpenseEntity{}).Where("id = ?", id).Update("deleted_at", nil).Error
+}
+
+// Purge hard-deletes expenses soft-deleted before the given time together with the rows that point at them.
+func (r *expenseRepository) Purge(before time.Time) (int64, error) {
+	ids := r.db.Unscoped().Model(&ExpenseEntity{}).Select("id").Where("deleted_at < ?", before)
+
+	if err := r.db.Exec("DELETE FROM expenses_tags WHERE expense_entity_id IN (?)", ids).Error; err != nil {
+		return 0, err
+	}
+
+	if err := r.db.Exec("UPDATE recurring_occurrences SET expense_id = NULL WHERE expense_id IN (?)", ids).Error; err != nil {
+		return 0, err
+	}
+
+	if err := r.db.Exec("UPDATE group_expenses SET expense_id = NULL WHERE expense_id IN (?)", ids).Error; err != nil {
+		return 0, err
+	}
+
+	splitIDs := r.db.Model(&ExpenseSplitEntity{}).Select("id").Where("expense_id IN (?)", ids)
+	if err := r.db.Exec("DELETE FROM expense_splits_tags WHERE expense_split_entity_id IN (?)", splitIDs).Error; err != nil {
+		return 0, err
+	}
+
+	if err := r.db.Exec("DELETE FROM expense_splits WHERE expense_id IN (?)", ids).Error; err != nil {
+		return 0, err
+	}
+
+	if err := r.db.Exec("DELETE FROM expense_revisions WHERE expense_id IN (?)", ids).Error; err != nil {
+		return 0, err
+	}
+
+	if err := r.db.Exec("DELETE FROM attachments WHERE expense_id IN (?)", ids).Error; err != nil {
+		return 0, err
+	}
+
+	if err := r.db.Exec("DELETE FROM duplicate_dismissals WHERE expense_id IN (?) OR other_id IN (?)", ids, ids).Error; err != nil {
+		return 0, err
+	}
+
+	result := r.db.Unscoped().Where("deleted_at < ?", before).Delete(&ExpenseEntity{})
+
+	return result.RowsAffected, result.Error
+}
+
+// orderByID keeps preloaded rows in the order they were written.
+func orderByID(db *gorm.DB) *gorm.DB {
+	return db.Order("id")
+}
+
+// unscoped lets preloads include soft-deleted rows.
+func unscoped(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
+
+type ExpenseExportRow struct {
+	ID         uint
+	Date       int64
+	Kind       Kind
+	Amount     decimal.Decimal
+	Currency   string
+	Note       string
+	Category   string
+	MerchantID *uint
+	Merchant   string
+	Tags       TagNames
+}
+
+// TagNames scans the JSON array of tag names aggregated by the export query.
+type TagNames []string
+
+func (n *TagNames) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*n = TagNames{}
+		return nil
+	case []byte:
+		return json.Unmarshal(v, n)
+	case string:
+		return json.Unmarshal([]byte(v), n)
+	}
+
+	return fmt.Errorf("cannot scan %T into TagNames", value)
+}
+
+func (n TagNames) Value() (driver.Value, error) {
+	return json.Marshal(n)
 }
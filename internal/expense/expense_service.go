@@ -1,36 +1,150 @@
 package expense
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/account"
 	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/export"
+	"github.com/Perajit/expense-tracker-go/internal/user"
 	"gorm.io/gorm"
 )
 
 type ExpenseService interface {
-	GetExpenses(authUserID uint) ([]ExpenseEntity, error)
+	GetExpenses(authUserID uint, dto GetExpensesRequest) (*ExpensePage, error)
+	ExportExpenses(authUserID uint, dto ExportExpensesRequest, w io.Writer) error
 	GetExpenseByID(id uint, authUserID uint) (*ExpenseEntity, error)
 	CreateExpense(authUserID uint, dto CreateExpenseRequest) (*ExpenseEntity, error)
+	QuickAddExpense(authUserID uint, dto QuickAddRequest) (*ExpenseEntity, []QuickAddToken, error)
 	UpdateExpense(id uint, authUserID uint, dto UpdateExpenseRequest) error
 	DeleteExpense(id uint, authUserID uint) error
+	GetExpenseHistory(id uint, authUserID uint) ([]ExpenseRevisionEntity, error)
+	RevertExpense(id uint, revisionID uint, authUserID uint) error
+	BulkUpdateExpenses(authUserID uint, dto BulkExpenseRequest) (*BulkResult, error)
+}
+
+// ExpenseListener is told about expenses created or updated through the service once they are committed.
+type ExpenseListener interface {
+	ExpenseSaved(expense ExpenseEntity)
 }
 
 type expenseService struct {
 	db              *gorm.DB
 	expenseRepo     ExpenseRepository
+	revisionRepo    RevisionRepository
 	categoryService CategoryService
 	tagService      TagService
+	userService     user.UserService
+	accountService  account.AccountService
+	ruleService     RuleService
+	merchantService MerchantService
+	listener        ExpenseListener
 }
 
-func NewExpenseService(db *gorm.DB, expenseRepo ExpenseRepository, categoryService CategoryService, tagService TagService) ExpenseService {
+func NewExpenseService(
+	db *gorm.DB,
+	expenseRepo ExpenseRepository,
+	revisionRepo RevisionRepository,
+	categoryService CategoryService,
+	tagService TagService,
+	userService user.UserService,
+	accountService account.AccountService,
+	ruleService RuleService,
+	merchantService MerchantService,
+	listener ExpenseListener,
+) ExpenseService {
 	return &expenseService{
 		db:              db,
 		expenseRepo:     expenseRepo,
+		revisionRepo:    revisionRepo,
 		categoryService: categoryService,
 		tagService:      tagService,
+		userService:     userService,
+		accountService:  accountService,
+		ruleService:     ruleService,
+		merchantService: merchantService,
+		listener:        listener,
+	}
+}
+
+func (s *expenseService) GetExpenses(authUserID uint, dto GetExpensesRequest) (*ExpensePage, error) {
+	query, err := dto.ToQuery(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := s.expenseRepo.Find(query)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.expenseRepo.Count(query)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &ExpensePage{Items: expenses, Total: total}
+	if len(expenses) > query.Limit {
+		page.Items = expenses[:query.Limit]
+		page.Next = NewExpenseCursor(query.SortBy, page.Items[query.Limit-1])
 	}
+
+	page.Currency = dto.Currency
+	if page.Currency == "" {
+		u, err := s.userService.GetUserByID(authUserID, authUserID)
+		if err != nil {
+			return nil, err
+		}
+		page.Currency = u.DefaultCurrency
+	}
+
+	ids := []uint{}
+	for _, expense := range page.Items {
+		ids = append(ids, expense.ID)
+	}
+
+	page.Converted, err = s.expenseRepo.ConvertAmounts(ids, page.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
 }
 
-func (s *expenseService) GetExpenses(authUserID uint) ([]ExpenseEntity, error) {
-	return s.expenseRepo.GetByUser(authUserID)
+func (s *expenseService) ExportExpenses(authUserID uint, dto ExportExpensesRequest, w io.Writer) error {
+	query, err := dto.ToQuery(authUserID)
+	if err != nil {
+		return err
+	}
+
+	writer, err := export.NewWriter(export.Format(dto.Format), w)
+	if err != nil {
+		return err
+	}
+
+	err = s.expenseRepo.Stream(query, func(row ExpenseExportRow) error {
+		return writer.Write(export.Row{
+			ID:       row.ID,
+			Date:     time.Unix(row.Date, 0).UTC(),
+			Kind:     string(row.Kind),
+			Amount:   row.Amount,
+			Currency: row.Currency,
+			Note:     row.Note,
+			Category: row.Category,
+			Merchant: row.Merchant,
+			Tags:     row.Tags,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	return writer.Close()
 }
 
 func (s *expenseService) GetExpenseByID(id uint, authUserID uint) (*ExpenseEntity, error) {
@@ -38,48 +152,513 @@ func (s *expenseService) GetExpenseByID(id uint, authUserID uint) (*ExpenseEntit
 }
 
 func (s *expenseService) CreateExpense(authUserID uint, dto CreateExpenseRequest) (*ExpenseEntity, error) {
-	isOwner, err := s.categoryService.IsCategoryOwner(dto.CategoryID, authUserID)
-	if err != nil {
+	return s.create(authUserID, dto, nil)
+}
+
+// create adds the expense along with newTags, tags not saved yet, which are inserted in the same transaction
+// so a failed expense leaves none of them behind.
+func (s *expenseService) create(authUserID uint, dto CreateExpenseRequest, newTags []TagEntity) (*ExpenseEntity, error) {
+	if !dto.Amount.IsPositive() {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	if err := dto.ValidateSplits(); err != nil {
 		return nil, err
 	}
-	if !isOwner {
-		return nil, apperror.ErrUnauthorized
+
+	if dto.CategoryID != 0 {
+		isOwner, err := s.categoryService.IsCategoryOwner(dto.CategoryID, authUserID)
+		if err != nil {
+			return nil, err
+		}
+		if !isOwner {
+			return nil, apperror.ErrUnauthorized
+		}
 	}
 
 	tags, err := s.tagService.GetTagsByIDs(dto.TagIDs, authUserID)
 	if err != nil {
 		return nil, err
 	}
+	tags = append(tags, newTags...)
+
+	if dto.AccountID != nil {
+		if err := s.checkAccount(*dto.AccountID, authUserID); err != nil {
+			return nil, err
+		}
+	}
+
+	splits, err := s.splits(dto.Splits, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	merchant, err := s.merchant(dto.MerchantID, dto.Note, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// without a category a split expense is filed under its largest split, any other one under its merchant's
+	// default category or else left to the user's rules
+	categoryID, note := dto.CategoryID, dto.Note
+	if categoryID == 0 && len(splits) > 0 {
+		categoryID = largestSplit(splits).CategoryID
+	}
+	var merchantID *uint
+	if merchant != nil {
+		merchantID = &merchant.ID
+		if categoryID == 0 && merchant.DefaultCategoryID != nil {
+			categoryID = *merchant.DefaultCategoryID
+		}
+		if len(tags) == 0 {
+			tags = merchant.DefaultTags
+		}
+	}
+	if categoryID == 0 {
+		subject := &RuleSubject{
+			Date:      dto.Date,
+			Amount:    dto.Amount,
+			Note:      dto.Note,
+			AccountID: dto.AccountID,
+			Tags:      tags,
+		}
+		if err := s.ruleService.Evaluate(authUserID, subject); err != nil {
+			return nil, err
+		}
+		if subject.CategoryID == 0 {
+			return nil, apperror.ErrInvalidRequest
+		}
+		categoryID, note, tags = subject.CategoryID, subject.Note, subject.Tags
+	}
 
 	expense := &ExpenseEntity{
 		UserID:     authUserID,
 		Date:       dto.Date.Unix(),
+		Kind:       Kind(dto.Kind),
 		Amount:     dto.Amount,
-		Note:       dto.Note,
-		CategoryID: dto.CategoryID,
+		Currency:   dto.Currency,
+		AccountID:  dto.AccountID,
+		MerchantID: merchantID,
+		Note:       note,
+		CategoryID: categoryID,
 		Tags:       tags,
+		Splits:     splits,
 	}
-	if err := s.expenseRepo.Create(expense); err != nil {
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.expenseRepo.WithTx(tx).Create(expense); err != nil {
+			return err
+		}
+
+		return RecordRevision(s.revisionRepo.WithTx(tx), *expense, nil, authUserID, RevisionActionCreate)
+	})
+	if err != nil {
 		return nil, err
 	}
+	s.notify(*expense)
 
 	return expense, nil
 }
 
+// QuickAddExpense creates an expense from a line of free text, see parseQuickAdd. Tags and the category are
+// looked up by name; unknown tags are created together with the expense when the request asks for it, an unknown
+// category is an error.
+func (s *expenseService) QuickAddExpense(authUserID uint, dto QuickAddRequest) (*ExpenseEntity, []QuickAddToken, error) {
+	u, err := s.userService.GetUserByID(authUserID, authUserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loc, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	now := time.Now().In(loc)
+	input, err := parseQuickAdd(dto.Text, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request := CreateExpenseRequest{
+		Date:     now,
+		Amount:   input.Amount,
+		Currency: input.Currency,
+		Note:     input.Note,
+	}
+	if input.Date != nil {
+		request.Date = *input.Date
+	}
+	details := map[string]string{}
+	newTags := []TagEntity{}
+
+	if input.CategoryName != "" {
+		category, err := s.quickAddCategory(input.CategoryName, authUserID)
+		if err != nil {
+			return nil, nil, err
+		}
+		request.CategoryID = category.ID
+		details[QuickTokenCategory+":"+input.CategoryName] = fmt.Sprintf("category %d (%s)", category.ID, category.Name)
+	}
+
+	tags, err := s.tagService.GetTagsByNames(input.TagNames, authUserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, name := range input.TagNames {
+		i := slices.IndexFunc(tags, func(tag TagEntity) bool { return strings.EqualFold(tag.Name, name) })
+		if i >= 0 {
+			request.TagIDs = append(request.TagIDs, tags[i].ID)
+			details[QuickTokenTag+":"+name] = fmt.Sprintf("tag %d (%s)", tags[i].ID, tags[i].Name)
+			continue
+		}
+
+		if !dto.CreateTags {
+			return nil, nil, fmt.Errorf("%w: unknown tag %q", apperror.ErrInvalidRequest, name)
+		}
+		if !slices.ContainsFunc(newTags, func(tag TagEntity) bool { return strings.EqualFold(tag.Name, name) }) {
+			newTags = append(newTags, TagEntity{UserID: authUserID, Name: name})
+		}
+	}
+
+	expense, err := s.create(authUserID, request, newTags)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, tag := range expense.Tags {
+		if slices.ContainsFunc(newTags, func(t TagEntity) bool { return t.Name == tag.Name }) {
+			details[QuickTokenTag+":"+tag.Name] = fmt.Sprintf("new tag %d", tag.ID)
+		}
+	}
+
+	tokens := input.Tokens
+	for i, token := range tokens {
+		tokens[i].Detail = details[token.Kind+":"+token.Value]
+	}
+
+	return expense, tokens, nil
+}
+
+// quickAddCategory picks the category with the given name, preferring the user's own over a default one
+// and a top-level one over a subcategory.
+func (s *expenseService) quickAddCategory(name string, authUserID uint) (*CategoryEntity, error) {
+	categories, err := s.categoryService.GetCategoriesByNames([]string{name}, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	rank := func(category CategoryEntity) int {
+		r := 0
+		if category.UserID != authUserID {
+			r += 2
+		}
+		if category.ParentID != 0 {
+			r++
+		}
+		return r
+	}
+
+	var found *CategoryEntity
+	for i, category := range categories {
+		if found == nil || rank(category) < rank(*found) {
+			found = &categories[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%w: unknown category %q", apperror.ErrInvalidRequest, name)
+	}
+
+	return found, nil
+}
+
 func (s *expenseService) UpdateExpense(id uint, authUserID uint, dto UpdateExpenseRequest) error {
-	expense, err := s.expenseRepo.GetByIDAndUserNoAssociation(id, authUserID)
+	expense, err := s.expenseRepo.GetByIDAndUser(id, authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	if err := s.update(expense, authUserID, dto, RevisionActionUpdate); err != nil {
+		return err
+	}
+	s.notify(*expense)
+
+	return nil
+}
+
+func (s *expenseService) DeleteExpense(id uint, authUserID uint) error {
+	isOwner, err := s.expenseRepo.IsOwner(id, authUserID)
+	if err != nil {
+		return err
+	}
+	if !isOwner {
+		return apperror.ErrUnauthorized
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := s.expenseRepo.WithTx(tx).Delete(id); err != nil {
+			return err
+		}
+
+		return RecordRevision(s.revisionRepo.WithTx(tx), ExpenseEntity{Model: gorm.Model{ID: id}}, nil, authUserID, RevisionActionDelete)
+	})
+}
+
+func (s *expenseService) GetExpenseHistory(id uint, authUserID uint) ([]ExpenseRevisionEntity, error) {
+	isOwner, err := s.expenseRepo.IsOwner(id, authUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOwner {
+		return nil, apperror.ErrNotFound
+	}
+
+	return s.revisionRepo.GetByExpense(id)
+}
+
+// RevertExpense puts the expense's fields back to how they were right after the given revision.
+// The revert goes through the same checks as an update and is recorded as a revision of its own.
+func (s *expenseService) RevertExpense(id uint, revisionID uint, authUserID uint) error {
+	expense, err := s.expenseRepo.GetByIDAndUser(id, authUserID)
 	if err != nil {
 		return apperror.ErrNotFound
 	}
 
+	revisions, err := s.revisionRepo.GetByExpense(id)
+	if err != nil {
+		return err
+	}
+
+	state, ok := revisionState(revisions, revisionID)
+	if !ok {
+		return apperror.ErrNotFound
+	}
+	// revisions only record splits once there are some, so none recorded means none at the time
+	if _, ok := state["splits"]; !ok {
+		state["splits"] = ""
+	}
+
+	dto, err := revertRequest(state, revisionFields(*expense))
+	if err != nil {
+		return err
+	}
+
+	if err := s.update(expense, authUserID, dto, RevisionActionRevert); err != nil {
+		return err
+	}
+	s.notify(*expense)
+
+	return nil
+}
+
+// BulkUpdateExpenses applies one operation to many expenses in a single transaction.
+// Ids the user does not own are reported as not found and skipped; every change is recorded as a revision.
+// Setting the category leaves split expenses unchanged.
+func (s *expenseService) BulkUpdateExpenses(authUserID uint, dto BulkExpenseRequest) (*BulkResult, error) {
+	operation := BulkOperation(dto.Operation)
+
+	ids := slices.Clone(dto.IDs)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	query := ExpenseQuery{UserID: authUserID, IDs: ids, TagMatch: TagMatchAny, SortBy: SortByCreated, Limit: len(ids)}
+	if len(ids) == 0 {
+		if dto.Filter == nil {
+			return nil, apperror.ErrInvalidRequest
+		}
+
+		filters := *dto.Filter
+		filters.Cursor = ""
+		filters.Limit = 0
+
+		var err error
+		query, err = filters.ToQuery(authUserID)
+		if err != nil {
+			return nil, err
+		}
+		query.SortBy = SortByCreated
+		query.SortDesc = false
+		query.Limit = maxBulkExpenses
+	}
+
+	var tags []TagEntity
+	switch operation {
+	case BulkSetCategory:
+		isOwner, err := s.categoryService.IsCategoryOwner(dto.CategoryID, authUserID)
+		if err != nil {
+			return nil, err
+		}
+		if !isOwner {
+			return nil, apperror.ErrUnauthorized
+		}
+	case BulkAddTags, BulkRemoveTags:
+		tagIDs := slices.Clone(dto.TagIDs)
+		slices.Sort(tagIDs)
+		tagIDs = slices.Compact(tagIDs)
+
+		var err error
+		tags, err = s.tagService.GetTagsByIDs(tagIDs, authUserID)
+		if err != nil {
+			return nil, err
+		}
+		if len(tags) != len(tagIDs) {
+			return nil, apperror.ErrUnauthorized
+		}
+	}
+
+	expenses, err := s.expenseRepo.Find(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(expenses) > query.Limit {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	result := &BulkResult{Operation: operation, Items: []BulkItemResult{}}
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		expenseRepo := s.expenseRepo.WithTx(tx)
+		revisionRepo := s.revisionRepo.WithTx(tx)
+
+		for i := range expenses {
+			expense := &expenses[i]
+			item := BulkItemResult{ID: expense.ID, Status: BulkStatusUnchanged}
+
+			if operation == BulkDelete {
+				if err := expenseRepo.Delete(expense.ID); err != nil {
+					return err
+				}
+				if err := RecordRevision(revisionRepo, *expense, nil, authUserID, RevisionActionDelete); err != nil {
+					return err
+				}
+
+				item.Status = BulkStatusDeleted
+				result.Items = append(result.Items, item)
+				result.Changed++
+				continue
+			}
+
+			before := revisionFields(*expense)
+			switch operation {
+			case BulkSetCategory:
+				// a split expense is categorized by its splits, which one category cannot replace
+				if len(expense.Splits) == 0 {
+					expense.CategoryID = dto.CategoryID
+				}
+			case BulkAddTags:
+				for _, tag := range tags {
+					if !slices.ContainsFunc(expense.Tags, func(t TagEntity) bool { return t.ID == tag.ID }) {
+						expense.Tags = append(expense.Tags, tag)
+					}
+				}
+			case BulkRemoveTags:
+				expense.Tags = slices.DeleteFunc(expense.Tags, func(t TagEntity) bool {
+					return slices.ContainsFunc(tags, func(tag TagEntity) bool { return tag.ID == t.ID })
+				})
+			case BulkShiftDate:
+				expense.Date += int64(dto.Days) * 24 * 60 * 60
+			}
+
+			if len(diffRevisionFields(before, revisionFields(*expense))) == 0 {
+				result.Items = append(result.Items, item)
+				continue
+			}
+
+			revised := *expense
+			expenseTags := expense.Tags
+			expense.Category = CategoryEntity{}
+			expense.Tags = nil
+			expense.Splits = nil
+
+			if err := expenseRepo.Update(expense); err != nil {
+				return err
+			}
+
+			if operation == BulkAddTags || operation == BulkRemoveTags {
+				if err := expenseRepo.UpdateTags(expense, expenseTags); err != nil {
+					return err
+				}
+			}
+
+			if err := RecordRevision(revisionRepo, revised, before, authUserID, RevisionActionUpdate); err != nil {
+				return err
+			}
+
+			item.Status = BulkStatusUpdated
+			result.Items = append(result.Items, item)
+			result.Changed++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		if !slices.ContainsFunc(expenses, func(e ExpenseEntity) bool { return e.ID == id }) {
+			result.Items = append(result.Items, BulkItemResult{ID: id, Status: BulkStatusNotFound})
+		}
+	}
+	slices.SortFunc(result.Items, func(a BulkItemResult, b BulkItemResult) int {
+		return int(a.ID) - int(b.ID)
+	})
+
+	return result, nil
+}
+
+func (s *expenseService) update(expense *ExpenseEntity, authUserID uint, dto UpdateExpenseRequest, action RevisionAction) error {
+	before := revisionFields(*expense)
+
 	if dto.Date != nil {
 		expense.Date = dto.Date.Unix()
 	}
 
+	if dto.Kind != nil {
+		expense.Kind = Kind(*dto.Kind)
+	}
+
+	if err := dto.ValidateSplits(expense.Amount); err != nil {
+		return err
+	}
+
 	if dto.Amount != nil {
+		if !dto.Amount.IsPositive() {
+			return apperror.ErrInvalidRequest
+		}
 		expense.Amount = *dto.Amount
 	}
 
+	if dto.Currency != nil {
+		expense.Currency = *dto.Currency
+	}
+
+	if dto.AccountID != nil {
+		if *dto.AccountID == 0 {
+			expense.AccountID = nil
+		} else {
+			if err := s.checkAccount(*dto.AccountID, authUserID); err != nil {
+				return err
+			}
+			expense.AccountID = dto.AccountID
+		}
+	}
+
+	if dto.MerchantID != nil {
+		if *dto.MerchantID == 0 {
+			expense.MerchantID = nil
+		} else {
+			isOwner, err := s.merchantService.IsMerchantOwner(*dto.MerchantID, authUserID)
+			if err != nil {
+				return err
+			}
+			if !isOwner {
+				return apperror.ErrUnauthorized
+			}
+			expense.MerchantID = dto.MerchantID
+		}
+	}
+
 	if dto.Note != nil {
 		expense.Note = *dto.Note
 	}
@@ -105,25 +684,99 @@ func (s *expenseService) UpdateExpense(id uint, authUserID uint, dto UpdateExpen
 		expense.Tags = tags
 	}
 
-	err = s.db.Transaction(func(tx *gorm.DB) error {
+	if dto.Splits != nil {
+		splits, err := s.splits(*dto.Splits, authUserID)
+		if err != nil {
+			return err
+		}
+
+		expense.Splits = splits
+	} else if len(expense.Splits) > 0 && dto.Amount != nil {
+		// the kept splits have to add up to the new amount too
+		if err := validateSplits(expense.Amount, splitRequests(expense.Splits)); err != nil {
+			return err
+		}
+	}
+
+	revised := *expense
+	tags, splits := expense.Tags, expense.Splits
+	expense.Category = CategoryEntity{}
+	expense.Tags = nil
+	expense.Splits = nil
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
 		expenseRepo := s.expenseRepo.WithTx(tx)
 
 		if err := expenseRepo.Update(expense); err != nil {
 			return err
 		}
 
-		if err := expenseRepo.UpdateTags(expense, expense.Tags); err != nil {
-			return err
+		if dto.TagIDs != nil {
+			if err := expenseRepo.UpdateTags(expense, tags); err != nil {
+				return err
+			}
 		}
 
-		return nil
+		if dto.Splits != nil {
+			if err := expenseRepo.ReplaceSplits(expense.ID, splits); err != nil {
+				return err
+			}
+		}
+
+		return RecordRevision(s.revisionRepo.WithTx(tx), revised, before, authUserID, action)
 	})
+}
 
-	return nil
+// splits checks that the categories and tags of the requested splits belong to the user and builds the entities.
+func (s *expenseService) splits(requests []SplitRequest, authUserID uint) ([]ExpenseSplitEntity, error) {
+	splits := []ExpenseSplitEntity{}
+	for _, request := range requests {
+		isOwner, err := s.categoryService.IsCategoryOwner(request.CategoryID, authUserID)
+		if err != nil {
+			return nil, err
+		}
+		if !isOwner {
+			return nil, apperror.ErrUnauthorized
+		}
+
+		tags, err := s.tagService.GetTagsByIDs(request.TagIDs, authUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		splits = append(splits, ExpenseSplitEntity{
+			Amount:     request.Amount,
+			CategoryID: request.CategoryID,
+			Note:       request.Note,
+			Tags:       tags,
+		})
+	}
+
+	return splits, nil
 }
 
-func (s *expenseService) DeleteExpense(id uint, authUserID uint) error {
-	isOwner, err := s.expenseRepo.IsOwner(id, authUserID)
+func (s *expenseService) notify(expense ExpenseEntity) {
+	if s.listener != nil {
+		s.listener.ExpenseSaved(expense)
+	}
+}
+
+// merchant returns the merchant an expense was explicitly given, or else the one its note matches, if any.
+func (s *expenseService) merchant(merchantID *uint, note string, authUserID uint) (*MerchantEntity, error) {
+	if merchantID == nil || *merchantID == 0 {
+		return s.merchantService.MatchMerchant(authUserID, note)
+	}
+
+	merchant, err := s.merchantService.GetMerchantByID(*merchantID, authUserID)
+	if errors.Is(err, apperror.ErrNotFound) {
+		return nil, apperror.ErrUnauthorized
+	}
+
+	return merchant, err
+}
+
+func (s *expenseService) checkAccount(accountID uint, authUserID uint) error {
+	isOwner, err := s.accountService.IsAccountOwner(accountID, authUserID)
 	if err != nil {
 		return err
 	}
@@ -131,5 +784,5 @@ func (s *expenseService) DeleteExpense(id uint, authUserID uint) error {
 		return apperror.ErrUnauthorized
 	}
 
-	return s.expenseRepo.Delete(id)
+	return nil
 }
@@ -0,0 +1,174 @@
+package expense_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	accountMocks "github.com/Perajit/expense-tracker-go/internal/account/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestBulkUpdateExpenses(t *testing.T) {
+	t.Run("success_add_tags", func(t *testing.T) {
+		var userID uint = 11
+		tag := expense.TagEntity{Model: gorm.Model{ID: 6}, UserID: userID, Name: "trip"}
+		expenses := []expense.ExpenseEntity{
+			{Model: gorm.Model{ID: 1}, UserID: userID, Tags: []expense.TagEntity{}},
+			{Model: gorm.Model{ID: 2}, UserID: userID, Tags: []expense.TagEntity{tag}},
+		}
+		dto := expense.BulkExpenseRequest{IDs: []uint{2, 1, 3, 1}, Operation: "add_tags", TagIDs: []uint{6}}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("Find", mock.MatchedBy(func(q expense.ExpenseQuery) bool {
+			return q.UserID == userID && slices.Equal(q.IDs, []uint{1, 2, 3})
+		})).Return(expenses, nil).Once()
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Update", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.ID == 1
+		})).Return(nil).Once()
+		mockExpenseRepo.On("UpdateTags", mock.Anything, []expense.TagEntity{tag}).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.ExpenseID == 1 && slices.Equal(r.Changes, expense.FieldChanges{{Field: "tagIds", Before: "", After: "6"}})
+		})).Return(nil).Once()
+
+		mockTagService := new(mocks.MockTagService)
+		mockTagService.On("GetTagsByIDs", []uint{6}, userID).Return([]expense.TagEntity{tag}, nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, new(mocks.MockCategoryService), mockTagService, new(userMocks.MockUserService), new(accountMocks.MockAccountService), new(mocks.MockRuleService), new(mocks.MockMerchantService), nil)
+		result, err := service.BulkUpdateExpenses(userID, dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &expense.BulkResult{
+			Operation: expense.BulkAddTags,
+			Changed:   1,
+			Items: []expense.BulkItemResult{
+				{ID: 1, Status: expense.BulkStatusUpdated},
+				{ID: 2, Status: expense.BulkStatusUnchanged},
+				{ID: 3, Status: expense.BulkStatusNotFound},
+			},
+		}, result)
+		mockExpenseRepo.AssertExpectations(t)
+		mockRevisionRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_shift_date_by_filter", func(t *testing.T) {
+		var userID uint = 11
+		from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		date := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC).Unix()
+		dto := expense.BulkExpenseRequest{
+			Filter:    &expense.GetExpensesRequest{From: &from, Cursor: "ignored"},
+			Operation: "shift_date",
+			Days:      -1,
+		}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("Find", mock.MatchedBy(func(q expense.ExpenseQuery) bool {
+			return q.UserID == userID && *q.DateFrom == from.Unix() && q.Cursor == nil && q.SortBy == expense.SortByCreated
+		})).Return([]expense.ExpenseEntity{{Model: gorm.Model{ID: 4}, UserID: userID, Date: date}}, nil).Once()
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Update", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.ID == 4 && e.Date == date-24*60*60
+		})).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.Anything).Return(nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, new(mocks.MockCategoryService), new(mocks.MockTagService), new(userMocks.MockUserService), new(accountMocks.MockAccountService), new(mocks.MockRuleService), new(mocks.MockMerchantService), nil)
+		result, err := service.BulkUpdateExpenses(userID, dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Changed)
+		mockExpenseRepo.AssertExpectations(t)
+		mockExpenseRepo.AssertNotCalled(t, "UpdateTags", mock.Anything, mock.Anything)
+	})
+
+	t.Run("success_set_category_skips_split", func(t *testing.T) {
+		var userID uint = 11
+		var categoryID uint = 9
+		expenses := []expense.ExpenseEntity{
+			{Model: gorm.Model{ID: 1}, UserID: userID, CategoryID: 2, Splits: []expense.ExpenseSplitEntity{{ID: 5, CategoryID: 2}}},
+			{Model: gorm.Model{ID: 2}, UserID: userID, CategoryID: 2},
+		}
+		dto := expense.BulkExpenseRequest{IDs: []uint{1, 2}, Operation: "set_category", CategoryID: categoryID}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("Find", mock.Anything).Return(expenses, nil).Once()
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Update", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.ID == 2 && e.CategoryID == categoryID && e.Splits == nil
+		})).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool { return r.ExpenseID == 2 })).Return(nil).Once()
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockCategoryService.On("IsCategoryOwner", categoryID, userID).Return(true, nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, new(mocks.MockTagService), new(userMocks.MockUserService), new(accountMocks.MockAccountService), new(mocks.MockRuleService), new(mocks.MockMerchantService), nil)
+		result, err := service.BulkUpdateExpenses(userID, dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []expense.BulkItemResult{
+			{ID: 1, Status: expense.BulkStatusUnchanged},
+			{ID: 2, Status: expense.BulkStatusUpdated},
+		}, result.Items)
+		mockExpenseRepo.AssertExpectations(t)
+		mockRevisionRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_category_unauthorized", func(t *testing.T) {
+		var userID uint = 11
+		dto := expense.BulkExpenseRequest{IDs: []uint{1}, Operation: "set_category", CategoryID: 9}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockCategoryService.On("IsCategoryOwner", dto.CategoryID, userID).Return(false, nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, new(mocks.MockRevisionRepository), mockCategoryService, new(mocks.MockTagService), new(userMocks.MockUserService), new(accountMocks.MockAccountService), new(mocks.MockRuleService), new(mocks.MockMerchantService), nil)
+		result, err := service.BulkUpdateExpenses(userID, dto)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperror.ErrUnauthorized)
+		mockExpenseRepo.AssertNotCalled(t, "Find", mock.Anything)
+	})
+
+	t.Run("error_too_many_matches", func(t *testing.T) {
+		var userID uint = 11
+		dto := expense.BulkExpenseRequest{Filter: &expense.GetExpensesRequest{}, Operation: "delete"}
+		expenses := make([]expense.ExpenseEntity, 1001)
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("Find", mock.Anything).Return(expenses, nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, new(mocks.MockRevisionRepository), new(mocks.MockCategoryService), new(mocks.MockTagService), new(userMocks.MockUserService), new(accountMocks.MockAccountService), new(mocks.MockRuleService), new(mocks.MockMerchantService), nil)
+		result, err := service.BulkUpdateExpenses(userID, dto)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockExpenseRepo.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+}
@@ -5,9 +5,12 @@ import (
 	"testing"
 	"time"
 
+	accountMocks "github.com/Perajit/expense-tracker-go/internal/account/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
 	"github.com/Perajit/expense-tracker-go/internal/expense"
 	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
 	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -33,6 +36,7 @@ func TestCreateExpense(t *testing.T) {
 		db := testutil.SetupDB()
 
 		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
 		mockExpenseRepo.On("Create", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
 			if e.UserID != userID || e.Amount != dto.Amount || e.Note != dto.Note || e.CategoryID != dto.CategoryID {
 				return false
@@ -44,17 +48,292 @@ func TestCreateExpense(t *testing.T) {
 			return true
 		})).Return(nil).Once()
 
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.ActorID == userID && r.Action == expense.RevisionActionCreate &&
+				slices.Contains(r.Changes, expense.FieldChange{Field: "tagIds", After: "3,4"})
+		})).Return(nil).Once()
+
 		mockCategoryService := new(mocks.MockCategoryService)
 		mockCategoryService.On("IsCategoryOwner", dto.CategoryID, userID).Return(true, nil).Once()
 
 		mockTagService := new(mocks.MockTagService)
 		mockTagService.On("GetTagsByIDs", dto.TagIDs, userID).Return(tags, nil).Once()
 
-		service := expense.NewExpenseService(db, mockExpenseRepo, mockCategoryService, mockTagService)
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+
+		mockListener := new(mocks.MockExpenseListener)
+		mockListener.On("ExpenseSaved", mock.MatchedBy(func(e expense.ExpenseEntity) bool { return e.Note == dto.Note })).Return().Once()
+
+		mockMerchantService := new(mocks.MockMerchantService)
+		mockMerchantService.On("MatchMerchant", userID, dto.Note).Return(nil, nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, mockMerchantService, mockListener)
 		entity, err := service.CreateExpense(userID, dto)
 
 		assert.Equal(t, createdEntity, entity)
 		assert.NoError(t, err)
 		mockExpenseRepo.AssertExpectations(t)
+		mockRevisionRepo.AssertExpectations(t)
+		mockListener.AssertExpectations(t)
+	})
+
+	t.Run("success_income", func(t *testing.T) {
+		var userID uint = 11
+		dto := expense.CreateExpenseRequest{
+			Date:       time.Now(),
+			Kind:       "income",
+			Amount:     decimal.NewFromInt(5000),
+			Note:       "salary",
+			CategoryID: 2,
+		}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Create", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.Kind == expense.KindIncome && e.Amount.Equal(dto.Amount)
+		})).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.ActorID == userID && r.Action == expense.RevisionActionCreate
+		})).Return(nil).Once()
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockCategoryService.On("IsCategoryOwner", dto.CategoryID, userID).Return(true, nil).Once()
+
+		mockTagService := new(mocks.MockTagService)
+		mockTagService.On("GetTagsByIDs", []uint(nil), userID).Return([]expense.TagEntity{}, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+
+		mockMerchantService := new(mocks.MockMerchantService)
+		mockMerchantService.On("MatchMerchant", userID, dto.Note).Return(nil, nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, mockMerchantService, nil)
+		entity, err := service.CreateExpense(userID, dto)
+
+		assert.Equal(t, expense.KindIncome, entity.Kind)
+		assert.NoError(t, err)
+		mockExpenseRepo.AssertExpectations(t)
+		mockRevisionRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_non_positive_amount", func(t *testing.T) {
+		var userID uint = 11
+		dto := expense.CreateExpenseRequest{
+			Date:       time.Now(),
+			Amount:     decimal.NewFromInt(-100),
+			Note:       "salary",
+			CategoryID: 2,
+		}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+
+		mockCategoryService := new(mocks.MockCategoryService)
+
+		mockTagService := new(mocks.MockTagService)
+
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+
+		mockListener := new(mocks.MockExpenseListener)
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, new(mocks.MockMerchantService), mockListener)
+		entity, err := service.CreateExpense(userID, dto)
+
+		assert.Nil(t, entity)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockExpenseRepo.AssertNotCalled(t, "Create", mock.Anything)
+		mockListener.AssertNotCalled(t, "ExpenseSaved", mock.Anything)
+	})
+
+	t.Run("error_account_unauthorized", func(t *testing.T) {
+		var userID uint = 11
+		var accountID uint = 7
+		dto := expense.CreateExpenseRequest{
+			Date:       time.Now(),
+			Amount:     decimal.NewFromInt(100),
+			CategoryID: 2,
+			AccountID:  &accountID,
+		}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockCategoryService.On("IsCategoryOwner", dto.CategoryID, userID).Return(true, nil).Once()
+
+		mockTagService := new(mocks.MockTagService)
+		mockTagService.On("GetTagsByIDs", []uint(nil), userID).Return([]expense.TagEntity{}, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+		mockAccountService.On("IsAccountOwner", accountID, userID).Return(false, nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, new(mocks.MockMerchantService), nil)
+		entity, err := service.CreateExpense(userID, dto)
+
+		assert.Nil(t, entity)
+		assert.ErrorIs(t, err, apperror.ErrUnauthorized)
+		mockExpenseRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("success_rules", func(t *testing.T) {
+		var userID uint = 11
+		var categoryID uint = 5
+		setNote := "Coffee: {note}"
+		dto := expense.CreateExpenseRequest{
+			Date:   time.Now(),
+			Amount: decimal.NewFromInt(4),
+			Note:   "starbucks",
+		}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Create", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.CategoryID == categoryID && e.Note == "Coffee: starbucks"
+		})).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.ActorID == userID && r.Action == expense.RevisionActionCreate
+		})).Return(nil).Once()
+
+		mockCategoryService := new(mocks.MockCategoryService)
+
+		mockTagService := new(mocks.MockTagService)
+		mockTagService.On("GetTagsByIDs", []uint(nil), userID).Return([]expense.TagEntity{}, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+		mockRuleService.On("Evaluate", userID, mock.AnythingOfType("*expense.RuleSubject")).Run(func(args mock.Arguments) {
+			rule := expense.RuleEntity{Enabled: true, NoteContains: "STARBUCKS", SetCategoryID: &categoryID, SetNote: &setNote}
+			expense.ApplyRules([]expense.RuleEntity{rule}, args.Get(1).(*expense.RuleSubject))
+		}).Return(nil).Once()
+
+		mockMerchantService := new(mocks.MockMerchantService)
+		mockMerchantService.On("MatchMerchant", userID, dto.Note).Return(nil, nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, mockMerchantService, nil)
+		entity, err := service.CreateExpense(userID, dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, categoryID, entity.CategoryID)
+		mockExpenseRepo.AssertExpectations(t)
+		mockRevisionRepo.AssertExpectations(t)
+		mockCategoryService.AssertNotCalled(t, "IsCategoryOwner", mock.Anything, mock.Anything)
+	})
+
+	t.Run("success_merchant_defaults", func(t *testing.T) {
+		var userID uint = 11
+		var categoryID uint = 5
+		merchant := &expense.MerchantEntity{
+			Model:             gorm.Model{ID: 3},
+			Name:              "Starbucks",
+			DefaultCategoryID: &categoryID,
+			DefaultTags:       []expense.TagEntity{{Model: gorm.Model{ID: 7}, Name: "coffee"}},
+		}
+		dto := expense.CreateExpenseRequest{
+			Date:   time.Now(),
+			Amount: decimal.NewFromInt(4),
+			Note:   "STARBUCKS #1234 BKK",
+		}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Create", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.CategoryID == categoryID && *e.MerchantID == merchant.ID && len(e.Tags) == 1 && e.Note == dto.Note
+		})).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.AnythingOfType("*expense.ExpenseRevisionEntity")).Return(nil).Once()
+
+		mockTagService := new(mocks.MockTagService)
+		mockTagService.On("GetTagsByIDs", []uint(nil), userID).Return([]expense.TagEntity{}, nil).Once()
+
+		mockRuleService := new(mocks.MockRuleService)
+
+		mockMerchantService := new(mocks.MockMerchantService)
+		mockMerchantService.On("MatchMerchant", userID, dto.Note).Return(merchant, nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, new(mocks.MockCategoryService), mockTagService, new(userMocks.MockUserService), new(accountMocks.MockAccountService), mockRuleService, mockMerchantService, nil)
+		entity, err := service.CreateExpense(userID, dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, categoryID, entity.CategoryID)
+		mockExpenseRepo.AssertExpectations(t)
+		mockRuleService.AssertNotCalled(t, "Evaluate", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error_no_category", func(t *testing.T) {
+		var userID uint = 11
+		dto := expense.CreateExpenseRequest{
+			Date:   time.Now(),
+			Amount: decimal.NewFromInt(4),
+			Note:   "unknown",
+		}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+
+		mockCategoryService := new(mocks.MockCategoryService)
+
+		mockTagService := new(mocks.MockTagService)
+		mockTagService.On("GetTagsByIDs", []uint(nil), userID).Return([]expense.TagEntity{}, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+		mockRuleService.On("Evaluate", userID, mock.AnythingOfType("*expense.RuleSubject")).Return(nil).Once()
+
+		mockMerchantService := new(mocks.MockMerchantService)
+		mockMerchantService.On("MatchMerchant", userID, dto.Note).Return(nil, nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, mockMerchantService, nil)
+		entity, err := service.CreateExpense(userID, dto)
+
+		assert.Nil(t, entity)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockExpenseRepo.AssertNotCalled(t, "Create", mock.Anything)
 	})
 }
@@ -0,0 +1,163 @@
+package expense_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	accountMocks "github.com/Perajit/expense-tracker-go/internal/account/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExportExpenses(t *testing.T) {
+	var merchantID uint = 4
+	rows := []expense.ExpenseExportRow{
+		{ID: 1, Date: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC).Unix(), Kind: expense.KindExpense, Amount: decimal.NewFromInt(100), Currency: "USD", Note: "rent, jan", Category: "Housing", MerchantID: &merchantID, Merchant: "Landlord", Tags: expense.TagNames{"home"}},
+		{ID: 2, Date: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC).Unix(), Kind: expense.KindIncome, Amount: decimal.RequireFromString("12.5"), Currency: "THB", Note: "refund", Category: "Food", Tags: expense.TagNames{}},
+	}
+	streamRows := func(args mock.Arguments) {
+		fn := args.Get(1).(func(expense.ExpenseExportRow) error)
+		for _, row := range rows {
+			if err := fn(row); err != nil {
+				return
+			}
+		}
+	}
+
+	t.Run("success_csv", func(t *testing.T) {
+		var userID uint = 11
+		dto := expense.ExportExpensesRequest{
+			GetExpensesRequest: expense.GetExpensesRequest{CategoryIDs: []uint{2}, Cursor: "ignored", Limit: 5},
+			Format:             "csv",
+		}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("Stream", mock.MatchedBy(func(q expense.ExpenseQuery) bool {
+			return q.UserID == userID && q.Cursor == nil && q.Limit == 0 && !q.SortDesc && len(q.CategoryIDs) == 1
+		}), mock.Anything).Run(streamRows).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockTagService := new(mocks.MockTagService)
+
+		var buf bytes.Buffer
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, new(mocks.MockMerchantService), nil)
+		err := service.ExportExpenses(userID, dto, &buf)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "id,date,kind,amount,currency,note,category,merchant,tags\n"+
+			"1,2025-01-31,expense,100.00,USD,\"rent, jan\",Housing,Landlord,home\n"+
+			"2,2025-02-01,income,12.50,THB,refund,Food,,\n", buf.String())
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_jsonl", func(t *testing.T) {
+		var userID uint = 11
+		dto := expense.ExportExpensesRequest{Format: "jsonl"}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("Stream", mock.Anything, mock.Anything).Run(streamRows).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockTagService := new(mocks.MockTagService)
+
+		var buf bytes.Buffer
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, new(mocks.MockMerchantService), nil)
+		err := service.ExportExpenses(userID, dto, &buf)
+
+		assert.NoError(t, err)
+		assert.Equal(t, `{"id":1,"date":"2025-01-31","kind":"expense","amount":"100","currency":"USD","note":"rent, jan","category":"Housing","merchant":"Landlord","tags":["home"]}`+"\n"+
+			`{"id":2,"date":"2025-02-01","kind":"income","amount":"12.5","currency":"THB","note":"refund","category":"Food","merchant":"","tags":[]}`+"\n", buf.String())
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_xlsx", func(t *testing.T) {
+		var userID uint = 11
+		dto := expense.ExportExpensesRequest{Format: "xlsx"}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("Stream", mock.Anything, mock.Anything).Run(streamRows).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockTagService := new(mocks.MockTagService)
+
+		var buf bytes.Buffer
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, new(mocks.MockMerchantService), nil)
+		err := service.ExportExpenses(userID, dto, &buf)
+
+		assert.NoError(t, err)
+		archive, errZip := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		if assert.NoError(t, errZip) {
+			names := []string{}
+			for _, file := range archive.File {
+				names = append(names, file.Name)
+			}
+			assert.Contains(t, names, "xl/worksheets/sheet1.xml")
+		}
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_stream", func(t *testing.T) {
+		expectedErr := errors.New("db error")
+		dto := expense.ExportExpensesRequest{Format: "csv"}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("Stream", mock.Anything, mock.Anything).Return(expectedErr).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockTagService := new(mocks.MockTagService)
+
+		var buf bytes.Buffer
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, new(mocks.MockMerchantService), nil)
+		err := service.ExportExpenses(11, dto, &buf)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
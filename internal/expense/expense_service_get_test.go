@@ -4,9 +4,13 @@ import (
 	"testing"
 	"time"
 
+	accountMocks "github.com/Perajit/expense-tracker-go/internal/account/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
 	"github.com/Perajit/expense-tracker-go/internal/expense"
 	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
 	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
@@ -37,13 +41,21 @@ func TestGetExpenseByID(t *testing.T) {
 		mockExpenseRepo := new(mocks.MockExpenseRepository)
 		mockExpenseRepo.On("GetByIDAndUser", id, userID).Return(matchedEntity, nil).Once()
 
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+
 		mockCategoryService := new(mocks.MockCategoryService)
 
 		mockTagService := new(mocks.MockTagService)
 
 		db := testutil.SetupDB()
 
-		service := expense.NewExpenseService(db, mockExpenseRepo, mockCategoryService, mockTagService)
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, new(mocks.MockMerchantService), nil)
 		entity, err := service.GetExpenseByID(id, userID)
 
 		assert.Equal(t, matchedEntity, entity)
@@ -89,20 +101,127 @@ func TestGetExpenses(t *testing.T) {
 			},
 		}
 
+		converted := map[uint]decimal.Decimal{1: decimal.NewFromInt(100), 3: decimal.NewFromInt(3600)}
+
 		db := testutil.SetupDB()
 
+		query := expense.ExpenseQuery{
+			UserID:   userID,
+			TagMatch: expense.TagMatchAny,
+			SortBy:   expense.SortByDate,
+			SortDesc: true,
+			Limit:    50,
+		}
+
 		mockExpenseRepo := new(mocks.MockExpenseRepository)
-		mockExpenseRepo.On("GetByUser", userID).Return(matchedList, nil).Once()
+		mockExpenseRepo.On("Find", query).Return(matchedList, nil).Once()
+		mockExpenseRepo.On("Count", query).Return(int64(2), nil).Once()
+		mockExpenseRepo.On("ConvertAmounts", []uint{1, 3}, "THB").Return(converted, nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
 
 		mockCategoryService := new(mocks.MockCategoryService)
 
 		mockTagService := new(mocks.MockTagService)
 
-		service := expense.NewExpenseService(db, mockExpenseRepo, mockCategoryService, mockTagService)
-		list, err := service.GetExpenses(userID)
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+		mockUserService.On("GetUserByID", userID, userID).Return(&user.UserEntity{
+			Model:           gorm.Model{ID: userID},
+			DefaultCurrency: "THB",
+		}, nil).Once()
 
-		assert.Equal(t, matchedList, list)
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, new(mocks.MockMerchantService), nil)
+		page, err := service.GetExpenses(userID, expense.GetExpensesRequest{})
+
+		assert.Equal(t, matchedList, page.Items)
+		assert.Equal(t, int64(2), page.Total)
+		assert.Nil(t, page.Next)
+		assert.Equal(t, "THB", page.Currency)
+		assert.Equal(t, converted, page.Converted)
 		assert.NoError(t, err)
 		mockExpenseRepo.AssertExpectations(t)
+		mockUserService.AssertExpectations(t)
+	})
+
+	t.Run("success_next_cursor", func(t *testing.T) {
+		var userID uint = 11
+		matchedList := []expense.ExpenseEntity{
+			{Model: gorm.Model{ID: 1}, UserID: userID, Amount: decimal.NewFromInt(300)},
+			{Model: gorm.Model{ID: 2}, UserID: userID, Amount: decimal.NewFromInt(200)},
+			{Model: gorm.Model{ID: 3}, UserID: userID, Amount: decimal.NewFromInt(100)},
+		}
+		cursor := expense.ExpenseCursor{SortBy: expense.SortByAmount, Value: "500", ID: 9}
+		dto := expense.GetExpensesRequest{Sort: "amount", Cursor: cursor.Encode(), Limit: 2, Currency: "EUR"}
+		query := expense.ExpenseQuery{
+			UserID:   userID,
+			TagMatch: expense.TagMatchAny,
+			SortBy:   expense.SortByAmount,
+			SortDesc: true,
+			Cursor:   &cursor,
+			Limit:    2,
+		}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("Find", query).Return(matchedList, nil).Once()
+		mockExpenseRepo.On("Count", query).Return(int64(5), nil).Once()
+		mockExpenseRepo.On("ConvertAmounts", []uint{1, 2}, "EUR").Return(map[uint]decimal.Decimal{}, nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+
+		mockCategoryService := new(mocks.MockCategoryService)
+
+		mockTagService := new(mocks.MockTagService)
+
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, new(mocks.MockMerchantService), nil)
+		page, err := service.GetExpenses(userID, dto)
+
+		assert.Equal(t, matchedList[:2], page.Items)
+		assert.Equal(t, int64(5), page.Total)
+		assert.Equal(t, &expense.ExpenseCursor{SortBy: expense.SortByAmount, Value: "200", ID: 2}, page.Next)
+		assert.Equal(t, "EUR", page.Currency)
+		assert.NoError(t, err)
+		mockExpenseRepo.AssertExpectations(t)
+		mockUserService.AssertNotCalled(t, "GetUserByID", userID, userID)
+	})
+
+	t.Run("error_invalid_cursor", func(t *testing.T) {
+		var userID uint = 11
+		cursor := expense.ExpenseCursor{SortBy: expense.SortByDate, Value: "1700000000", ID: 9}
+		dto := expense.GetExpensesRequest{Sort: "amount", Cursor: cursor.Encode()}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+
+		mockCategoryService := new(mocks.MockCategoryService)
+
+		mockTagService := new(mocks.MockTagService)
+
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, new(mocks.MockMerchantService), nil)
+		page, err := service.GetExpenses(userID, dto)
+
+		assert.Nil(t, page)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockExpenseRepo.AssertExpectations(t)
 	})
 }
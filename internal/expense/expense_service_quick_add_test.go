@@ -0,0 +1,226 @@
+package expense_test
+
+import (
+	"testing"
+	"time"
+
+	accountMocks "github.com/Perajit/expense-tracker-go/internal/account/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestQuickAddExpense(t *testing.T) {
+	var userID uint = 11
+	u := &user.UserEntity{Model: gorm.Model{ID: userID}, Timezone: "Asia/Bangkok", DefaultCurrency: "USD"}
+	loc, _ := time.LoadLocation(u.Timezone)
+
+	t.Run("success", func(t *testing.T) {
+		now := time.Now().In(loc)
+		yesterday := time.Date(now.Year(), now.Month(), now.Day()-1, 0, 0, 0, 0, loc)
+		workTag := expense.TagEntity{Model: gorm.Model{ID: 5}, UserID: userID, Name: "Work"}
+		dto := expense.QuickAddRequest{Text: `lunch with team 1,250.50 thb yesterday #work #trip @"Eating out"`, CreateTags: true}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Create", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.Amount.Equal(decimal.RequireFromString("1250.50")) && e.Currency == "THB" &&
+				e.Date == yesterday.Unix() && e.Note == "lunch with team" && e.CategoryID == 4 &&
+				len(e.Tags) == 2 && e.Tags[1] == expense.TagEntity{UserID: userID, Name: "trip"}
+		})).Run(func(args mock.Arguments) {
+			args.Get(0).(*expense.ExpenseEntity).Tags[1].ID = 6
+		}).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.Anything).Return(nil).Once()
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockCategoryService.On("GetCategoriesByNames", []string{"Eating out"}, userID).Return([]expense.CategoryEntity{
+			{Model: gorm.Model{ID: 1}, UserID: 0, Name: "Eating Out"},
+			{Model: gorm.Model{ID: 3}, UserID: userID, ParentID: 2, Name: "Eating out"},
+			{Model: gorm.Model{ID: 4}, UserID: userID, Name: "eating out"},
+		}, nil).Once()
+		mockCategoryService.On("IsCategoryOwner", uint(4), userID).Return(true, nil).Once()
+
+		mockTagService := new(mocks.MockTagService)
+		mockTagService.On("GetTagsByNames", []string{"work", "trip"}, userID).Return([]expense.TagEntity{workTag}, nil).Once()
+		mockTagService.On("GetTagsByIDs", []uint{workTag.ID}, userID).Return([]expense.TagEntity{workTag}, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(u, nil).Once()
+
+		mockMerchantService := new(mocks.MockMerchantService)
+		mockMerchantService.On("MatchMerchant", userID, "lunch with team").Return(nil, nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, new(accountMocks.MockAccountService), new(mocks.MockRuleService), mockMerchantService, nil)
+		entity, tokens, err := service.QuickAddExpense(userID, dto)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, entity)
+		assert.Contains(t, tokens, expense.QuickAddToken{Text: "1,250.50", Kind: expense.QuickTokenAmount, Value: "1250.5"})
+		assert.Contains(t, tokens, expense.QuickAddToken{Text: "thb", Kind: expense.QuickTokenCurrency, Value: "THB"})
+		assert.Contains(t, tokens, expense.QuickAddToken{Text: "yesterday", Kind: expense.QuickTokenDate, Value: yesterday.Format("2006-01-02")})
+		assert.Contains(t, tokens, expense.QuickAddToken{Text: "#work", Kind: expense.QuickTokenTag, Value: "work", Detail: "tag 5 (Work)"})
+		assert.Contains(t, tokens, expense.QuickAddToken{Text: "#trip", Kind: expense.QuickTokenTag, Value: "trip", Detail: "new tag 6"})
+		assert.Contains(t, tokens, expense.QuickAddToken{Text: `@"Eating out"`, Kind: expense.QuickTokenCategory, Value: "Eating out", Detail: "category 4 (eating out)"})
+		mockExpenseRepo.AssertExpectations(t)
+		mockTagService.AssertExpectations(t)
+		mockTagService.AssertNotCalled(t, "CreateTag", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error_no_category_creates_no_tags", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(u, nil).Once()
+
+		mockTagService := new(mocks.MockTagService)
+		mockTagService.On("GetTagsByNames", []string{"trip"}, userID).Return([]expense.TagEntity{}, nil).Once()
+		mockTagService.On("GetTagsByIDs", []uint(nil), userID).Return([]expense.TagEntity{}, nil).Once()
+
+		mockMerchantService := new(mocks.MockMerchantService)
+		mockMerchantService.On("MatchMerchant", userID, "lunch").Return(nil, nil).Once()
+
+		mockRuleService := new(mocks.MockRuleService)
+		mockRuleService.On("Evaluate", userID, mock.Anything).Return(nil).Once()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, new(mocks.MockRevisionRepository), new(mocks.MockCategoryService), mockTagService, mockUserService, new(accountMocks.MockAccountService), mockRuleService, mockMerchantService, nil)
+		entity, _, err := service.QuickAddExpense(userID, expense.QuickAddRequest{Text: "lunch 12.50 #trip", CreateTags: true})
+
+		assert.Nil(t, entity)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockTagService.AssertNotCalled(t, "CreateTag", mock.Anything, mock.Anything)
+		mockExpenseRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("success_absolute_date_and_symbol", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Create", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.Amount.Equal(decimal.NewFromInt(230)) && e.Currency == "THB" && e.Note == "taxi all the way" &&
+				e.Date == time.Date(2026, 10, 1, 0, 0, 0, 0, loc).Unix() && e.CategoryID == 7
+		})).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.Anything).Return(nil).Once()
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockCategoryService.On("GetCategoriesByNames", []string{"Transport"}, userID).
+			Return([]expense.CategoryEntity{{Model: gorm.Model{ID: 7}, UserID: userID, Name: "Transport"}}, nil).Once()
+		mockCategoryService.On("IsCategoryOwner", uint(7), userID).Return(true, nil).Once()
+
+		mockTagService := new(mocks.MockTagService)
+		mockTagService.On("GetTagsByNames", []string(nil), userID).Return([]expense.TagEntity{}, nil).Once()
+		mockTagService.On("GetTagsByIDs", []uint(nil), userID).Return([]expense.TagEntity{}, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(u, nil).Once()
+
+		mockMerchantService := new(mocks.MockMerchantService)
+		mockMerchantService.On("MatchMerchant", userID, "taxi all the way").Return(nil, nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, new(accountMocks.MockAccountService), new(mocks.MockRuleService), mockMerchantService, nil)
+		_, _, err := service.QuickAddExpense(userID, expense.QuickAddRequest{Text: "taxi ฿230 2026-10-01 all the way @Transport"})
+
+		assert.NoError(t, err)
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_no_amount", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(u, nil).Once()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, new(mocks.MockRevisionRepository), new(mocks.MockCategoryService), new(mocks.MockTagService), mockUserService, new(accountMocks.MockAccountService), new(mocks.MockRuleService), new(mocks.MockMerchantService), nil)
+		entity, _, err := service.QuickAddExpense(userID, expense.QuickAddRequest{Text: "lunch yesterday @Food"})
+
+		assert.Nil(t, entity)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockExpenseRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("error_unknown_tag", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(u, nil).Once()
+
+		mockTagService := new(mocks.MockTagService)
+		mockTagService.On("GetTagsByNames", []string{"work"}, userID).Return([]expense.TagEntity{}, nil).Once()
+
+		service := expense.NewExpenseService(db, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository), new(mocks.MockCategoryService), mockTagService, mockUserService, new(accountMocks.MockAccountService), new(mocks.MockRuleService), new(mocks.MockMerchantService), nil)
+		entity, _, err := service.QuickAddExpense(userID, expense.QuickAddRequest{Text: "lunch 12.50 #work"})
+
+		assert.Nil(t, entity)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockTagService.AssertNotCalled(t, "CreateTag", mock.Anything, mock.Anything)
+	})
+
+	t.Run("success_default_category", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(u, nil).Once()
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockCategoryService.On("GetCategoriesByNames", []string{"Food"}, userID).
+			Return([]expense.CategoryEntity{{Model: gorm.Model{ID: 1}, UserID: 0, Name: "Food", IsDefault: true}}, nil).Once()
+		mockCategoryService.On("IsCategoryOwner", uint(1), userID).Return(true, nil).Once()
+
+		mockTagService := new(mocks.MockTagService)
+		mockTagService.On("GetTagsByNames", []string(nil), userID).Return([]expense.TagEntity{}, nil).Once()
+		mockTagService.On("GetTagsByIDs", []uint(nil), userID).Return([]expense.TagEntity{}, nil).Once()
+
+		mockMerchantService := new(mocks.MockMerchantService)
+		mockMerchantService.On("MatchMerchant", userID, "lunch").Return(nil, nil).Once()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Create", mock.MatchedBy(func(e *expense.ExpenseEntity) bool { return e.CategoryID == 1 })).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.Anything).Return(nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, new(accountMocks.MockAccountService), new(mocks.MockRuleService), mockMerchantService, nil)
+		_, tokens, err := service.QuickAddExpense(userID, expense.QuickAddRequest{Text: "lunch 12.50 @Food"})
+
+		assert.NoError(t, err)
+		assert.Contains(t, tokens, expense.QuickAddToken{Text: "@Food", Kind: expense.QuickTokenCategory, Value: "Food", Detail: "category 1 (Food)"})
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_unknown_category", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(u, nil).Once()
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockCategoryService.On("GetCategoriesByNames", []string{"Food"}, userID).Return([]expense.CategoryEntity{}, nil).Once()
+
+		service := expense.NewExpenseService(db, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository), mockCategoryService, new(mocks.MockTagService), mockUserService, new(accountMocks.MockAccountService), new(mocks.MockRuleService), new(mocks.MockMerchantService), nil)
+		entity, _, err := service.QuickAddExpense(userID, expense.QuickAddRequest{Text: "lunch 12.50 @Food"})
+
+		assert.Nil(t, entity)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+	})
+}
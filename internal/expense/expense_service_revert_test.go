@@ -0,0 +1,91 @@
+package expense_test
+
+import (
+	"slices"
+	"testing"
+
+	accountMocks "github.com/Perajit/expense-tracker-go/internal/account/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestRevertExpense(t *testing.T) {
+	var id uint = 1
+	var userID uint = 11
+	revisions := []expense.ExpenseRevisionEntity{
+		{ID: 4, ExpenseID: id, ActorID: userID, Action: expense.RevisionActionCreate, Changes: expense.FieldChanges{
+			{Field: "amount", After: "10"},
+			{Field: "note", After: "Lunch"},
+			{Field: "tagIds", After: "3"},
+		}},
+		{ID: 9, ExpenseID: id, ActorID: 12, Action: expense.RevisionActionUpdate, Changes: expense.FieldChanges{
+			{Field: "amount", Before: "10", After: "12"},
+			{Field: "note", Before: "Lunch", After: "Dinner"},
+		}},
+	}
+	newCurrent := func() *expense.ExpenseEntity {
+		return &expense.ExpenseEntity{
+			Model:  gorm.Model{ID: id},
+			UserID: userID,
+			Amount: decimal.NewFromInt(12),
+			Note:   "Dinner",
+			Tags:   []expense.TagEntity{{Model: gorm.Model{ID: 3}, UserID: userID, Name: "food"}},
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("GetByIDAndUser", id, userID).Return(newCurrent(), nil).Once()
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Update", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.Note == "Lunch" && e.Amount.Equal(decimal.NewFromInt(10))
+		})).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("GetByExpense", id).Return(revisions, nil).Once()
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.ActorID == userID && r.Action == expense.RevisionActionRevert && slices.Equal(r.Changes, expense.FieldChanges{
+				{Field: "amount", Before: "12", After: "10"},
+				{Field: "note", Before: "Dinner", After: "Lunch"},
+			})
+		})).Return(nil).Once()
+
+		mockListener := new(mocks.MockExpenseListener)
+		mockListener.On("ExpenseSaved", mock.MatchedBy(func(e expense.ExpenseEntity) bool { return e.ID == id && e.Note == "Lunch" })).Return().Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, new(mocks.MockCategoryService), new(mocks.MockTagService), new(userMocks.MockUserService), new(accountMocks.MockAccountService), new(mocks.MockRuleService), new(mocks.MockMerchantService), mockListener)
+		err := service.RevertExpense(id, 4, userID)
+
+		assert.NoError(t, err)
+		mockExpenseRepo.AssertExpectations(t)
+		mockExpenseRepo.AssertNotCalled(t, "UpdateTags", mock.Anything, mock.Anything)
+		mockRevisionRepo.AssertExpectations(t)
+		mockListener.AssertExpectations(t)
+	})
+
+	t.Run("error_revision_not_found", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("GetByIDAndUser", id, userID).Return(newCurrent(), nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("GetByExpense", id).Return(revisions, nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, new(mocks.MockCategoryService), new(mocks.MockTagService), new(userMocks.MockUserService), new(accountMocks.MockAccountService), new(mocks.MockRuleService), new(mocks.MockMerchantService), nil)
+		err := service.RevertExpense(id, 5, userID)
+
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+		mockExpenseRepo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+}
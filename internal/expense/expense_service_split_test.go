@@ -0,0 +1,166 @@
+package expense_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	accountMocks "github.com/Perajit/expense-tracker-go/internal/account/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestCreateSplitExpense(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var userID uint = 11
+		pharmacyTag := expense.TagEntity{Model: gorm.Model{ID: 5}, UserID: userID, Name: "pharmacy"}
+		dto := expense.CreateExpenseRequest{
+			Date:   time.Now(),
+			Amount: decimal.RequireFromString("84.50"),
+			Note:   "Supermarket",
+			Splits: []expense.SplitRequest{
+				{Amount: decimal.RequireFromString("52.30"), CategoryID: 2, Note: "groceries"},
+				{Amount: decimal.RequireFromString("20.20"), CategoryID: 3},
+				{Amount: decimal.NewFromInt(12), CategoryID: 4, TagIDs: []uint{pharmacyTag.ID}},
+			},
+		}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Create", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.CategoryID == 2 && len(e.Splits) == 3 &&
+				e.Splits[0].Note == "groceries" && e.Splits[1].CategoryID == 3 &&
+				slices.Equal(e.Splits[2].Tags, []expense.TagEntity{pharmacyTag})
+		})).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return slices.ContainsFunc(r.Changes, func(change expense.FieldChange) bool { return change.Field == "splits" })
+		})).Return(nil).Once()
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockCategoryService.On("IsCategoryOwner", mock.Anything, userID).Return(true, nil).Times(3)
+
+		mockTagService := new(mocks.MockTagService)
+		mockTagService.On("GetTagsByIDs", []uint(nil), userID).Return([]expense.TagEntity{}, nil).Times(3)
+		mockTagService.On("GetTagsByIDs", []uint{pharmacyTag.ID}, userID).Return([]expense.TagEntity{pharmacyTag}, nil).Once()
+
+		mockRuleService := new(mocks.MockRuleService)
+
+		mockMerchantService := new(mocks.MockMerchantService)
+		mockMerchantService.On("MatchMerchant", userID, dto.Note).Return(nil, nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, new(userMocks.MockUserService), new(accountMocks.MockAccountService), mockRuleService, mockMerchantService, nil)
+		entity, err := service.CreateExpense(userID, dto)
+
+		assert.NoError(t, err)
+		assert.Len(t, entity.Splits, 3)
+		mockExpenseRepo.AssertExpectations(t)
+		mockRuleService.AssertNotCalled(t, "Evaluate", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error_sum_mismatch", func(t *testing.T) {
+		var userID uint = 11
+		dto := expense.CreateExpenseRequest{
+			Date:   time.Now(),
+			Amount: decimal.NewFromInt(80),
+			Splits: []expense.SplitRequest{
+				{Amount: decimal.NewFromInt(50), CategoryID: 2},
+				{Amount: decimal.NewFromInt(20), CategoryID: 3},
+			},
+		}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, new(mocks.MockRevisionRepository), new(mocks.MockCategoryService), new(mocks.MockTagService), new(userMocks.MockUserService), new(accountMocks.MockAccountService), new(mocks.MockRuleService), new(mocks.MockMerchantService), nil)
+		entity, err := service.CreateExpense(userID, dto)
+
+		assert.Nil(t, entity)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockExpenseRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
+
+func TestUpdateSplitExpense(t *testing.T) {
+	var id uint = 1
+	var userID uint = 11
+
+	existingEntity := func() *expense.ExpenseEntity {
+		return &expense.ExpenseEntity{
+			Model:      gorm.Model{ID: id},
+			UserID:     userID,
+			Amount:     decimal.NewFromInt(70),
+			CategoryID: 2,
+			Splits: []expense.ExpenseSplitEntity{
+				{ID: 8, ExpenseID: id, Amount: decimal.NewFromInt(50), CategoryID: 2},
+				{ID: 9, ExpenseID: id, Amount: decimal.NewFromInt(20), CategoryID: 3},
+			},
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		amount := decimal.NewFromInt(90)
+		splits := []expense.SplitRequest{
+			{Amount: decimal.NewFromInt(50), CategoryID: 2},
+			{Amount: decimal.NewFromInt(40), CategoryID: 3},
+		}
+		dto := expense.UpdateExpenseRequest{Amount: &amount, Splits: &splits}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("GetByIDAndUser", id, userID).Return(existingEntity(), nil).Once()
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Update", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.Amount.Equal(amount) && e.Splits == nil
+		})).Return(nil).Once()
+		mockExpenseRepo.On("ReplaceSplits", id, mock.MatchedBy(func(s []expense.ExpenseSplitEntity) bool {
+			return len(s) == 2 && s[1].Amount.Equal(decimal.NewFromInt(40))
+		})).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.Anything).Return(nil).Once()
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockCategoryService.On("IsCategoryOwner", mock.Anything, userID).Return(true, nil).Twice()
+
+		mockTagService := new(mocks.MockTagService)
+		mockTagService.On("GetTagsByIDs", []uint(nil), userID).Return([]expense.TagEntity{}, nil).Twice()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, new(userMocks.MockUserService), new(accountMocks.MockAccountService), new(mocks.MockRuleService), new(mocks.MockMerchantService), nil)
+		err := service.UpdateExpense(id, userID, dto)
+
+		assert.NoError(t, err)
+		mockExpenseRepo.AssertExpectations(t)
+		mockRevisionRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_amount_without_splits", func(t *testing.T) {
+		amount := decimal.NewFromInt(90)
+		dto := expense.UpdateExpenseRequest{Amount: &amount}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("GetByIDAndUser", id, userID).Return(existingEntity(), nil).Once()
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, new(mocks.MockRevisionRepository), new(mocks.MockCategoryService), new(mocks.MockTagService), new(userMocks.MockUserService), new(accountMocks.MockAccountService), new(mocks.MockRuleService), new(mocks.MockMerchantService), nil)
+		err := service.UpdateExpense(id, userID, dto)
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockExpenseRepo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+}
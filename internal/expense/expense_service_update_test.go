@@ -5,9 +5,11 @@ import (
 	"testing"
 	"time"
 
+	accountMocks "github.com/Perajit/expense-tracker-go/internal/account/mocks"
 	"github.com/Perajit/expense-tracker-go/internal/expense"
 	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
 	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -46,7 +48,7 @@ func TestUpdateExpense(t *testing.T) {
 		db := testutil.SetupDB()
 
 		mockExpenseRepo := new(mocks.MockExpenseRepository)
-		mockExpenseRepo.On("GetByIDAndUserNoAssociation", id, userID).Return(existingEntity, nil)
+		mockExpenseRepo.On("GetByIDAndUser", id, userID).Return(existingEntity, nil)
 		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo)
 		mockExpenseRepo.On("Update", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
 			if e.ID != existingEntity.ID || e.UserID != existingEntity.UserID {
@@ -75,6 +77,14 @@ func TestUpdateExpense(t *testing.T) {
 			}),
 		).Return(nil).Once()
 
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.ExpenseID == id && r.Action == expense.RevisionActionUpdate &&
+				slices.Contains(r.Changes, expense.FieldChange{Field: "note", Before: "expense1", After: "new"}) &&
+				slices.Contains(r.Changes, expense.FieldChange{Field: "tagIds", Before: "", After: "6,7,8"})
+		})).Return(nil).Once()
+
 		mockCategoryService := new(mocks.MockCategoryService)
 		mockCategoryService.On("IsCategoryOwner", newCategoryID, userID).Return(true, nil).Once()
 
@@ -83,10 +93,66 @@ func TestUpdateExpense(t *testing.T) {
 			return slices.Equal(l, *dto.TagIDs)
 		}), userID).Return(tags, nil)
 
-		service := expense.NewExpenseService(db, mockExpenseRepo, mockCategoryService, mockTagService)
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, new(mocks.MockMerchantService), nil)
+		err := service.UpdateExpense(id, userID, dto)
+
+		assert.NoError(t, err)
+		mockExpenseRepo.AssertExpectations(t)
+		mockRevisionRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_keeps_tags", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+		newNote := "new"
+		dto := expense.UpdateExpenseRequest{Note: &newNote}
+		existingEntity := &expense.ExpenseEntity{
+			Model:      gorm.Model{ID: id},
+			UserID:     userID,
+			Amount:     decimal.NewFromInt(100),
+			Note:       "expense1",
+			CategoryID: 2,
+			Category:   expense.CategoryEntity{Model: gorm.Model{ID: 2}},
+			Tags:       []expense.TagEntity{{Model: gorm.Model{ID: 6}, UserID: userID, Name: "tag6"}},
+		}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("GetByIDAndUser", id, userID).Return(existingEntity, nil).Once()
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Update", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.Note == newNote && e.Category.ID == 0 && e.Tags == nil
+		})).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return slices.Equal(r.Changes, expense.FieldChanges{{Field: "note", Before: "expense1", After: newNote}})
+		})).Return(nil).Once()
+
+		mockCategoryService := new(mocks.MockCategoryService)
+
+		mockTagService := new(mocks.MockTagService)
+
+		mockUserService := new(userMocks.MockUserService)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+
+		mockRuleService := new(mocks.MockRuleService)
+
+		service := expense.NewExpenseService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService, mockUserService, mockAccountService, mockRuleService, new(mocks.MockMerchantService), nil)
 		err := service.UpdateExpense(id, userID, dto)
 
 		assert.NoError(t, err)
 		mockExpenseRepo.AssertExpectations(t)
+		mockExpenseRepo.AssertNotCalled(t, "UpdateTags", mock.Anything, mock.Anything)
+		mockRevisionRepo.AssertExpectations(t)
 	})
 }
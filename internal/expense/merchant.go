@@ -0,0 +1,95 @@
+package expense
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// NormalizeDescription lowercases a raw description and drops what changes from one payment to the next at the
+// same merchant: punctuation, and words with three or more digits such as store, terminal and card numbers.
+// "STARBUCKS #1234 BKK" becomes "starbucks bkk".
+func NormalizeDescription(description string) string {
+	return normalizeWords(description, false)
+}
+
+func normalizeWords(text string, wildcard bool) string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !(wildcard && r == '*')
+	})
+
+	words := []string{}
+	for _, field := range fields {
+		digits := 0
+		for _, r := range field {
+			if unicode.IsDigit(r) {
+				digits++
+			}
+		}
+		if digits < 3 {
+			words = append(words, field)
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// aliasPattern compiles an alias into a regexp over normalized descriptions. An alias matches whole words in order,
+// with "*" standing for the rest of a word, so "starbucks" and "star*" both match "starbucks bkk" but not "superstar".
+// It also returns how specific the alias is, the length of its fixed text.
+func aliasPattern(alias string) (*regexp.Regexp, int, bool) {
+	words := strings.Fields(normalizeWords(alias, true))
+	if len(words) == 0 {
+		return nil, 0, false
+	}
+
+	specificity := 0
+	parts := make([]string, len(words))
+	for i, word := range words {
+		pieces := strings.Split(word, "*")
+		for j, piece := range pieces {
+			specificity += len(piece)
+			pieces[j] = regexp.QuoteMeta(piece)
+		}
+		parts[i] = strings.Join(pieces, `[^ ]*`)
+	}
+	if specificity == 0 {
+		return nil, 0, false
+	}
+
+	return regexp.MustCompile(`(^| )` + strings.Join(parts, " ") + `( |$)`), specificity, true
+}
+
+// ValidAlias tells whether an alias can match anything, i.e. has some letters or digits besides wildcards.
+func ValidAlias(alias string) bool {
+	_, _, ok := aliasPattern(alias)
+	return ok
+}
+
+// MatchMerchant finds the merchant a raw description belongs to. A merchant's name counts as one of its aliases;
+// when several match, the most specific alias wins, then the merchant created first.
+func MatchMerchant(merchants []MerchantEntity, description string) *MerchantEntity {
+	normalized := NormalizeDescription(description)
+	if normalized == "" {
+		return nil
+	}
+
+	var found *MerchantEntity
+	best := 0
+	for i, merchant := range merchants {
+		patterns := []string{merchant.Name}
+		for _, alias := range merchant.Aliases {
+			patterns = append(patterns, alias.Pattern)
+		}
+
+		for _, pattern := range patterns {
+			re, specificity, ok := aliasPattern(pattern)
+			if !ok || specificity <= best || !re.MatchString(normalized) {
+				continue
+			}
+			found, best = &merchants[i], specificity
+		}
+	}
+
+	return found
+}
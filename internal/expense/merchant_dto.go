@@ -0,0 +1,54 @@
+package expense
+
+type CreateMerchantRequest struct {
+	Name              string   `json:"name" validate:"required,max=100"`
+	Aliases           []string `json:"aliases" validate:"omitempty,dive,required,max=100"`
+	DefaultCategoryID *uint    `json:"defaultCategoryId"`
+	DefaultTagIDs     []uint   `json:"defaultTagIds"`
+}
+
+// UpdateMerchantRequest changes only the fields given; a zero category id clears the default category.
+type UpdateMerchantRequest struct {
+	Name              *string   `json:"name" validate:"omitempty,max=100"`
+	Aliases           *[]string `json:"aliases" validate:"omitempty,dive,required,max=100"`
+	DefaultCategoryID *uint     `json:"defaultCategoryId"`
+	DefaultTagIDs     *[]uint   `json:"defaultTagIds"`
+}
+
+type MatchMerchantRequest struct {
+	Description string `query:"description" validate:"required"`
+}
+
+type MerchantResponse struct {
+	ID                uint          `json:"id"`
+	Name              string        `json:"name"`
+	Aliases           []string      `json:"aliases"`
+	DefaultCategoryID *uint         `json:"defaultCategoryId"`
+	DefaultTags       []TagResponse `json:"defaultTags"`
+}
+
+func (MerchantResponse) FromEntity(merchant MerchantEntity) MerchantResponse {
+	aliases := make([]string, len(merchant.Aliases))
+	for i, alias := range merchant.Aliases {
+		aliases[i] = alias.Pattern
+	}
+
+	tags := make([]TagResponse, len(merchant.DefaultTags))
+	for i, tag := range merchant.DefaultTags {
+		tags[i] = TagResponse{}.FromEntity(tag)
+	}
+
+	return MerchantResponse{
+		ID:                merchant.ID,
+		Name:              merchant.Name,
+		Aliases:           aliases,
+		DefaultCategoryID: merchant.DefaultCategoryID,
+		DefaultTags:       tags,
+	}
+}
+
+// MerchantMatchResponse shows how a description was normalized and which merchant, if any, it maps to.
+type MerchantMatchResponse struct {
+	Normalized string            `json:"normalized"`
+	Merchant   *MerchantResponse `json:"merchant"`
+}
@@ -0,0 +1,29 @@
+package expense
+
+import "gorm.io/gorm"
+
+// MerchantEntity is who an expense was paid to. Its aliases map the raw descriptions on receipts and bank
+// statements to it, and its defaults file new expenses that come without a category or tags.
+type MerchantEntity struct {
+	gorm.Model
+	UserID            uint                  `gorm:"not null;uniqueIndex:idx_merchants_user_name_active,where:deleted_at IS NULL"`
+	Name              string                `gorm:"not null;uniqueIndex:idx_merchants_user_name_active,where:deleted_at IS NULL"`
+	DefaultCategoryID *uint                 `gorm:"index"`
+	DefaultTags       []TagEntity           `gorm:"many2many:merchants_tags;"`
+	Aliases           []MerchantAliasEntity `gorm:"foreignKey:MerchantID"`
+}
+
+func (MerchantEntity) TableName() string {
+	return "merchants"
+}
+
+// MerchantAliasEntity is one pattern a merchant is recognized by, see MatchMerchant.
+type MerchantAliasEntity struct {
+	ID         uint   `gorm:"primarykey"`
+	MerchantID uint   `gorm:"not null;index"`
+	Pattern    string `gorm:"not null"`
+}
+
+func (MerchantAliasEntity) TableName() string {
+	return "merchant_aliases"
+}
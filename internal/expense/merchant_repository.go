@@ -0,0 +1,125 @@
+package expense
+
+import "gorm.io/gorm"
+
+type MerchantRepository interface {
+	WithTx(tx *gorm.DB) MerchantRepository
+	GetByUser(userID uint) ([]MerchantEntity, error)
+	GetByIDAndUser(id uint, userID uint) (*MerchantEntity, error)
+	IsOwner(id uint, userID uint) (bool, error)
+	ExistsByName(userID uint, excludeID uint, name string) (bool, error)
+	Create(merchant *MerchantEntity) error
+	Update(merchant *MerchantEntity) error
+	ReplaceAliases(merchantID uint, aliases []MerchantAliasEntity) error
+	UpdateTags(merchant *MerchantEntity, tags []TagEntity) error
+	Delete(id uint) error
+}
+
+type merchantRepository struct {
+	db *gorm.DB
+}
+
+func NewMerchantRepository(db *gorm.DB) MerchantRepository {
+	return &merchantRepository{db: db}
+}
+
+func (r *merchantRepository) WithTx(tx *gorm.DB) MerchantRepository {
+	if tx == nil {
+		return r
+	}
+
+	return &merchantRepository{db: tx}
+}
+
+func (r *merchantRepository) GetByUser(userID uint) ([]MerchantEntity, error) {
+	var merchants []MerchantEntity
+	if err := r.db.Preload("Aliases").
+		Preload("DefaultTags").
+		Where("user_id = ?", userID).
+		Order("id").
+		Find(&merchants).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return merchants, nil
+}
+
+func (r *merchantRepository) GetByIDAndUser(id uint, userID uint) (*MerchantEntity, error) {
+	var merchant MerchantEntity
+	if err := r.db.Preload("Aliases").
+		Preload("DefaultTags").
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		First(&merchant).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &merchant, nil
+}
+
+func (r *merchantRepository) IsOwner(id uint, userID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&MerchantEntity{}).Where("id = ?", id).Where("user_id = ?", userID).Count(&count).Error
+
+	return count > 0, err
+}
+
+// ExistsByName compares names case-insensitively, leaving out the merchant being renamed.
+func (r *merchantRepository) ExistsByName(userID uint, excludeID uint, name string) (bool, error) {
+	var count int64
+	err := r.db.Model(&MerchantEntity{}).
+		Where("user_id = ?", userID).
+		Where("LOWER(name) = LOWER(?)", name).
+		Where("id <> ?", excludeID).
+		Count(&count).
+		Error
+
+	return count > 0, err
+}
+
+func (r *merchantRepository) Create(merchant *MerchantEntity) error {
+	return r.db.Create(merchant).Error
+}
+
+func (r *merchantRepository) Update(merchant *MerchantEntity) error {
+	return r.db.Omit("Aliases", "DefaultTags").Save(merchant).Error
+}
+
+func (r *merchantRepository) ReplaceAliases(merchantID uint, aliases []MerchantAliasEntity) error {
+	if err := r.db.Where("merchant_id = ?", merchantID).Delete(&MerchantAliasEntity{}).Error; err != nil {
+		return err
+	}
+
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	for i := range aliases {
+		aliases[i].MerchantID = merchantID
+	}
+
+	return r.db.Create(&aliases).Error
+}
+
+func (r *merchantRepository) UpdateTags(merchant *MerchantEntity, tags []TagEntity) error {
+	return r.db.Model(merchant).Association("DefaultTags").Replace(tags)
+}
+
+// Delete removes the merchant for good; its expenses stay, without a merchant.
+func (r *merchantRepository) Delete(id uint) error {
+	if err := r.db.Exec("UPDATE expenses SET merchant_id = NULL WHERE merchant_id = ?", id).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.Where("merchant_id = ?", id).Delete(&MerchantAliasEntity{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.Exec("DELETE FROM merchants_tags WHERE merchant_entity_id = ?", id).Error; err != nil {
+		return err
+	}
+
+	return r.db.Unscoped().Delete(&MerchantEntity{}, id).Error
+}
@@ -0,0 +1,213 @@
+package expense
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"gorm.io/gorm"
+)
+
+type MerchantService interface {
+	GetMerchants(authUserID uint) ([]MerchantEntity, error)
+	GetMerchantByID(id uint, authUserID uint) (*MerchantEntity, error)
+	IsMerchantOwner(id uint, authUserID uint) (bool, error)
+	MatchMerchant(authUserID uint, description string) (*MerchantEntity, error)
+	CreateMerchant(authUserID uint, dto CreateMerchantRequest) (*MerchantEntity, error)
+	UpdateMerchant(id uint, authUserID uint, dto UpdateMerchantRequest) error
+	DeleteMerchant(id uint, authUserID uint) error
+}
+
+type merchantService struct {
+	db              *gorm.DB
+	merchantRepo    MerchantRepository
+	categoryService CategoryService
+	tagService      TagService
+}
+
+func NewMerchantService(db *gorm.DB, merchantRepo MerchantRepository, categoryService CategoryService, tagService TagService) MerchantService {
+	return &merchantService{
+		db:              db,
+		merchantRepo:    merchantRepo,
+		categoryService: categoryService,
+		tagService:      tagService,
+	}
+}
+
+func (s *merchantService) GetMerchants(authUserID uint) ([]MerchantEntity, error) {
+	return s.merchantRepo.GetByUser(authUserID)
+}
+
+func (s *merchantService) GetMerchantByID(id uint, authUserID uint) (*MerchantEntity, error) {
+	merchant, err := s.merchantRepo.GetByIDAndUser(id, authUserID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperror.ErrNotFound
+	}
+
+	return merchant, err
+}
+
+func (s *merchantService) IsMerchantOwner(id uint, authUserID uint) (bool, error) {
+	return s.merchantRepo.IsOwner(id, authUserID)
+}
+
+// MatchMerchant returns the user's merchant for a raw description, or nil when none of them matches.
+func (s *merchantService) MatchMerchant(authUserID uint, description string) (*MerchantEntity, error) {
+	if NormalizeDescription(description) == "" {
+		return nil, nil
+	}
+
+	merchants, err := s.merchantRepo.GetByUser(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return MatchMerchant(merchants, description), nil
+}
+
+func (s *merchantService) CreateMerchant(authUserID uint, dto CreateMerchantRequest) (*MerchantEntity, error) {
+	aliases, err := merchantAliases(dto.Aliases)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.getTags(dto.DefaultTagIDs, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	merchant := &MerchantEntity{
+		UserID:            authUserID,
+		Name:              dto.Name,
+		DefaultCategoryID: dto.DefaultCategoryID,
+		DefaultTags:       tags,
+		Aliases:           aliases,
+	}
+	if err := s.validate(merchant, authUserID); err != nil {
+		return nil, err
+	}
+
+	if err := s.merchantRepo.Create(merchant); err != nil {
+		return nil, err
+	}
+
+	return merchant, nil
+}
+
+func (s *merchantService) UpdateMerchant(id uint, authUserID uint, dto UpdateMerchantRequest) error {
+	merchant, err := s.merchantRepo.GetByIDAndUser(id, authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	if dto.Name != nil {
+		merchant.Name = *dto.Name
+	}
+
+	if dto.Aliases != nil {
+		aliases, err := merchantAliases(*dto.Aliases)
+		if err != nil {
+			return err
+		}
+		merchant.Aliases = aliases
+	}
+
+	if dto.DefaultCategoryID != nil {
+		merchant.DefaultCategoryID = dto.DefaultCategoryID
+		if *dto.DefaultCategoryID == 0 {
+			merchant.DefaultCategoryID = nil
+		}
+	}
+
+	if dto.DefaultTagIDs != nil {
+		tags, err := s.getTags(*dto.DefaultTagIDs, authUserID)
+		if err != nil {
+			return err
+		}
+		merchant.DefaultTags = tags
+	}
+
+	if err := s.validate(merchant, authUserID); err != nil {
+		return err
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		merchantRepo := s.merchantRepo.WithTx(tx)
+
+		if err := merchantRepo.Update(merchant); err != nil {
+			return err
+		}
+
+		if dto.Aliases != nil {
+			if err := merchantRepo.ReplaceAliases(merchant.ID, merchant.Aliases); err != nil {
+				return err
+			}
+		}
+
+		return merchantRepo.UpdateTags(merchant, merchant.DefaultTags)
+	})
+}
+
+func (s *merchantService) DeleteMerchant(id uint, authUserID uint) error {
+	isOwner, err := s.merchantRepo.IsOwner(id, authUserID)
+	if err != nil {
+		return err
+	}
+	if !isOwner {
+		return apperror.ErrNotFound
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		return s.merchantRepo.WithTx(tx).Delete(id)
+	})
+}
+
+func (s *merchantService) validate(merchant *MerchantEntity, authUserID uint) error {
+	duplicated, err := s.merchantRepo.ExistsByName(authUserID, merchant.ID, merchant.Name)
+	if err != nil {
+		return err
+	}
+	if duplicated {
+		return apperror.ErrRecordDuplication
+	}
+
+	if merchant.DefaultCategoryID != nil {
+		isOwner, err := s.categoryService.IsCategoryOwner(*merchant.DefaultCategoryID, authUserID)
+		if err != nil {
+			return err
+		}
+		if !isOwner {
+			return apperror.ErrUnauthorized
+		}
+	}
+
+	return nil
+}
+
+func (s *merchantService) getTags(ids []uint, authUserID uint) ([]TagEntity, error) {
+	if len(ids) == 0 {
+		return []TagEntity{}, nil
+	}
+
+	tags, err := s.tagService.GetTagsByIDs(ids, authUserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) != len(ids) {
+		return nil, apperror.ErrUnauthorized
+	}
+
+	return tags, nil
+}
+
+func merchantAliases(patterns []string) ([]MerchantAliasEntity, error) {
+	aliases := []MerchantAliasEntity{}
+	for _, pattern := range patterns {
+		if !ValidAlias(pattern) {
+			return nil, fmt.Errorf("%w: alias %q matches nothing", apperror.ErrInvalidRequest, pattern)
+		}
+		aliases = append(aliases, MerchantAliasEntity{Pattern: pattern})
+	}
+
+	return aliases, nil
+}
@@ -0,0 +1,101 @@
+package expense_test
+
+import (
+	"testing"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestCreateMerchant(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var userID uint = 11
+		var categoryID uint = 5
+		dto := expense.CreateMerchantRequest{
+			Name:              "Starbucks",
+			Aliases:           []string{"sbux*", "starbucks coffee"},
+			DefaultCategoryID: &categoryID,
+			DefaultTagIDs:     []uint{7},
+		}
+
+		db := testutil.SetupDB()
+
+		mockMerchantRepo := new(mocks.MockMerchantRepository)
+		mockMerchantRepo.On("ExistsByName", userID, uint(0), "Starbucks").Return(false, nil).Once()
+		mockMerchantRepo.On("Create", mock.MatchedBy(func(m *expense.MerchantEntity) bool {
+			return m.UserID == userID && len(m.Aliases) == 2 && m.Aliases[0].Pattern == "sbux*" && len(m.DefaultTags) == 1
+		})).Return(nil).Once()
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockCategoryService.On("IsCategoryOwner", categoryID, userID).Return(true, nil).Once()
+
+		mockTagService := new(mocks.MockTagService)
+		mockTagService.On("GetTagsByIDs", []uint{7}, userID).Return([]expense.TagEntity{{Model: gorm.Model{ID: 7}, Name: "coffee"}}, nil).Once()
+
+		service := expense.NewMerchantService(db, mockMerchantRepo, mockCategoryService, mockTagService)
+		merchant, err := service.CreateMerchant(userID, dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Starbucks", merchant.Name)
+		mockMerchantRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_duplicate_name", func(t *testing.T) {
+		var userID uint = 11
+		dto := expense.CreateMerchantRequest{Name: "Starbucks"}
+
+		db := testutil.SetupDB()
+
+		mockMerchantRepo := new(mocks.MockMerchantRepository)
+		mockMerchantRepo.On("ExistsByName", userID, uint(0), "Starbucks").Return(true, nil).Once()
+
+		service := expense.NewMerchantService(db, mockMerchantRepo, new(mocks.MockCategoryService), new(mocks.MockTagService))
+		merchant, err := service.CreateMerchant(userID, dto)
+
+		assert.Nil(t, merchant)
+		assert.ErrorIs(t, err, apperror.ErrRecordDuplication)
+		mockMerchantRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("error_invalid_alias", func(t *testing.T) {
+		var userID uint = 11
+		dto := expense.CreateMerchantRequest{Name: "Starbucks", Aliases: []string{"**"}}
+
+		db := testutil.SetupDB()
+
+		mockMerchantRepo := new(mocks.MockMerchantRepository)
+
+		service := expense.NewMerchantService(db, mockMerchantRepo, new(mocks.MockCategoryService), new(mocks.MockTagService))
+		merchant, err := service.CreateMerchant(userID, dto)
+
+		assert.Nil(t, merchant)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockMerchantRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("error_category_unauthorized", func(t *testing.T) {
+		var userID uint = 11
+		var categoryID uint = 9
+		dto := expense.CreateMerchantRequest{Name: "Starbucks", DefaultCategoryID: &categoryID}
+
+		db := testutil.SetupDB()
+
+		mockMerchantRepo := new(mocks.MockMerchantRepository)
+		mockMerchantRepo.On("ExistsByName", userID, uint(0), "Starbucks").Return(false, nil).Once()
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockCategoryService.On("IsCategoryOwner", categoryID, userID).Return(false, nil).Once()
+
+		service := expense.NewMerchantService(db, mockMerchantRepo, mockCategoryService, new(mocks.MockTagService))
+		merchant, err := service.CreateMerchant(userID, dto)
+
+		assert.Nil(t, merchant)
+		assert.ErrorIs(t, err, apperror.ErrUnauthorized)
+		mockMerchantRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
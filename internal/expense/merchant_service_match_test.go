@@ -0,0 +1,64 @@
+package expense_test
+
+import (
+	"testing"
+
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestMatchMerchant(t *testing.T) {
+	merchants := []expense.MerchantEntity{
+		{Model: gorm.Model{ID: 1}, Name: "Starbucks", Aliases: []expense.MerchantAliasEntity{{Pattern: "sbux*"}}},
+		{Model: gorm.Model{ID: 2}, Name: "Starbucks Reserve"},
+		{Model: gorm.Model{ID: 3}, Name: "7-Eleven", Aliases: []expense.MerchantAliasEntity{{Pattern: "7 eleven"}, {Pattern: "seven*"}}},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		var userID uint = 11
+
+		db := testutil.SetupDB()
+
+		mockMerchantRepo := new(mocks.MockMerchantRepository)
+		mockMerchantRepo.On("GetByUser", userID).Return(merchants, nil).Once()
+
+		service := expense.NewMerchantService(db, mockMerchantRepo, new(mocks.MockCategoryService), new(mocks.MockTagService))
+		merchant, err := service.MatchMerchant(userID, "STARBUCKS #1234 BKK")
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(1), merchant.ID)
+		mockMerchantRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_most_specific", func(t *testing.T) {
+		assert.Equal(t, uint(2), expense.MatchMerchant(merchants, "Starbucks Reserve 00123 Siam").ID)
+		assert.Equal(t, uint(1), expense.MatchMerchant(merchants, "SBUXCARD 998877").ID)
+		assert.Equal(t, uint(3), expense.MatchMerchant(merchants, "7-ELEVEN 04412").ID)
+		assert.Equal(t, uint(3), expense.MatchMerchant(merchants, "SevenEleven Asok").ID)
+		assert.Nil(t, expense.MatchMerchant(merchants, "superstarbucks"))
+	})
+
+	t.Run("success_no_words", func(t *testing.T) {
+		var userID uint = 11
+
+		db := testutil.SetupDB()
+
+		mockMerchantRepo := new(mocks.MockMerchantRepository)
+
+		service := expense.NewMerchantService(db, mockMerchantRepo, new(mocks.MockCategoryService), new(mocks.MockTagService))
+		merchant, err := service.MatchMerchant(userID, "#0042 / 12345")
+
+		assert.NoError(t, err)
+		assert.Nil(t, merchant)
+		mockMerchantRepo.AssertNotCalled(t, "GetByUser", userID)
+	})
+}
+
+func TestNormalizeDescription(t *testing.T) {
+	assert.Equal(t, "starbucks bkk", expense.NormalizeDescription("STARBUCKS #1234 BKK"))
+	assert.Equal(t, "7 eleven", expense.NormalizeDescription("7-Eleven 04412"))
+	assert.Equal(t, "", expense.NormalizeDescription(" *** #4412 "))
+}
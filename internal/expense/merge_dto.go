@@ -0,0 +1,11 @@
+package expense
+
+type MergeRequest struct {
+	Preview bool `query:"preview"`
+}
+
+// MergeResult tells how many expenses a merge moved, or would move when previewed.
+type MergeResult struct {
+	Expenses int64 `json:"expenses"`
+	Preview  bool  `json:"preview"`
+}
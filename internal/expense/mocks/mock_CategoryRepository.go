@@ -5,8 +5,11 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/Perajit/expense-tracker-go/internal/expense"
 	mock "github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 // NewMockCategoryRepository creates a new instance of MockCategoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -36,6 +39,66 @@ func (_m *MockCategoryRepository) EXPECT() *MockCategoryRepository_Expecter {
 	return &MockCategoryRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountExpenses provides a mock function for the type MockCategoryRepository
+func (_mock *MockCategoryRepository) CountExpenses(id uint) (int64, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountExpenses")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint) (int64, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepository_CountExpenses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountExpenses'
+type MockCategoryRepository_CountExpenses_Call struct {
+	*mock.Call
+}
+
+// CountExpenses is a helper method to define mock.On call
+//   - id uint
+func (_e *MockCategoryRepository_Expecter) CountExpenses(id interface{}) *MockCategoryRepository_CountExpenses_Call {
+	return &MockCategoryRepository_CountExpenses_Call{Call: _e.mock.On("CountExpenses", id)}
+}
+
+func (_c *MockCategoryRepository_CountExpenses_Call) Run(run func(id uint)) *MockCategoryRepository_CountExpenses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_CountExpenses_Call) Return(n int64, err error) *MockCategoryRepository_CountExpenses_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockCategoryRepository_CountExpenses_Call) RunAndReturn(run func(id uint) (int64, error)) *MockCategoryRepository_CountExpenses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockCategoryRepository
 func (_mock *MockCategoryRepository) Create(category *expense.CategoryEntity) error {
 	ret := _mock.Called(category)
@@ -138,9 +201,60 @@ func (_c *MockCategoryRepository_Delete_Call) RunAndReturn(run func(id uint) err
 	return _c
 }
 
+// DeleteUsages provides a mock function for the type MockCategoryRepository
+func (_mock *MockCategoryRepository) DeleteUsages(id uint) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUsages")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryRepository_DeleteUsages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUsages'
+type MockCategoryRepository_DeleteUsages_Call struct {
+	*mock.Call
+}
+
+// DeleteUsages is a helper method to define mock.On call
+//   - id uint
+func (_e *MockCategoryRepository_Expecter) DeleteUsages(id interface{}) *MockCategoryRepository_DeleteUsages_Call {
+	return &MockCategoryRepository_DeleteUsages_Call{Call: _e.mock.On("DeleteUsages", id)}
+}
+
+func (_c *MockCategoryRepository_DeleteUsages_Call) Run(run func(id uint)) *MockCategoryRepository_DeleteUsages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_DeleteUsages_Call) Return(err error) *MockCategoryRepository_DeleteUsages_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryRepository_DeleteUsages_Call) RunAndReturn(run func(id uint) error) *MockCategoryRepository_DeleteUsages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExistsByName provides a mock function for the type MockCategoryRepository
-func (_mock *MockCategoryRepository) ExistsByName(userID uint, name string) (bool, error) {
-	ret := _mock.Called(userID, name)
+func (_mock *MockCategoryRepository) ExistsByName(userID uint, parentID uint, name string) (bool, error) {
+	ret := _mock.Called(userID, parentID, name)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ExistsByName")
@@ -148,16 +262,16 @@ func (_mock *MockCategoryRepository) ExistsByName(userID uint, name string) (boo
 
 	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(uint, string) (bool, error)); ok {
-		return returnFunc(userID, name)
+	if returnFunc, ok := ret.Get(0).(func(uint, uint, string) (bool, error)); ok {
+		return returnFunc(userID, parentID, name)
 	}
-	if returnFunc, ok := ret.Get(0).(func(uint, string) bool); ok {
-		r0 = returnFunc(userID, name)
+	if returnFunc, ok := ret.Get(0).(func(uint, uint, string) bool); ok {
+		r0 = returnFunc(userID, parentID, name)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(uint, string) error); ok {
-		r1 = returnFunc(userID, name)
+	if returnFunc, ok := ret.Get(1).(func(uint, uint, string) error); ok {
+		r1 = returnFunc(userID, parentID, name)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -171,24 +285,30 @@ type MockCategoryRepository_ExistsByName_Call struct {
 
 // ExistsByName is a helper method to define mock.On call
 //   - userID uint
+//   - parentID uint
 //   - name string
-func (_e *MockCategoryRepository_Expecter) ExistsByName(userID interface{}, name interface{}) *MockCategoryRepository_ExistsByName_Call {
-	return &MockCategoryRepository_ExistsByName_Call{Call: _e.mock.On("ExistsByName", userID, name)}
+func (_e *MockCategoryRepository_Expecter) ExistsByName(userID interface{}, parentID interface{}, name interface{}) *MockCategoryRepository_ExistsByName_Call {
+	return &MockCategoryRepository_ExistsByName_Call{Call: _e.mock.On("ExistsByName", userID, parentID, name)}
 }
 
-func (_c *MockCategoryRepository_ExistsByName_Call) Run(run func(userID uint, name string)) *MockCategoryRepository_ExistsByName_Call {
+func (_c *MockCategoryRepository_ExistsByName_Call) Run(run func(userID uint, parentID uint, name string)) *MockCategoryRepository_ExistsByName_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 uint
 		if args[0] != nil {
 			arg0 = args[0].(uint)
 		}
-		var arg1 string
+		var arg1 uint
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(uint)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -199,7 +319,7 @@ func (_c *MockCategoryRepository_ExistsByName_Call) Return(b bool, err error) *M
 	return _c
 }
 
-func (_c *MockCategoryRepository_ExistsByName_Call) RunAndReturn(run func(userID uint, name string) (bool, error)) *MockCategoryRepository_ExistsByName_Call {
+func (_c *MockCategoryRepository_ExistsByName_Call) RunAndReturn(run func(userID uint, parentID uint, name string) (bool, error)) *MockCategoryRepository_ExistsByName_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -272,6 +392,74 @@ func (_c *MockCategoryRepository_GetByIDAndUser_Call) RunAndReturn(run func(id u
 	return _c
 }
 
+// GetByNames provides a mock function for the type MockCategoryRepository
+func (_mock *MockCategoryRepository) GetByNames(userID uint, names []string) ([]expense.CategoryEntity, error) {
+	ret := _mock.Called(userID, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByNames")
+	}
+
+	var r0 []expense.CategoryEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint, []string) ([]expense.CategoryEntity, error)); ok {
+		return returnFunc(userID, names)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, []string) []expense.CategoryEntity); ok {
+		r0 = returnFunc(userID, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.CategoryEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, []string) error); ok {
+		r1 = returnFunc(userID, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepository_GetByNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByNames'
+type MockCategoryRepository_GetByNames_Call struct {
+	*mock.Call
+}
+
+// GetByNames is a helper method to define mock.On call
+//   - userID uint
+//   - names []string
+func (_e *MockCategoryRepository_Expecter) GetByNames(userID interface{}, names interface{}) *MockCategoryRepository_GetByNames_Call {
+	return &MockCategoryRepository_GetByNames_Call{Call: _e.mock.On("GetByNames", userID, names)}
+}
+
+func (_c *MockCategoryRepository_GetByNames_Call) Run(run func(userID uint, names []string)) *MockCategoryRepository_GetByNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_GetByNames_Call) Return(categoryEntitys []expense.CategoryEntity, err error) *MockCategoryRepository_GetByNames_Call {
+	_c.Call.Return(categoryEntitys, err)
+	return _c
+}
+
+func (_c *MockCategoryRepository_GetByNames_Call) RunAndReturn(run func(userID uint, names []string) ([]expense.CategoryEntity, error)) *MockCategoryRepository_GetByNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByUser provides a mock function for the type MockCategoryRepository
 func (_mock *MockCategoryRepository) GetByUser(userID uint) ([]expense.CategoryEntity, error) {
 	ret := _mock.Called(userID)
@@ -334,23 +522,25 @@ func (_c *MockCategoryRepository_GetByUser_Call) RunAndReturn(run func(userID ui
 	return _c
 }
 
-// IsOwner provides a mock function for the type MockCategoryRepository
-func (_mock *MockCategoryRepository) IsOwner(id uint, userID uint) (bool, error) {
+// GetDeletedByIDAndUser provides a mock function for the type MockCategoryRepository
+func (_mock *MockCategoryRepository) GetDeletedByIDAndUser(id uint, userID uint) (*expense.CategoryEntity, error) {
 	ret := _mock.Called(id, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsOwner")
+		panic("no return value specified for GetDeletedByIDAndUser")
 	}
 
-	var r0 bool
+	var r0 *expense.CategoryEntity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(uint, uint) (bool, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) (*expense.CategoryEntity, error)); ok {
 		return returnFunc(id, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(uint, uint) bool); ok {
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) *expense.CategoryEntity); ok {
 		r0 = returnFunc(id, userID)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*expense.CategoryEntity)
+		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(uint, uint) error); ok {
 		r1 = returnFunc(id, userID)
@@ -360,19 +550,19 @@ func (_mock *MockCategoryRepository) IsOwner(id uint, userID uint) (bool, error)
 	return r0, r1
 }
 
-// MockCategoryRepository_IsOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsOwner'
-type MockCategoryRepository_IsOwner_Call struct {
+// MockCategoryRepository_GetDeletedByIDAndUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedByIDAndUser'
+type MockCategoryRepository_GetDeletedByIDAndUser_Call struct {
 	*mock.Call
 }
 
-// IsOwner is a helper method to define mock.On call
+// GetDeletedByIDAndUser is a helper method to define mock.On call
 //   - id uint
 //   - userID uint
-func (_e *MockCategoryRepository_Expecter) IsOwner(id interface{}, userID interface{}) *MockCategoryRepository_IsOwner_Call {
-	return &MockCategoryRepository_IsOwner_Call{Call: _e.mock.On("IsOwner", id, userID)}
+func (_e *MockCategoryRepository_Expecter) GetDeletedByIDAndUser(id interface{}, userID interface{}) *MockCategoryRepository_GetDeletedByIDAndUser_Call {
+	return &MockCategoryRepository_GetDeletedByIDAndUser_Call{Call: _e.mock.On("GetDeletedByIDAndUser", id, userID)}
 }
 
-func (_c *MockCategoryRepository_IsOwner_Call) Run(run func(id uint, userID uint)) *MockCategoryRepository_IsOwner_Call {
+func (_c *MockCategoryRepository_GetDeletedByIDAndUser_Call) Run(run func(id uint, userID uint)) *MockCategoryRepository_GetDeletedByIDAndUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 uint
 		if args[0] != nil {
@@ -390,49 +580,60 @@ func (_c *MockCategoryRepository_IsOwner_Call) Run(run func(id uint, userID uint
 	return _c
 }
 
-func (_c *MockCategoryRepository_IsOwner_Call) Return(b bool, err error) *MockCategoryRepository_IsOwner_Call {
-	_c.Call.Return(b, err)
+func (_c *MockCategoryRepository_GetDeletedByIDAndUser_Call) Return(categoryEntity *expense.CategoryEntity, err error) *MockCategoryRepository_GetDeletedByIDAndUser_Call {
+	_c.Call.Return(categoryEntity, err)
 	return _c
 }
 
-func (_c *MockCategoryRepository_IsOwner_Call) RunAndReturn(run func(id uint, userID uint) (bool, error)) *MockCategoryRepository_IsOwner_Call {
+func (_c *MockCategoryRepository_GetDeletedByIDAndUser_Call) RunAndReturn(run func(id uint, userID uint) (*expense.CategoryEntity, error)) *MockCategoryRepository_GetDeletedByIDAndUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockCategoryRepository
-func (_mock *MockCategoryRepository) Update(category *expense.CategoryEntity) error {
-	ret := _mock.Called(category)
+// GetDeletedByUser provides a mock function for the type MockCategoryRepository
+func (_mock *MockCategoryRepository) GetDeletedByUser(userID uint) ([]expense.CategoryEntity, error) {
+	ret := _mock.Called(userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for GetDeletedByUser")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*expense.CategoryEntity) error); ok {
-		r0 = returnFunc(category)
+	var r0 []expense.CategoryEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint) ([]expense.CategoryEntity, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint) []expense.CategoryEntity); ok {
+		r0 = returnFunc(userID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.CategoryEntity)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockCategoryRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockCategoryRepository_Update_Call struct {
+// MockCategoryRepository_GetDeletedByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedByUser'
+type MockCategoryRepository_GetDeletedByUser_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
-//   - category *expense.CategoryEntity
-func (_e *MockCategoryRepository_Expecter) Update(category interface{}) *MockCategoryRepository_Update_Call {
-	return &MockCategoryRepository_Update_Call{Call: _e.mock.On("Update", category)}
+// GetDeletedByUser is a helper method to define mock.On call
+//   - userID uint
+func (_e *MockCategoryRepository_Expecter) GetDeletedByUser(userID interface{}) *MockCategoryRepository_GetDeletedByUser_Call {
+	return &MockCategoryRepository_GetDeletedByUser_Call{Call: _e.mock.On("GetDeletedByUser", userID)}
 }
 
-func (_c *MockCategoryRepository_Update_Call) Run(run func(category *expense.CategoryEntity)) *MockCategoryRepository_Update_Call {
+func (_c *MockCategoryRepository_GetDeletedByUser_Call) Run(run func(userID uint)) *MockCategoryRepository_GetDeletedByUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *expense.CategoryEntity
+		var arg0 uint
 		if args[0] != nil {
-			arg0 = args[0].(*expense.CategoryEntity)
+			arg0 = args[0].(uint)
 		}
 		run(
 			arg0,
@@ -441,12 +642,599 @@ func (_c *MockCategoryRepository_Update_Call) Run(run func(category *expense.Cat
 	return _c
 }
 
-func (_c *MockCategoryRepository_Update_Call) Return(err error) *MockCategoryRepository_Update_Call {
-	_c.Call.Return(err)
+func (_c *MockCategoryRepository_GetDeletedByUser_Call) Return(categoryEntitys []expense.CategoryEntity, err error) *MockCategoryRepository_GetDeletedByUser_Call {
+	_c.Call.Return(categoryEntitys, err)
 	return _c
 }
 
-func (_c *MockCategoryRepository_Update_Call) RunAndReturn(run func(category *expense.CategoryEntity) error) *MockCategoryRepository_Update_Call {
+func (_c *MockCategoryRepository_GetDeletedByUser_Call) RunAndReturn(run func(userID uint) ([]expense.CategoryEntity, error)) *MockCategoryRepository_GetDeletedByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDescendantIDs provides a mock function for the type MockCategoryRepository
+func (_mock *MockCategoryRepository) GetDescendantIDs(id uint) ([]uint, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDescendantIDs")
+	}
+
+	var r0 []uint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint) ([]uint, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint) []uint); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepository_GetDescendantIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDescendantIDs'
+type MockCategoryRepository_GetDescendantIDs_Call struct {
+	*mock.Call
+}
+
+// GetDescendantIDs is a helper method to define mock.On call
+//   - id uint
+func (_e *MockCategoryRepository_Expecter) GetDescendantIDs(id interface{}) *MockCategoryRepository_GetDescendantIDs_Call {
+	return &MockCategoryRepository_GetDescendantIDs_Call{Call: _e.mock.On("GetDescendantIDs", id)}
+}
+
+func (_c *MockCategoryRepository_GetDescendantIDs_Call) Run(run func(id uint)) *MockCategoryRepository_GetDescendantIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_GetDescendantIDs_Call) Return(vs []uint, err error) *MockCategoryRepository_GetDescendantIDs_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockCategoryRepository_GetDescendantIDs_Call) RunAndReturn(run func(id uint) ([]uint, error)) *MockCategoryRepository_GetDescendantIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExpenseIDs provides a mock function for the type MockCategoryRepository
+func (_mock *MockCategoryRepository) GetExpenseIDs(id uint) ([]uint, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpenseIDs")
+	}
+
+	var r0 []uint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint) ([]uint, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint) []uint); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepository_GetExpenseIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpenseIDs'
+type MockCategoryRepository_GetExpenseIDs_Call struct {
+	*mock.Call
+}
+
+// GetExpenseIDs is a helper method to define mock.On call
+//   - id uint
+func (_e *MockCategoryRepository_Expecter) GetExpenseIDs(id interface{}) *MockCategoryRepository_GetExpenseIDs_Call {
+	return &MockCategoryRepository_GetExpenseIDs_Call{Call: _e.mock.On("GetExpenseIDs", id)}
+}
+
+func (_c *MockCategoryRepository_GetExpenseIDs_Call) Run(run func(id uint)) *MockCategoryRepository_GetExpenseIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_GetExpenseIDs_Call) Return(vs []uint, err error) *MockCategoryRepository_GetExpenseIDs_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockCategoryRepository_GetExpenseIDs_Call) RunAndReturn(run func(id uint) ([]uint, error)) *MockCategoryRepository_GetExpenseIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsage provides a mock function for the type MockCategoryRepository
+func (_mock *MockCategoryRepository) GetUsage(id uint, userID uint) (*expense.CategoryUsage, error) {
+	ret := _mock.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsage")
+	}
+
+	var r0 *expense.CategoryUsage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) (*expense.CategoryUsage, error)); ok {
+		return returnFunc(id, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) *expense.CategoryUsage); ok {
+		r0 = returnFunc(id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*expense.CategoryUsage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = returnFunc(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepository_GetUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsage'
+type MockCategoryRepository_GetUsage_Call struct {
+	*mock.Call
+}
+
+// GetUsage is a helper method to define mock.On call
+//   - id uint
+//   - userID uint
+func (_e *MockCategoryRepository_Expecter) GetUsage(id interface{}, userID interface{}) *MockCategoryRepository_GetUsage_Call {
+	return &MockCategoryRepository_GetUsage_Call{Call: _e.mock.On("GetUsage", id, userID)}
+}
+
+func (_c *MockCategoryRepository_GetUsage_Call) Run(run func(id uint, userID uint)) *MockCategoryRepository_GetUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_GetUsage_Call) Return(categoryUsage *expense.CategoryUsage, err error) *MockCategoryRepository_GetUsage_Call {
+	_c.Call.Return(categoryUsage, err)
+	return _c
+}
+
+func (_c *MockCategoryRepository_GetUsage_Call) RunAndReturn(run func(id uint, userID uint) (*expense.CategoryUsage, error)) *MockCategoryRepository_GetUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsOwner provides a mock function for the type MockCategoryRepository
+func (_mock *MockCategoryRepository) IsOwner(id uint, userID uint) (bool, error) {
+	ret := _mock.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsOwner")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) (bool, error)); ok {
+		return returnFunc(id, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) bool); ok {
+		r0 = returnFunc(id, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = returnFunc(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepository_IsOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsOwner'
+type MockCategoryRepository_IsOwner_Call struct {
+	*mock.Call
+}
+
+// IsOwner is a helper method to define mock.On call
+//   - id uint
+//   - userID uint
+func (_e *MockCategoryRepository_Expecter) IsOwner(id interface{}, userID interface{}) *MockCategoryRepository_IsOwner_Call {
+	return &MockCategoryRepository_IsOwner_Call{Call: _e.mock.On("IsOwner", id, userID)}
+}
+
+func (_c *MockCategoryRepository_IsOwner_Call) Run(run func(id uint, userID uint)) *MockCategoryRepository_IsOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_IsOwner_Call) Return(b bool, err error) *MockCategoryRepository_IsOwner_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockCategoryRepository_IsOwner_Call) RunAndReturn(run func(id uint, userID uint) (bool, error)) *MockCategoryRepository_IsOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function for the type MockCategoryRepository
+func (_mock *MockCategoryRepository) Purge(before time.Time) (int64, error) {
+	ret := _mock.Called(before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(time.Time) (int64, error)); ok {
+		return returnFunc(before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(time.Time) int64); ok {
+		r0 = returnFunc(before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = returnFunc(before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockCategoryRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - before time.Time
+func (_e *MockCategoryRepository_Expecter) Purge(before interface{}) *MockCategoryRepository_Purge_Call {
+	return &MockCategoryRepository_Purge_Call{Call: _e.mock.On("Purge", before)}
+}
+
+func (_c *MockCategoryRepository_Purge_Call) Run(run func(before time.Time)) *MockCategoryRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 time.Time
+		if args[0] != nil {
+			arg0 = args[0].(time.Time)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_Purge_Call) Return(n int64, err error) *MockCategoryRepository_Purge_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockCategoryRepository_Purge_Call) RunAndReturn(run func(before time.Time) (int64, error)) *MockCategoryRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reassign provides a mock function for the type MockCategoryRepository
+func (_mock *MockCategoryRepository) Reassign(fromID uint, toID uint) error {
+	ret := _mock.Called(fromID, toID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reassign")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = returnFunc(fromID, toID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryRepository_Reassign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reassign'
+type MockCategoryRepository_Reassign_Call struct {
+	*mock.Call
+}
+
+// Reassign is a helper method to define mock.On call
+//   - fromID uint
+//   - toID uint
+func (_e *MockCategoryRepository_Expecter) Reassign(fromID interface{}, toID interface{}) *MockCategoryRepository_Reassign_Call {
+	return &MockCategoryRepository_Reassign_Call{Call: _e.mock.On("Reassign", fromID, toID)}
+}
+
+func (_c *MockCategoryRepository_Reassign_Call) Run(run func(fromID uint, toID uint)) *MockCategoryRepository_Reassign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_Reassign_Call) Return(err error) *MockCategoryRepository_Reassign_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryRepository_Reassign_Call) RunAndReturn(run func(fromID uint, toID uint) error) *MockCategoryRepository_Reassign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reparent provides a mock function for the type MockCategoryRepository
+func (_mock *MockCategoryRepository) Reparent(fromParentID uint, toParentID uint) error {
+	ret := _mock.Called(fromParentID, toParentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reparent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = returnFunc(fromParentID, toParentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryRepository_Reparent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reparent'
+type MockCategoryRepository_Reparent_Call struct {
+	*mock.Call
+}
+
+// Reparent is a helper method to define mock.On call
+//   - fromParentID uint
+//   - toParentID uint
+func (_e *MockCategoryRepository_Expecter) Reparent(fromParentID interface{}, toParentID interface{}) *MockCategoryRepository_Reparent_Call {
+	return &MockCategoryRepository_Reparent_Call{Call: _e.mock.On("Reparent", fromParentID, toParentID)}
+}
+
+func (_c *MockCategoryRepository_Reparent_Call) Run(run func(fromParentID uint, toParentID uint)) *MockCategoryRepository_Reparent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_Reparent_Call) Return(err error) *MockCategoryRepository_Reparent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryRepository_Reparent_Call) RunAndReturn(run func(fromParentID uint, toParentID uint) error) *MockCategoryRepository_Reparent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockCategoryRepository
+func (_mock *MockCategoryRepository) Restore(id uint) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockCategoryRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - id uint
+func (_e *MockCategoryRepository_Expecter) Restore(id interface{}) *MockCategoryRepository_Restore_Call {
+	return &MockCategoryRepository_Restore_Call{Call: _e.mock.On("Restore", id)}
+}
+
+func (_c *MockCategoryRepository_Restore_Call) Run(run func(id uint)) *MockCategoryRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_Restore_Call) Return(err error) *MockCategoryRepository_Restore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryRepository_Restore_Call) RunAndReturn(run func(id uint) error) *MockCategoryRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockCategoryRepository
+func (_mock *MockCategoryRepository) Update(category *expense.CategoryEntity) error {
+	ret := _mock.Called(category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*expense.CategoryEntity) error); ok {
+		r0 = returnFunc(category)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCategoryRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - category *expense.CategoryEntity
+func (_e *MockCategoryRepository_Expecter) Update(category interface{}) *MockCategoryRepository_Update_Call {
+	return &MockCategoryRepository_Update_Call{Call: _e.mock.On("Update", category)}
+}
+
+func (_c *MockCategoryRepository_Update_Call) Run(run func(category *expense.CategoryEntity)) *MockCategoryRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *expense.CategoryEntity
+		if args[0] != nil {
+			arg0 = args[0].(*expense.CategoryEntity)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_Update_Call) Return(err error) *MockCategoryRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryRepository_Update_Call) RunAndReturn(run func(category *expense.CategoryEntity) error) *MockCategoryRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithTx provides a mock function for the type MockCategoryRepository
+func (_mock *MockCategoryRepository) WithTx(tx *gorm.DB) expense.CategoryRepository {
+	ret := _mock.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 expense.CategoryRepository
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB) expense.CategoryRepository); ok {
+		r0 = returnFunc(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(expense.CategoryRepository)
+		}
+	}
+	return r0
+}
+
+// MockCategoryRepository_WithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTx'
+type MockCategoryRepository_WithTx_Call struct {
+	*mock.Call
+}
+
+// WithTx is a helper method to define mock.On call
+//   - tx *gorm.DB
+func (_e *MockCategoryRepository_Expecter) WithTx(tx interface{}) *MockCategoryRepository_WithTx_Call {
+	return &MockCategoryRepository_WithTx_Call{Call: _e.mock.On("WithTx", tx)}
+}
+
+func (_c *MockCategoryRepository_WithTx_Call) Run(run func(tx *gorm.DB)) *MockCategoryRepository_WithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_WithTx_Call) Return(categoryRepository expense.CategoryRepository) *MockCategoryRepository_WithTx_Call {
+	_c.Call.Return(categoryRepository)
+	return _c
+}
+
+func (_c *MockCategoryRepository_WithTx_Call) RunAndReturn(run func(tx *gorm.DB) expense.CategoryRepository) *MockCategoryRepository_WithTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -105,16 +105,16 @@ func (_c *MockCategoryService_CreateCategory_Call) RunAndReturn(run func(authUse
 }
 
 // DeleteCategory provides a mock function for the type MockCategoryService
-func (_mock *MockCategoryService) DeleteCategory(id uint, userId uint) error {
-	ret := _mock.Called(id, userId)
+func (_mock *MockCategoryService) DeleteCategory(id uint, userId uint, dto expense.DeleteCategoryRequest) error {
+	ret := _mock.Called(id, userId, dto)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteCategory")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(uint, uint) error); ok {
-		r0 = returnFunc(id, userId)
+	if returnFunc, ok := ret.Get(0).(func(uint, uint, expense.DeleteCategoryRequest) error); ok {
+		r0 = returnFunc(id, userId, dto)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -129,11 +129,12 @@ type MockCategoryService_DeleteCategory_Call struct {
 // DeleteCategory is a helper method to define mock.On call
 //   - id uint
 //   - userId uint
-func (_e *MockCategoryService_Expecter) DeleteCategory(id interface{}, userId interface{}) *MockCategoryService_DeleteCategory_Call {
-	return &MockCategoryService_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", id, userId)}
+//   - dto expense.DeleteCategoryRequest
+func (_e *MockCategoryService_Expecter) DeleteCategory(id interface{}, userId interface{}, dto interface{}) *MockCategoryService_DeleteCategory_Call {
+	return &MockCategoryService_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", id, userId, dto)}
 }
 
-func (_c *MockCategoryService_DeleteCategory_Call) Run(run func(id uint, userId uint)) *MockCategoryService_DeleteCategory_Call {
+func (_c *MockCategoryService_DeleteCategory_Call) Run(run func(id uint, userId uint, dto expense.DeleteCategoryRequest)) *MockCategoryService_DeleteCategory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 uint
 		if args[0] != nil {
@@ -143,9 +144,14 @@ func (_c *MockCategoryService_DeleteCategory_Call) Run(run func(id uint, userId
 		if args[1] != nil {
 			arg1 = args[1].(uint)
 		}
+		var arg2 expense.DeleteCategoryRequest
+		if args[2] != nil {
+			arg2 = args[2].(expense.DeleteCategoryRequest)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -156,7 +162,7 @@ func (_c *MockCategoryService_DeleteCategory_Call) Return(err error) *MockCatego
 	return _c
 }
 
-func (_c *MockCategoryService_DeleteCategory_Call) RunAndReturn(run func(id uint, userId uint) error) *MockCategoryService_DeleteCategory_Call {
+func (_c *MockCategoryService_DeleteCategory_Call) RunAndReturn(run func(id uint, userId uint, dto expense.DeleteCategoryRequest) error) *MockCategoryService_DeleteCategory_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -223,6 +229,74 @@ func (_c *MockCategoryService_GetCategories_Call) RunAndReturn(run func(authUser
 	return _c
 }
 
+// GetCategoriesByNames provides a mock function for the type MockCategoryService
+func (_mock *MockCategoryService) GetCategoriesByNames(names []string, authUserID uint) ([]expense.CategoryEntity, error) {
+	ret := _mock.Called(names, authUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoriesByNames")
+	}
+
+	var r0 []expense.CategoryEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]string, uint) ([]expense.CategoryEntity, error)); ok {
+		return returnFunc(names, authUserID)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]string, uint) []expense.CategoryEntity); ok {
+		r0 = returnFunc(names, authUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.CategoryEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]string, uint) error); ok {
+		r1 = returnFunc(names, authUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryService_GetCategoriesByNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoriesByNames'
+type MockCategoryService_GetCategoriesByNames_Call struct {
+	*mock.Call
+}
+
+// GetCategoriesByNames is a helper method to define mock.On call
+//   - names []string
+//   - authUserID uint
+func (_e *MockCategoryService_Expecter) GetCategoriesByNames(names interface{}, authUserID interface{}) *MockCategoryService_GetCategoriesByNames_Call {
+	return &MockCategoryService_GetCategoriesByNames_Call{Call: _e.mock.On("GetCategoriesByNames", names, authUserID)}
+}
+
+func (_c *MockCategoryService_GetCategoriesByNames_Call) Run(run func(names []string, authUserID uint)) *MockCategoryService_GetCategoriesByNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_GetCategoriesByNames_Call) Return(categoryEntitys []expense.CategoryEntity, err error) *MockCategoryService_GetCategoriesByNames_Call {
+	_c.Call.Return(categoryEntitys, err)
+	return _c
+}
+
+func (_c *MockCategoryService_GetCategoriesByNames_Call) RunAndReturn(run func(names []string, authUserID uint) ([]expense.CategoryEntity, error)) *MockCategoryService_GetCategoriesByNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCategoryByID provides a mock function for the type MockCategoryService
 func (_mock *MockCategoryService) GetCategoryByID(id uint, authUserID *uint) (*expense.CategoryEntity, error) {
 	ret := _mock.Called(id, authUserID)
@@ -291,6 +365,136 @@ func (_c *MockCategoryService_GetCategoryByID_Call) RunAndReturn(run func(id uin
 	return _c
 }
 
+// GetCategoryTree provides a mock function for the type MockCategoryService
+func (_mock *MockCategoryService) GetCategoryTree(authUserID uint) ([]expense.CategoryTreeResponse, error) {
+	ret := _mock.Called(authUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryTree")
+	}
+
+	var r0 []expense.CategoryTreeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint) ([]expense.CategoryTreeResponse, error)); ok {
+		return returnFunc(authUserID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint) []expense.CategoryTreeResponse); ok {
+		r0 = returnFunc(authUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.CategoryTreeResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = returnFunc(authUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryService_GetCategoryTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryTree'
+type MockCategoryService_GetCategoryTree_Call struct {
+	*mock.Call
+}
+
+// GetCategoryTree is a helper method to define mock.On call
+//   - authUserID uint
+func (_e *MockCategoryService_Expecter) GetCategoryTree(authUserID interface{}) *MockCategoryService_GetCategoryTree_Call {
+	return &MockCategoryService_GetCategoryTree_Call{Call: _e.mock.On("GetCategoryTree", authUserID)}
+}
+
+func (_c *MockCategoryService_GetCategoryTree_Call) Run(run func(authUserID uint)) *MockCategoryService_GetCategoryTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_GetCategoryTree_Call) Return(categoryTreeResponses []expense.CategoryTreeResponse, err error) *MockCategoryService_GetCategoryTree_Call {
+	_c.Call.Return(categoryTreeResponses, err)
+	return _c
+}
+
+func (_c *MockCategoryService_GetCategoryTree_Call) RunAndReturn(run func(authUserID uint) ([]expense.CategoryTreeResponse, error)) *MockCategoryService_GetCategoryTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryUsage provides a mock function for the type MockCategoryService
+func (_mock *MockCategoryService) GetCategoryUsage(id uint, authUserID uint) (*expense.CategoryUsage, error) {
+	ret := _mock.Called(id, authUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryUsage")
+	}
+
+	var r0 *expense.CategoryUsage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) (*expense.CategoryUsage, error)); ok {
+		return returnFunc(id, authUserID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) *expense.CategoryUsage); ok {
+		r0 = returnFunc(id, authUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*expense.CategoryUsage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = returnFunc(id, authUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryService_GetCategoryUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryUsage'
+type MockCategoryService_GetCategoryUsage_Call struct {
+	*mock.Call
+}
+
+// GetCategoryUsage is a helper method to define mock.On call
+//   - id uint
+//   - authUserID uint
+func (_e *MockCategoryService_Expecter) GetCategoryUsage(id interface{}, authUserID interface{}) *MockCategoryService_GetCategoryUsage_Call {
+	return &MockCategoryService_GetCategoryUsage_Call{Call: _e.mock.On("GetCategoryUsage", id, authUserID)}
+}
+
+func (_c *MockCategoryService_GetCategoryUsage_Call) Run(run func(id uint, authUserID uint)) *MockCategoryService_GetCategoryUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_GetCategoryUsage_Call) Return(categoryUsage *expense.CategoryUsage, err error) *MockCategoryService_GetCategoryUsage_Call {
+	_c.Call.Return(categoryUsage, err)
+	return _c
+}
+
+func (_c *MockCategoryService_GetCategoryUsage_Call) RunAndReturn(run func(id uint, authUserID uint) (*expense.CategoryUsage, error)) *MockCategoryService_GetCategoryUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsCategoryOwner provides a mock function for the type MockCategoryService
 func (_mock *MockCategoryService) IsCategoryOwner(id uint, authUserID uint) (bool, error) {
 	ret := _mock.Called(id, authUserID)
@@ -357,6 +561,149 @@ func (_c *MockCategoryService_IsCategoryOwner_Call) RunAndReturn(run func(id uin
 	return _c
 }
 
+// MergeCategory provides a mock function for the type MockCategoryService
+func (_mock *MockCategoryService) MergeCategory(id uint, targetID uint, authUserID uint, dto expense.MergeRequest) (*expense.MergeResult, error) {
+	ret := _mock.Called(id, targetID, authUserID, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeCategory")
+	}
+
+	var r0 *expense.MergeResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint, uint, uint, expense.MergeRequest) (*expense.MergeResult, error)); ok {
+		return returnFunc(id, targetID, authUserID, dto)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, uint, uint, expense.MergeRequest) *expense.MergeResult); ok {
+		r0 = returnFunc(id, targetID, authUserID, dto)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*expense.MergeResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, uint, uint, expense.MergeRequest) error); ok {
+		r1 = returnFunc(id, targetID, authUserID, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCategoryService_MergeCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeCategory'
+type MockCategoryService_MergeCategory_Call struct {
+	*mock.Call
+}
+
+// MergeCategory is a helper method to define mock.On call
+//   - id uint
+//   - targetID uint
+//   - authUserID uint
+//   - dto expense.MergeRequest
+func (_e *MockCategoryService_Expecter) MergeCategory(id interface{}, targetID interface{}, authUserID interface{}, dto interface{}) *MockCategoryService_MergeCategory_Call {
+	return &MockCategoryService_MergeCategory_Call{Call: _e.mock.On("MergeCategory", id, targetID, authUserID, dto)}
+}
+
+func (_c *MockCategoryService_MergeCategory_Call) Run(run func(id uint, targetID uint, authUserID uint, dto expense.MergeRequest)) *MockCategoryService_MergeCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 uint
+		if args[2] != nil {
+			arg2 = args[2].(uint)
+		}
+		var arg3 expense.MergeRequest
+		if args[3] != nil {
+			arg3 = args[3].(expense.MergeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_MergeCategory_Call) Return(mergeResult *expense.MergeResult, err error) *MockCategoryService_MergeCategory_Call {
+	_c.Call.Return(mergeResult, err)
+	return _c
+}
+
+func (_c *MockCategoryService_MergeCategory_Call) RunAndReturn(run func(id uint, targetID uint, authUserID uint, dto expense.MergeRequest) (*expense.MergeResult, error)) *MockCategoryService_MergeCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveCategory provides a mock function for the type MockCategoryService
+func (_mock *MockCategoryService) MoveCategory(id uint, authUserID uint, dto expense.MoveCategoryRequest) error {
+	ret := _mock.Called(id, authUserID, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveCategory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uint, uint, expense.MoveCategoryRequest) error); ok {
+		r0 = returnFunc(id, authUserID, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCategoryService_MoveCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveCategory'
+type MockCategoryService_MoveCategory_Call struct {
+	*mock.Call
+}
+
+// MoveCategory is a helper method to define mock.On call
+//   - id uint
+//   - authUserID uint
+//   - dto expense.MoveCategoryRequest
+func (_e *MockCategoryService_Expecter) MoveCategory(id interface{}, authUserID interface{}, dto interface{}) *MockCategoryService_MoveCategory_Call {
+	return &MockCategoryService_MoveCategory_Call{Call: _e.mock.On("MoveCategory", id, authUserID, dto)}
+}
+
+func (_c *MockCategoryService_MoveCategory_Call) Run(run func(id uint, authUserID uint, dto expense.MoveCategoryRequest)) *MockCategoryService_MoveCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 expense.MoveCategoryRequest
+		if args[2] != nil {
+			arg2 = args[2].(expense.MoveCategoryRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_MoveCategory_Call) Return(err error) *MockCategoryService_MoveCategory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCategoryService_MoveCategory_Call) RunAndReturn(run func(id uint, authUserID uint, dto expense.MoveCategoryRequest) error) *MockCategoryService_MoveCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateCategory provides a mock function for the type MockCategoryService
 func (_mock *MockCategoryService) UpdateCategory(id uint, authUserID uint, dto expense.UpdateCategoryRequest) error {
 	ret := _mock.Called(id, authUserID, dto)
@@ -5,7 +5,10 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/shopspring/decimal"
 	mock "github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
 )
@@ -37,6 +40,134 @@ func (_m *MockExpenseRepository) EXPECT() *MockExpenseRepository_Expecter {
 	return &MockExpenseRepository_Expecter{mock: &_m.Mock}
 }
 
+// ConvertAmounts provides a mock function for the type MockExpenseRepository
+func (_mock *MockExpenseRepository) ConvertAmounts(ids []uint, target string) (map[uint]decimal.Decimal, error) {
+	ret := _mock.Called(ids, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertAmounts")
+	}
+
+	var r0 map[uint]decimal.Decimal
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]uint, string) (map[uint]decimal.Decimal, error)); ok {
+		return returnFunc(ids, target)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]uint, string) map[uint]decimal.Decimal); ok {
+		r0 = returnFunc(ids, target)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint]decimal.Decimal)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]uint, string) error); ok {
+		r1 = returnFunc(ids, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseRepository_ConvertAmounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertAmounts'
+type MockExpenseRepository_ConvertAmounts_Call struct {
+	*mock.Call
+}
+
+// ConvertAmounts is a helper method to define mock.On call
+//   - ids []uint
+//   - target string
+func (_e *MockExpenseRepository_Expecter) ConvertAmounts(ids interface{}, target interface{}) *MockExpenseRepository_ConvertAmounts_Call {
+	return &MockExpenseRepository_ConvertAmounts_Call{Call: _e.mock.On("ConvertAmounts", ids, target)}
+}
+
+func (_c *MockExpenseRepository_ConvertAmounts_Call) Run(run func(ids []uint, target string)) *MockExpenseRepository_ConvertAmounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []uint
+		if args[0] != nil {
+			arg0 = args[0].([]uint)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseRepository_ConvertAmounts_Call) Return(m map[uint]decimal.Decimal, err error) *MockExpenseRepository_ConvertAmounts_Call {
+	_c.Call.Return(m, err)
+	return _c
+}
+
+func (_c *MockExpenseRepository_ConvertAmounts_Call) RunAndReturn(run func(ids []uint, target string) (map[uint]decimal.Decimal, error)) *MockExpenseRepository_ConvertAmounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Count provides a mock function for the type MockExpenseRepository
+func (_mock *MockExpenseRepository) Count(query expense.ExpenseQuery) (int64, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(expense.ExpenseQuery) (int64, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(expense.ExpenseQuery) int64); ok {
+		r0 = returnFunc(query)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(expense.ExpenseQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockExpenseRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - query expense.ExpenseQuery
+func (_e *MockExpenseRepository_Expecter) Count(query interface{}) *MockExpenseRepository_Count_Call {
+	return &MockExpenseRepository_Count_Call{Call: _e.mock.On("Count", query)}
+}
+
+func (_c *MockExpenseRepository_Count_Call) Run(run func(query expense.ExpenseQuery)) *MockExpenseRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 expense.ExpenseQuery
+		if args[0] != nil {
+			arg0 = args[0].(expense.ExpenseQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseRepository_Count_Call) Return(n int64, err error) *MockExpenseRepository_Count_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockExpenseRepository_Count_Call) RunAndReturn(run func(query expense.ExpenseQuery) (int64, error)) *MockExpenseRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockExpenseRepository
 func (_mock *MockExpenseRepository) Create(expense1 *expense.ExpenseEntity) error {
 	ret := _mock.Called(expense1)
@@ -139,6 +270,68 @@ func (_c *MockExpenseRepository_Delete_Call) RunAndReturn(run func(id uint) erro
 	return _c
 }
 
+// Find provides a mock function for the type MockExpenseRepository
+func (_mock *MockExpenseRepository) Find(query expense.ExpenseQuery) ([]expense.ExpenseEntity, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 []expense.ExpenseEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(expense.ExpenseQuery) ([]expense.ExpenseEntity, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(expense.ExpenseQuery) []expense.ExpenseEntity); ok {
+		r0 = returnFunc(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.ExpenseEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(expense.ExpenseQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockExpenseRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - query expense.ExpenseQuery
+func (_e *MockExpenseRepository_Expecter) Find(query interface{}) *MockExpenseRepository_Find_Call {
+	return &MockExpenseRepository_Find_Call{Call: _e.mock.On("Find", query)}
+}
+
+func (_c *MockExpenseRepository_Find_Call) Run(run func(query expense.ExpenseQuery)) *MockExpenseRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 expense.ExpenseQuery
+		if args[0] != nil {
+			arg0 = args[0].(expense.ExpenseQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseRepository_Find_Call) Return(expenseEntitys []expense.ExpenseEntity, err error) *MockExpenseRepository_Find_Call {
+	_c.Call.Return(expenseEntitys, err)
+	return _c
+}
+
+func (_c *MockExpenseRepository_Find_Call) RunAndReturn(run func(query expense.ExpenseQuery) ([]expense.ExpenseEntity, error)) *MockExpenseRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByIDAndUser provides a mock function for the type MockExpenseRepository
 func (_mock *MockExpenseRepository) GetByIDAndUser(id uint, userID uint) (*expense.ExpenseEntity, error) {
 	ret := _mock.Called(id, userID)
@@ -275,12 +468,142 @@ func (_c *MockExpenseRepository_GetByIDAndUserNoAssociation_Call) RunAndReturn(r
 	return _c
 }
 
-// GetByUser provides a mock function for the type MockExpenseRepository
-func (_mock *MockExpenseRepository) GetByUser(userID uint) ([]expense.ExpenseEntity, error) {
+// GetByIDs provides a mock function for the type MockExpenseRepository
+func (_mock *MockExpenseRepository) GetByIDs(ids []uint) ([]expense.ExpenseEntity, error) {
+	ret := _mock.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []expense.ExpenseEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]uint) ([]expense.ExpenseEntity, error)); ok {
+		return returnFunc(ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]uint) []expense.ExpenseEntity); ok {
+		r0 = returnFunc(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.ExpenseEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = returnFunc(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseRepository_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type MockExpenseRepository_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ids []uint
+func (_e *MockExpenseRepository_Expecter) GetByIDs(ids interface{}) *MockExpenseRepository_GetByIDs_Call {
+	return &MockExpenseRepository_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ids)}
+}
+
+func (_c *MockExpenseRepository_GetByIDs_Call) Run(run func(ids []uint)) *MockExpenseRepository_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []uint
+		if args[0] != nil {
+			arg0 = args[0].([]uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseRepository_GetByIDs_Call) Return(expenseEntitys []expense.ExpenseEntity, err error) *MockExpenseRepository_GetByIDs_Call {
+	_c.Call.Return(expenseEntitys, err)
+	return _c
+}
+
+func (_c *MockExpenseRepository_GetByIDs_Call) RunAndReturn(run func(ids []uint) ([]expense.ExpenseEntity, error)) *MockExpenseRepository_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedByIDAndUser provides a mock function for the type MockExpenseRepository
+func (_mock *MockExpenseRepository) GetDeletedByIDAndUser(id uint, userID uint) (*expense.ExpenseEntity, error) {
+	ret := _mock.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedByIDAndUser")
+	}
+
+	var r0 *expense.ExpenseEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) (*expense.ExpenseEntity, error)); ok {
+		return returnFunc(id, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) *expense.ExpenseEntity); ok {
+		r0 = returnFunc(id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*expense.ExpenseEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = returnFunc(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseRepository_GetDeletedByIDAndUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedByIDAndUser'
+type MockExpenseRepository_GetDeletedByIDAndUser_Call struct {
+	*mock.Call
+}
+
+// GetDeletedByIDAndUser is a helper method to define mock.On call
+//   - id uint
+//   - userID uint
+func (_e *MockExpenseRepository_Expecter) GetDeletedByIDAndUser(id interface{}, userID interface{}) *MockExpenseRepository_GetDeletedByIDAndUser_Call {
+	return &MockExpenseRepository_GetDeletedByIDAndUser_Call{Call: _e.mock.On("GetDeletedByIDAndUser", id, userID)}
+}
+
+func (_c *MockExpenseRepository_GetDeletedByIDAndUser_Call) Run(run func(id uint, userID uint)) *MockExpenseRepository_GetDeletedByIDAndUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseRepository_GetDeletedByIDAndUser_Call) Return(expenseEntity *expense.ExpenseEntity, err error) *MockExpenseRepository_GetDeletedByIDAndUser_Call {
+	_c.Call.Return(expenseEntity, err)
+	return _c
+}
+
+func (_c *MockExpenseRepository_GetDeletedByIDAndUser_Call) RunAndReturn(run func(id uint, userID uint) (*expense.ExpenseEntity, error)) *MockExpenseRepository_GetDeletedByIDAndUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedByUser provides a mock function for the type MockExpenseRepository
+func (_mock *MockExpenseRepository) GetDeletedByUser(userID uint) ([]expense.ExpenseEntity, error) {
 	ret := _mock.Called(userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByUser")
+		panic("no return value specified for GetDeletedByUser")
 	}
 
 	var r0 []expense.ExpenseEntity
@@ -303,18 +626,18 @@ func (_mock *MockExpenseRepository) GetByUser(userID uint) ([]expense.ExpenseEnt
 	return r0, r1
 }
 
-// MockExpenseRepository_GetByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUser'
-type MockExpenseRepository_GetByUser_Call struct {
+// MockExpenseRepository_GetDeletedByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedByUser'
+type MockExpenseRepository_GetDeletedByUser_Call struct {
 	*mock.Call
 }
 
-// GetByUser is a helper method to define mock.On call
+// GetDeletedByUser is a helper method to define mock.On call
 //   - userID uint
-func (_e *MockExpenseRepository_Expecter) GetByUser(userID interface{}) *MockExpenseRepository_GetByUser_Call {
-	return &MockExpenseRepository_GetByUser_Call{Call: _e.mock.On("GetByUser", userID)}
+func (_e *MockExpenseRepository_Expecter) GetDeletedByUser(userID interface{}) *MockExpenseRepository_GetDeletedByUser_Call {
+	return &MockExpenseRepository_GetDeletedByUser_Call{Call: _e.mock.On("GetDeletedByUser", userID)}
 }
 
-func (_c *MockExpenseRepository_GetByUser_Call) Run(run func(userID uint)) *MockExpenseRepository_GetByUser_Call {
+func (_c *MockExpenseRepository_GetDeletedByUser_Call) Run(run func(userID uint)) *MockExpenseRepository_GetDeletedByUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 uint
 		if args[0] != nil {
@@ -327,12 +650,80 @@ func (_c *MockExpenseRepository_GetByUser_Call) Run(run func(userID uint)) *Mock
 	return _c
 }
 
-func (_c *MockExpenseRepository_GetByUser_Call) Return(expenseEntitys []expense.ExpenseEntity, err error) *MockExpenseRepository_GetByUser_Call {
+func (_c *MockExpenseRepository_GetDeletedByUser_Call) Return(expenseEntitys []expense.ExpenseEntity, err error) *MockExpenseRepository_GetDeletedByUser_Call {
 	_c.Call.Return(expenseEntitys, err)
 	return _c
 }
 
-func (_c *MockExpenseRepository_GetByUser_Call) RunAndReturn(run func(userID uint) ([]expense.ExpenseEntity, error)) *MockExpenseRepository_GetByUser_Call {
+func (_c *MockExpenseRepository_GetDeletedByUser_Call) RunAndReturn(run func(userID uint) ([]expense.ExpenseEntity, error)) *MockExpenseRepository_GetDeletedByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExternalIDs provides a mock function for the type MockExpenseRepository
+func (_mock *MockExpenseRepository) GetExternalIDs(userID uint, externalIDs []string) ([]string, error) {
+	ret := _mock.Called(userID, externalIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExternalIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint, []string) ([]string, error)); ok {
+		return returnFunc(userID, externalIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, []string) []string); ok {
+		r0 = returnFunc(userID, externalIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, []string) error); ok {
+		r1 = returnFunc(userID, externalIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseRepository_GetExternalIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExternalIDs'
+type MockExpenseRepository_GetExternalIDs_Call struct {
+	*mock.Call
+}
+
+// GetExternalIDs is a helper method to define mock.On call
+//   - userID uint
+//   - externalIDs []string
+func (_e *MockExpenseRepository_Expecter) GetExternalIDs(userID interface{}, externalIDs interface{}) *MockExpenseRepository_GetExternalIDs_Call {
+	return &MockExpenseRepository_GetExternalIDs_Call{Call: _e.mock.On("GetExternalIDs", userID, externalIDs)}
+}
+
+func (_c *MockExpenseRepository_GetExternalIDs_Call) Run(run func(userID uint, externalIDs []string)) *MockExpenseRepository_GetExternalIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseRepository_GetExternalIDs_Call) Return(ss []string, err error) *MockExpenseRepository_GetExternalIDs_Call {
+	_c.Call.Return(ss, err)
+	return _c
+}
+
+func (_c *MockExpenseRepository_GetExternalIDs_Call) RunAndReturn(run func(userID uint, externalIDs []string) ([]string, error)) *MockExpenseRepository_GetExternalIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -403,6 +794,231 @@ func (_c *MockExpenseRepository_IsOwner_Call) RunAndReturn(run func(id uint, use
 	return _c
 }
 
+// Purge provides a mock function for the type MockExpenseRepository
+func (_mock *MockExpenseRepository) Purge(before time.Time) (int64, error) {
+	ret := _mock.Called(before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(time.Time) (int64, error)); ok {
+		return returnFunc(before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(time.Time) int64); ok {
+		r0 = returnFunc(before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = returnFunc(before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockExpenseRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - before time.Time
+func (_e *MockExpenseRepository_Expecter) Purge(before interface{}) *MockExpenseRepository_Purge_Call {
+	return &MockExpenseRepository_Purge_Call{Call: _e.mock.On("Purge", before)}
+}
+
+func (_c *MockExpenseRepository_Purge_Call) Run(run func(before time.Time)) *MockExpenseRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 time.Time
+		if args[0] != nil {
+			arg0 = args[0].(time.Time)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseRepository_Purge_Call) Return(n int64, err error) *MockExpenseRepository_Purge_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockExpenseRepository_Purge_Call) RunAndReturn(run func(before time.Time) (int64, error)) *MockExpenseRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceSplits provides a mock function for the type MockExpenseRepository
+func (_mock *MockExpenseRepository) ReplaceSplits(expenseID uint, splits []expense.ExpenseSplitEntity) error {
+	ret := _mock.Called(expenseID, splits)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceSplits")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uint, []expense.ExpenseSplitEntity) error); ok {
+		r0 = returnFunc(expenseID, splits)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExpenseRepository_ReplaceSplits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceSplits'
+type MockExpenseRepository_ReplaceSplits_Call struct {
+	*mock.Call
+}
+
+// ReplaceSplits is a helper method to define mock.On call
+//   - expenseID uint
+//   - splits []expense.ExpenseSplitEntity
+func (_e *MockExpenseRepository_Expecter) ReplaceSplits(expenseID interface{}, splits interface{}) *MockExpenseRepository_ReplaceSplits_Call {
+	return &MockExpenseRepository_ReplaceSplits_Call{Call: _e.mock.On("ReplaceSplits", expenseID, splits)}
+}
+
+func (_c *MockExpenseRepository_ReplaceSplits_Call) Run(run func(expenseID uint, splits []expense.ExpenseSplitEntity)) *MockExpenseRepository_ReplaceSplits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 []expense.ExpenseSplitEntity
+		if args[1] != nil {
+			arg1 = args[1].([]expense.ExpenseSplitEntity)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseRepository_ReplaceSplits_Call) Return(err error) *MockExpenseRepository_ReplaceSplits_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExpenseRepository_ReplaceSplits_Call) RunAndReturn(run func(expenseID uint, splits []expense.ExpenseSplitEntity) error) *MockExpenseRepository_ReplaceSplits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockExpenseRepository
+func (_mock *MockExpenseRepository) Restore(id uint) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExpenseRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockExpenseRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - id uint
+func (_e *MockExpenseRepository_Expecter) Restore(id interface{}) *MockExpenseRepository_Restore_Call {
+	return &MockExpenseRepository_Restore_Call{Call: _e.mock.On("Restore", id)}
+}
+
+func (_c *MockExpenseRepository_Restore_Call) Run(run func(id uint)) *MockExpenseRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseRepository_Restore_Call) Return(err error) *MockExpenseRepository_Restore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExpenseRepository_Restore_Call) RunAndReturn(run func(id uint) error) *MockExpenseRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stream provides a mock function for the type MockExpenseRepository
+func (_mock *MockExpenseRepository) Stream(query expense.ExpenseQuery, fn func(expense.ExpenseExportRow) error) error {
+	ret := _mock.Called(query, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stream")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(expense.ExpenseQuery, func(expense.ExpenseExportRow) error) error); ok {
+		r0 = returnFunc(query, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExpenseRepository_Stream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stream'
+type MockExpenseRepository_Stream_Call struct {
+	*mock.Call
+}
+
+// Stream is a helper method to define mock.On call
+//   - query expense.ExpenseQuery
+//   - fn func(expense.ExpenseExportRow) error
+func (_e *MockExpenseRepository_Expecter) Stream(query interface{}, fn interface{}) *MockExpenseRepository_Stream_Call {
+	return &MockExpenseRepository_Stream_Call{Call: _e.mock.On("Stream", query, fn)}
+}
+
+func (_c *MockExpenseRepository_Stream_Call) Run(run func(query expense.ExpenseQuery, fn func(expense.ExpenseExportRow) error)) *MockExpenseRepository_Stream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 expense.ExpenseQuery
+		if args[0] != nil {
+			arg0 = args[0].(expense.ExpenseQuery)
+		}
+		var arg1 func(expense.ExpenseExportRow) error
+		if args[1] != nil {
+			arg1 = args[1].(func(expense.ExpenseExportRow) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseRepository_Stream_Call) Return(err error) *MockExpenseRepository_Stream_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExpenseRepository_Stream_Call) RunAndReturn(run func(query expense.ExpenseQuery, fn func(expense.ExpenseExportRow) error) error) *MockExpenseRepository_Stream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockExpenseRepository
 func (_mock *MockExpenseRepository) Update(expense1 *expense.ExpenseEntity) error {
 	ret := _mock.Called(expense1)
@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"io"
+
 	"github.com/Perajit/expense-tracker-go/internal/expense"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -36,6 +38,74 @@ func (_m *MockExpenseService) EXPECT() *MockExpenseService_Expecter {
 	return &MockExpenseService_Expecter{mock: &_m.Mock}
 }
 
+// BulkUpdateExpenses provides a mock function for the type MockExpenseService
+func (_mock *MockExpenseService) BulkUpdateExpenses(authUserID uint, dto expense.BulkExpenseRequest) (*expense.BulkResult, error) {
+	ret := _mock.Called(authUserID, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUpdateExpenses")
+	}
+
+	var r0 *expense.BulkResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint, expense.BulkExpenseRequest) (*expense.BulkResult, error)); ok {
+		return returnFunc(authUserID, dto)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, expense.BulkExpenseRequest) *expense.BulkResult); ok {
+		r0 = returnFunc(authUserID, dto)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*expense.BulkResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, expense.BulkExpenseRequest) error); ok {
+		r1 = returnFunc(authUserID, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseService_BulkUpdateExpenses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdateExpenses'
+type MockExpenseService_BulkUpdateExpenses_Call struct {
+	*mock.Call
+}
+
+// BulkUpdateExpenses is a helper method to define mock.On call
+//   - authUserID uint
+//   - dto expense.BulkExpenseRequest
+func (_e *MockExpenseService_Expecter) BulkUpdateExpenses(authUserID interface{}, dto interface{}) *MockExpenseService_BulkUpdateExpenses_Call {
+	return &MockExpenseService_BulkUpdateExpenses_Call{Call: _e.mock.On("BulkUpdateExpenses", authUserID, dto)}
+}
+
+func (_c *MockExpenseService_BulkUpdateExpenses_Call) Run(run func(authUserID uint, dto expense.BulkExpenseRequest)) *MockExpenseService_BulkUpdateExpenses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 expense.BulkExpenseRequest
+		if args[1] != nil {
+			arg1 = args[1].(expense.BulkExpenseRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseService_BulkUpdateExpenses_Call) Return(bulkResult *expense.BulkResult, err error) *MockExpenseService_BulkUpdateExpenses_Call {
+	_c.Call.Return(bulkResult, err)
+	return _c
+}
+
+func (_c *MockExpenseService_BulkUpdateExpenses_Call) RunAndReturn(run func(authUserID uint, dto expense.BulkExpenseRequest) (*expense.BulkResult, error)) *MockExpenseService_BulkUpdateExpenses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateExpense provides a mock function for the type MockExpenseService
 func (_mock *MockExpenseService) CreateExpense(authUserID uint, dto expense.CreateExpenseRequest) (*expense.ExpenseEntity, error) {
 	ret := _mock.Called(authUserID, dto)
@@ -161,6 +231,69 @@ func (_c *MockExpenseService_DeleteExpense_Call) RunAndReturn(run func(id uint,
 	return _c
 }
 
+// ExportExpenses provides a mock function for the type MockExpenseService
+func (_mock *MockExpenseService) ExportExpenses(authUserID uint, dto expense.ExportExpensesRequest, w io.Writer) error {
+	ret := _mock.Called(authUserID, dto, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportExpenses")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uint, expense.ExportExpensesRequest, io.Writer) error); ok {
+		r0 = returnFunc(authUserID, dto, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExpenseService_ExportExpenses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportExpenses'
+type MockExpenseService_ExportExpenses_Call struct {
+	*mock.Call
+}
+
+// ExportExpenses is a helper method to define mock.On call
+//   - authUserID uint
+//   - dto expense.ExportExpensesRequest
+//   - w io.Writer
+func (_e *MockExpenseService_Expecter) ExportExpenses(authUserID interface{}, dto interface{}, w interface{}) *MockExpenseService_ExportExpenses_Call {
+	return &MockExpenseService_ExportExpenses_Call{Call: _e.mock.On("ExportExpenses", authUserID, dto, w)}
+}
+
+func (_c *MockExpenseService_ExportExpenses_Call) Run(run func(authUserID uint, dto expense.ExportExpensesRequest, w io.Writer)) *MockExpenseService_ExportExpenses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 expense.ExportExpensesRequest
+		if args[1] != nil {
+			arg1 = args[1].(expense.ExportExpensesRequest)
+		}
+		var arg2 io.Writer
+		if args[2] != nil {
+			arg2 = args[2].(io.Writer)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseService_ExportExpenses_Call) Return(err error) *MockExpenseService_ExportExpenses_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExpenseService_ExportExpenses_Call) RunAndReturn(run func(authUserID uint, dto expense.ExportExpensesRequest, w io.Writer) error) *MockExpenseService_ExportExpenses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExpenseByID provides a mock function for the type MockExpenseService
 func (_mock *MockExpenseService) GetExpenseByID(id uint, authUserID uint) (*expense.ExpenseEntity, error) {
 	ret := _mock.Called(id, authUserID)
@@ -229,28 +362,96 @@ func (_c *MockExpenseService_GetExpenseByID_Call) RunAndReturn(run func(id uint,
 	return _c
 }
 
+// GetExpenseHistory provides a mock function for the type MockExpenseService
+func (_mock *MockExpenseService) GetExpenseHistory(id uint, authUserID uint) ([]expense.ExpenseRevisionEntity, error) {
+	ret := _mock.Called(id, authUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpenseHistory")
+	}
+
+	var r0 []expense.ExpenseRevisionEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) ([]expense.ExpenseRevisionEntity, error)); ok {
+		return returnFunc(id, authUserID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) []expense.ExpenseRevisionEntity); ok {
+		r0 = returnFunc(id, authUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.ExpenseRevisionEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = returnFunc(id, authUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExpenseService_GetExpenseHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpenseHistory'
+type MockExpenseService_GetExpenseHistory_Call struct {
+	*mock.Call
+}
+
+// GetExpenseHistory is a helper method to define mock.On call
+//   - id uint
+//   - authUserID uint
+func (_e *MockExpenseService_Expecter) GetExpenseHistory(id interface{}, authUserID interface{}) *MockExpenseService_GetExpenseHistory_Call {
+	return &MockExpenseService_GetExpenseHistory_Call{Call: _e.mock.On("GetExpenseHistory", id, authUserID)}
+}
+
+func (_c *MockExpenseService_GetExpenseHistory_Call) Run(run func(id uint, authUserID uint)) *MockExpenseService_GetExpenseHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseService_GetExpenseHistory_Call) Return(expenseRevisionEntitys []expense.ExpenseRevisionEntity, err error) *MockExpenseService_GetExpenseHistory_Call {
+	_c.Call.Return(expenseRevisionEntitys, err)
+	return _c
+}
+
+func (_c *MockExpenseService_GetExpenseHistory_Call) RunAndReturn(run func(id uint, authUserID uint) ([]expense.ExpenseRevisionEntity, error)) *MockExpenseService_GetExpenseHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExpenses provides a mock function for the type MockExpenseService
-func (_mock *MockExpenseService) GetExpenses(authUserID uint) ([]expense.ExpenseEntity, error) {
-	ret := _mock.Called(authUserID)
+func (_mock *MockExpenseService) GetExpenses(authUserID uint, dto expense.GetExpensesRequest) (*expense.ExpensePage, error) {
+	ret := _mock.Called(authUserID, dto)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetExpenses")
 	}
 
-	var r0 []expense.ExpenseEntity
+	var r0 *expense.ExpensePage
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(uint) ([]expense.ExpenseEntity, error)); ok {
-		return returnFunc(authUserID)
+	if returnFunc, ok := ret.Get(0).(func(uint, expense.GetExpensesRequest) (*expense.ExpensePage, error)); ok {
+		return returnFunc(authUserID, dto)
 	}
-	if returnFunc, ok := ret.Get(0).(func(uint) []expense.ExpenseEntity); ok {
-		r0 = returnFunc(authUserID)
+	if returnFunc, ok := ret.Get(0).(func(uint, expense.GetExpensesRequest) *expense.ExpensePage); ok {
+		r0 = returnFunc(authUserID, dto)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]expense.ExpenseEntity)
+			r0 = ret.Get(0).(*expense.ExpensePage)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(uint) error); ok {
-		r1 = returnFunc(authUserID)
+	if returnFunc, ok := ret.Get(1).(func(uint, expense.GetExpensesRequest) error); ok {
+		r1 = returnFunc(authUserID, dto)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -264,29 +465,174 @@ type MockExpenseService_GetExpenses_Call struct {
 
 // GetExpenses is a helper method to define mock.On call
 //   - authUserID uint
-func (_e *MockExpenseService_Expecter) GetExpenses(authUserID interface{}) *MockExpenseService_GetExpenses_Call {
-	return &MockExpenseService_GetExpenses_Call{Call: _e.mock.On("GetExpenses", authUserID)}
+//   - dto expense.GetExpensesRequest
+func (_e *MockExpenseService_Expecter) GetExpenses(authUserID interface{}, dto interface{}) *MockExpenseService_GetExpenses_Call {
+	return &MockExpenseService_GetExpenses_Call{Call: _e.mock.On("GetExpenses", authUserID, dto)}
 }
 
-func (_c *MockExpenseService_GetExpenses_Call) Run(run func(authUserID uint)) *MockExpenseService_GetExpenses_Call {
+func (_c *MockExpenseService_GetExpenses_Call) Run(run func(authUserID uint, dto expense.GetExpensesRequest)) *MockExpenseService_GetExpenses_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 uint
 		if args[0] != nil {
 			arg0 = args[0].(uint)
 		}
+		var arg1 expense.GetExpensesRequest
+		if args[1] != nil {
+			arg1 = args[1].(expense.GetExpensesRequest)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockExpenseService_GetExpenses_Call) Return(expenseEntitys []expense.ExpenseEntity, err error) *MockExpenseService_GetExpenses_Call {
-	_c.Call.Return(expenseEntitys, err)
+func (_c *MockExpenseService_GetExpenses_Call) Return(expensePage *expense.ExpensePage, err error) *MockExpenseService_GetExpenses_Call {
+	_c.Call.Return(expensePage, err)
+	return _c
+}
+
+func (_c *MockExpenseService_GetExpenses_Call) RunAndReturn(run func(authUserID uint, dto expense.GetExpensesRequest) (*expense.ExpensePage, error)) *MockExpenseService_GetExpenses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QuickAddExpense provides a mock function for the type MockExpenseService
+func (_mock *MockExpenseService) QuickAddExpense(authUserID uint, dto expense.QuickAddRequest) (*expense.ExpenseEntity, []expense.QuickAddToken, error) {
+	ret := _mock.Called(authUserID, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuickAddExpense")
+	}
+
+	var r0 *expense.ExpenseEntity
+	var r1 []expense.QuickAddToken
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(uint, expense.QuickAddRequest) (*expense.ExpenseEntity, []expense.QuickAddToken, error)); ok {
+		return returnFunc(authUserID, dto)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, expense.QuickAddRequest) *expense.ExpenseEntity); ok {
+		r0 = returnFunc(authUserID, dto)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*expense.ExpenseEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, expense.QuickAddRequest) []expense.QuickAddToken); ok {
+		r1 = returnFunc(authUserID, dto)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]expense.QuickAddToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(uint, expense.QuickAddRequest) error); ok {
+		r2 = returnFunc(authUserID, dto)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockExpenseService_QuickAddExpense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QuickAddExpense'
+type MockExpenseService_QuickAddExpense_Call struct {
+	*mock.Call
+}
+
+// QuickAddExpense is a helper method to define mock.On call
+//   - authUserID uint
+//   - dto expense.QuickAddRequest
+func (_e *MockExpenseService_Expecter) QuickAddExpense(authUserID interface{}, dto interface{}) *MockExpenseService_QuickAddExpense_Call {
+	return &MockExpenseService_QuickAddExpense_Call{Call: _e.mock.On("QuickAddExpense", authUserID, dto)}
+}
+
+func (_c *MockExpenseService_QuickAddExpense_Call) Run(run func(authUserID uint, dto expense.QuickAddRequest)) *MockExpenseService_QuickAddExpense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 expense.QuickAddRequest
+		if args[1] != nil {
+			arg1 = args[1].(expense.QuickAddRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseService_QuickAddExpense_Call) Return(expenseEntity *expense.ExpenseEntity, quickAddTokens []expense.QuickAddToken, err error) *MockExpenseService_QuickAddExpense_Call {
+	_c.Call.Return(expenseEntity, quickAddTokens, err)
+	return _c
+}
+
+func (_c *MockExpenseService_QuickAddExpense_Call) RunAndReturn(run func(authUserID uint, dto expense.QuickAddRequest) (*expense.ExpenseEntity, []expense.QuickAddToken, error)) *MockExpenseService_QuickAddExpense_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevertExpense provides a mock function for the type MockExpenseService
+func (_mock *MockExpenseService) RevertExpense(id uint, revisionID uint, authUserID uint) error {
+	ret := _mock.Called(id, revisionID, authUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevertExpense")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uint, uint, uint) error); ok {
+		r0 = returnFunc(id, revisionID, authUserID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExpenseService_RevertExpense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevertExpense'
+type MockExpenseService_RevertExpense_Call struct {
+	*mock.Call
+}
+
+// RevertExpense is a helper method to define mock.On call
+//   - id uint
+//   - revisionID uint
+//   - authUserID uint
+func (_e *MockExpenseService_Expecter) RevertExpense(id interface{}, revisionID interface{}, authUserID interface{}) *MockExpenseService_RevertExpense_Call {
+	return &MockExpenseService_RevertExpense_Call{Call: _e.mock.On("RevertExpense", id, revisionID, authUserID)}
+}
+
+func (_c *MockExpenseService_RevertExpense_Call) Run(run func(id uint, revisionID uint, authUserID uint)) *MockExpenseService_RevertExpense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 uint
+		if args[2] != nil {
+			arg2 = args[2].(uint)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExpenseService_RevertExpense_Call) Return(err error) *MockExpenseService_RevertExpense_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockExpenseService_GetExpenses_Call) RunAndReturn(run func(authUserID uint) ([]expense.ExpenseEntity, error)) *MockExpenseService_GetExpenses_Call {
+func (_c *MockExpenseService_RevertExpense_Call) RunAndReturn(run func(id uint, revisionID uint, authUserID uint) error) *MockExpenseService_RevertExpense_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,8 +5,11 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/Perajit/expense-tracker-go/internal/expense"
 	mock "github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 // NewMockTagRepository creates a new instance of MockTagRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -36,6 +39,66 @@ func (_m *MockTagRepository) EXPECT() *MockTagRepository_Expecter {
 	return &MockTagRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountExpenses provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) CountExpenses(id uint) (int64, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountExpenses")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint) (int64, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagRepository_CountExpenses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountExpenses'
+type MockTagRepository_CountExpenses_Call struct {
+	*mock.Call
+}
+
+// CountExpenses is a helper method to define mock.On call
+//   - id uint
+func (_e *MockTagRepository_Expecter) CountExpenses(id interface{}) *MockTagRepository_CountExpenses_Call {
+	return &MockTagRepository_CountExpenses_Call{Call: _e.mock.On("CountExpenses", id)}
+}
+
+func (_c *MockTagRepository_CountExpenses_Call) Run(run func(id uint)) *MockTagRepository_CountExpenses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_CountExpenses_Call) Return(n int64, err error) *MockTagRepository_CountExpenses_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockTagRepository_CountExpenses_Call) RunAndReturn(run func(id uint) (int64, error)) *MockTagRepository_CountExpenses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockTagRepository
 func (_mock *MockTagRepository) Create(tag *expense.TagEntity) error {
 	ret := _mock.Called(tag)
@@ -274,6 +337,74 @@ func (_c *MockTagRepository_GetByIDsAndUser_Call) RunAndReturn(run func(ids []ui
 	return _c
 }
 
+// GetByNames provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) GetByNames(userID uint, names []string) ([]expense.TagEntity, error) {
+	ret := _mock.Called(userID, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByNames")
+	}
+
+	var r0 []expense.TagEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint, []string) ([]expense.TagEntity, error)); ok {
+		return returnFunc(userID, names)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, []string) []expense.TagEntity); ok {
+		r0 = returnFunc(userID, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.TagEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, []string) error); ok {
+		r1 = returnFunc(userID, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagRepository_GetByNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByNames'
+type MockTagRepository_GetByNames_Call struct {
+	*mock.Call
+}
+
+// GetByNames is a helper method to define mock.On call
+//   - userID uint
+//   - names []string
+func (_e *MockTagRepository_Expecter) GetByNames(userID interface{}, names interface{}) *MockTagRepository_GetByNames_Call {
+	return &MockTagRepository_GetByNames_Call{Call: _e.mock.On("GetByNames", userID, names)}
+}
+
+func (_c *MockTagRepository_GetByNames_Call) Run(run func(userID uint, names []string)) *MockTagRepository_GetByNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_GetByNames_Call) Return(tagEntitys []expense.TagEntity, err error) *MockTagRepository_GetByNames_Call {
+	_c.Call.Return(tagEntitys, err)
+	return _c
+}
+
+func (_c *MockTagRepository_GetByNames_Call) RunAndReturn(run func(userID uint, names []string) ([]expense.TagEntity, error)) *MockTagRepository_GetByNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByUser provides a mock function for the type MockTagRepository
 func (_mock *MockTagRepository) GetByUser(userID uint) ([]expense.TagEntity, error) {
 	ret := _mock.Called(userID)
@@ -336,6 +467,198 @@ func (_c *MockTagRepository_GetByUser_Call) RunAndReturn(run func(userID uint) (
 	return _c
 }
 
+// GetDeletedByIDAndUser provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) GetDeletedByIDAndUser(id uint, userID uint) (*expense.TagEntity, error) {
+	ret := _mock.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedByIDAndUser")
+	}
+
+	var r0 *expense.TagEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) (*expense.TagEntity, error)); ok {
+		return returnFunc(id, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) *expense.TagEntity); ok {
+		r0 = returnFunc(id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*expense.TagEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = returnFunc(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagRepository_GetDeletedByIDAndUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedByIDAndUser'
+type MockTagRepository_GetDeletedByIDAndUser_Call struct {
+	*mock.Call
+}
+
+// GetDeletedByIDAndUser is a helper method to define mock.On call
+//   - id uint
+//   - userID uint
+func (_e *MockTagRepository_Expecter) GetDeletedByIDAndUser(id interface{}, userID interface{}) *MockTagRepository_GetDeletedByIDAndUser_Call {
+	return &MockTagRepository_GetDeletedByIDAndUser_Call{Call: _e.mock.On("GetDeletedByIDAndUser", id, userID)}
+}
+
+func (_c *MockTagRepository_GetDeletedByIDAndUser_Call) Run(run func(id uint, userID uint)) *MockTagRepository_GetDeletedByIDAndUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_GetDeletedByIDAndUser_Call) Return(tagEntity *expense.TagEntity, err error) *MockTagRepository_GetDeletedByIDAndUser_Call {
+	_c.Call.Return(tagEntity, err)
+	return _c
+}
+
+func (_c *MockTagRepository_GetDeletedByIDAndUser_Call) RunAndReturn(run func(id uint, userID uint) (*expense.TagEntity, error)) *MockTagRepository_GetDeletedByIDAndUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedByUser provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) GetDeletedByUser(userID uint) ([]expense.TagEntity, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedByUser")
+	}
+
+	var r0 []expense.TagEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint) ([]expense.TagEntity, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint) []expense.TagEntity); ok {
+		r0 = returnFunc(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.TagEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagRepository_GetDeletedByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedByUser'
+type MockTagRepository_GetDeletedByUser_Call struct {
+	*mock.Call
+}
+
+// GetDeletedByUser is a helper method to define mock.On call
+//   - userID uint
+func (_e *MockTagRepository_Expecter) GetDeletedByUser(userID interface{}) *MockTagRepository_GetDeletedByUser_Call {
+	return &MockTagRepository_GetDeletedByUser_Call{Call: _e.mock.On("GetDeletedByUser", userID)}
+}
+
+func (_c *MockTagRepository_GetDeletedByUser_Call) Run(run func(userID uint)) *MockTagRepository_GetDeletedByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_GetDeletedByUser_Call) Return(tagEntitys []expense.TagEntity, err error) *MockTagRepository_GetDeletedByUser_Call {
+	_c.Call.Return(tagEntitys, err)
+	return _c
+}
+
+func (_c *MockTagRepository_GetDeletedByUser_Call) RunAndReturn(run func(userID uint) ([]expense.TagEntity, error)) *MockTagRepository_GetDeletedByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExpenseIDs provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) GetExpenseIDs(id uint) ([]uint, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpenseIDs")
+	}
+
+	var r0 []uint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint) ([]uint, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint) []uint); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagRepository_GetExpenseIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpenseIDs'
+type MockTagRepository_GetExpenseIDs_Call struct {
+	*mock.Call
+}
+
+// GetExpenseIDs is a helper method to define mock.On call
+//   - id uint
+func (_e *MockTagRepository_Expecter) GetExpenseIDs(id interface{}) *MockTagRepository_GetExpenseIDs_Call {
+	return &MockTagRepository_GetExpenseIDs_Call{Call: _e.mock.On("GetExpenseIDs", id)}
+}
+
+func (_c *MockTagRepository_GetExpenseIDs_Call) Run(run func(id uint)) *MockTagRepository_GetExpenseIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_GetExpenseIDs_Call) Return(vs []uint, err error) *MockTagRepository_GetExpenseIDs_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockTagRepository_GetExpenseIDs_Call) RunAndReturn(run func(id uint) ([]uint, error)) *MockTagRepository_GetExpenseIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsOwner provides a mock function for the type MockTagRepository
 func (_mock *MockTagRepository) IsOwner(id uint, userID uint) (bool, error) {
 	ret := _mock.Called(id, userID)
@@ -402,6 +725,174 @@ func (_c *MockTagRepository_IsOwner_Call) RunAndReturn(run func(id uint, userID
 	return _c
 }
 
+// Purge provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) Purge(before time.Time) (int64, error) {
+	ret := _mock.Called(before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(time.Time) (int64, error)); ok {
+		return returnFunc(before)
+	}
+	if returnFunc, ok := ret.Get(0).(func(time.Time) int64); ok {
+		r0 = returnFunc(before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = returnFunc(before)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockTagRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - before time.Time
+func (_e *MockTagRepository_Expecter) Purge(before interface{}) *MockTagRepository_Purge_Call {
+	return &MockTagRepository_Purge_Call{Call: _e.mock.On("Purge", before)}
+}
+
+func (_c *MockTagRepository_Purge_Call) Run(run func(before time.Time)) *MockTagRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 time.Time
+		if args[0] != nil {
+			arg0 = args[0].(time.Time)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_Purge_Call) Return(n int64, err error) *MockTagRepository_Purge_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockTagRepository_Purge_Call) RunAndReturn(run func(before time.Time) (int64, error)) *MockTagRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reassign provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) Reassign(fromID uint, toID uint) error {
+	ret := _mock.Called(fromID, toID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reassign")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = returnFunc(fromID, toID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTagRepository_Reassign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reassign'
+type MockTagRepository_Reassign_Call struct {
+	*mock.Call
+}
+
+// Reassign is a helper method to define mock.On call
+//   - fromID uint
+//   - toID uint
+func (_e *MockTagRepository_Expecter) Reassign(fromID interface{}, toID interface{}) *MockTagRepository_Reassign_Call {
+	return &MockTagRepository_Reassign_Call{Call: _e.mock.On("Reassign", fromID, toID)}
+}
+
+func (_c *MockTagRepository_Reassign_Call) Run(run func(fromID uint, toID uint)) *MockTagRepository_Reassign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_Reassign_Call) Return(err error) *MockTagRepository_Reassign_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTagRepository_Reassign_Call) RunAndReturn(run func(fromID uint, toID uint) error) *MockTagRepository_Reassign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) Restore(id uint) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTagRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockTagRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - id uint
+func (_e *MockTagRepository_Expecter) Restore(id interface{}) *MockTagRepository_Restore_Call {
+	return &MockTagRepository_Restore_Call{Call: _e.mock.On("Restore", id)}
+}
+
+func (_c *MockTagRepository_Restore_Call) Run(run func(id uint)) *MockTagRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_Restore_Call) Return(err error) *MockTagRepository_Restore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTagRepository_Restore_Call) RunAndReturn(run func(id uint) error) *MockTagRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockTagRepository
 func (_mock *MockTagRepository) Update(tag *expense.TagEntity) error {
 	ret := _mock.Called(tag)
@@ -452,3 +943,56 @@ func (_c *MockTagRepository_Update_Call) RunAndReturn(run func(tag *expense.TagE
 	_c.Call.Return(run)
 	return _c
 }
+
+// WithTx provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) WithTx(tx *gorm.DB) expense.TagRepository {
+	ret := _mock.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 expense.TagRepository
+	if returnFunc, ok := ret.Get(0).(func(*gorm.DB) expense.TagRepository); ok {
+		r0 = returnFunc(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(expense.TagRepository)
+		}
+	}
+	return r0
+}
+
+// MockTagRepository_WithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTx'
+type MockTagRepository_WithTx_Call struct {
+	*mock.Call
+}
+
+// WithTx is a helper method to define mock.On call
+//   - tx *gorm.DB
+func (_e *MockTagRepository_Expecter) WithTx(tx interface{}) *MockTagRepository_WithTx_Call {
+	return &MockTagRepository_WithTx_Call{Call: _e.mock.On("WithTx", tx)}
+}
+
+func (_c *MockTagRepository_WithTx_Call) Run(run func(tx *gorm.DB)) *MockTagRepository_WithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gorm.DB
+		if args[0] != nil {
+			arg0 = args[0].(*gorm.DB)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_WithTx_Call) Return(tagRepository expense.TagRepository) *MockTagRepository_WithTx_Call {
+	_c.Call.Return(tagRepository)
+	return _c
+}
+
+func (_c *MockTagRepository_WithTx_Call) RunAndReturn(run func(tx *gorm.DB) expense.TagRepository) *MockTagRepository_WithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
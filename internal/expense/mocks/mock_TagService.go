@@ -359,6 +359,154 @@ func (_c *MockTagService_GetTagsByIDs_Call) RunAndReturn(run func(ids []uint, au
 	return _c
 }
 
+// GetTagsByNames provides a mock function for the type MockTagService
+func (_mock *MockTagService) GetTagsByNames(names []string, authUserID uint) ([]expense.TagEntity, error) {
+	ret := _mock.Called(names, authUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagsByNames")
+	}
+
+	var r0 []expense.TagEntity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]string, uint) ([]expense.TagEntity, error)); ok {
+		return returnFunc(names, authUserID)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]string, uint) []expense.TagEntity); ok {
+		r0 = returnFunc(names, authUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]expense.TagEntity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]string, uint) error); ok {
+		r1 = returnFunc(names, authUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagService_GetTagsByNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagsByNames'
+type MockTagService_GetTagsByNames_Call struct {
+	*mock.Call
+}
+
+// GetTagsByNames is a helper method to define mock.On call
+//   - names []string
+//   - authUserID uint
+func (_e *MockTagService_Expecter) GetTagsByNames(names interface{}, authUserID interface{}) *MockTagService_GetTagsByNames_Call {
+	return &MockTagService_GetTagsByNames_Call{Call: _e.mock.On("GetTagsByNames", names, authUserID)}
+}
+
+func (_c *MockTagService_GetTagsByNames_Call) Run(run func(names []string, authUserID uint)) *MockTagService_GetTagsByNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagService_GetTagsByNames_Call) Return(tagEntitys []expense.TagEntity, err error) *MockTagService_GetTagsByNames_Call {
+	_c.Call.Return(tagEntitys, err)
+	return _c
+}
+
+func (_c *MockTagService_GetTagsByNames_Call) RunAndReturn(run func(names []string, authUserID uint) ([]expense.TagEntity, error)) *MockTagService_GetTagsByNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeTag provides a mock function for the type MockTagService
+func (_mock *MockTagService) MergeTag(id uint, targetID uint, authUserID uint, dto expense.MergeRequest) (*expense.MergeResult, error) {
+	ret := _mock.Called(id, targetID, authUserID, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeTag")
+	}
+
+	var r0 *expense.MergeResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint, uint, uint, expense.MergeRequest) (*expense.MergeResult, error)); ok {
+		return returnFunc(id, targetID, authUserID, dto)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, uint, uint, expense.MergeRequest) *expense.MergeResult); ok {
+		r0 = returnFunc(id, targetID, authUserID, dto)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*expense.MergeResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, uint, uint, expense.MergeRequest) error); ok {
+		r1 = returnFunc(id, targetID, authUserID, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagService_MergeTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeTag'
+type MockTagService_MergeTag_Call struct {
+	*mock.Call
+}
+
+// MergeTag is a helper method to define mock.On call
+//   - id uint
+//   - targetID uint
+//   - authUserID uint
+//   - dto expense.MergeRequest
+func (_e *MockTagService_Expecter) MergeTag(id interface{}, targetID interface{}, authUserID interface{}, dto interface{}) *MockTagService_MergeTag_Call {
+	return &MockTagService_MergeTag_Call{Call: _e.mock.On("MergeTag", id, targetID, authUserID, dto)}
+}
+
+func (_c *MockTagService_MergeTag_Call) Run(run func(id uint, targetID uint, authUserID uint, dto expense.MergeRequest)) *MockTagService_MergeTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		var arg2 uint
+		if args[2] != nil {
+			arg2 = args[2].(uint)
+		}
+		var arg3 expense.MergeRequest
+		if args[3] != nil {
+			arg3 = args[3].(expense.MergeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTagService_MergeTag_Call) Return(mergeResult *expense.MergeResult, err error) *MockTagService_MergeTag_Call {
+	_c.Call.Return(mergeResult, err)
+	return _c
+}
+
+func (_c *MockTagService_MergeTag_Call) RunAndReturn(run func(id uint, targetID uint, authUserID uint, dto expense.MergeRequest) (*expense.MergeResult, error)) *MockTagService_MergeTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTag provides a mock function for the type MockTagService
 func (_mock *MockTagService) UpdateTag(id uint, authUserID uint, dto expense.UpdateTagRequest) error {
 	ret := _mock.Called(id, authUserID, dto)
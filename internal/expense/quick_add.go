@@ -0,0 +1,196 @@
+package expense
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/currency"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	QuickTokenAmount   = "amount"
+	QuickTokenCurrency = "currency"
+	QuickTokenDate     = "date"
+	QuickTokenTag      = "tag"
+	QuickTokenCategory = "category"
+	QuickTokenNote     = "note"
+)
+
+var (
+	quickAmountPattern    = regexp.MustCompile(`^\d{1,3}(,\d{3})+(\.\d+)?$|^\d+([.,]\d+)?$`)
+	quickCurrencySymbols  = map[rune]string{'$': "USD", '€': "EUR", '£': "GBP", '¥': "JPY", '฿': "THB"}
+	quickRelativeDays     = map[string]int{"today": 0, "yesterday": -1, "tomorrow": 1}
+	quickWeekdayNames     = map[string]time.Weekday{}
+	quickWeekdayFullNames = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+)
+
+func init() {
+	for i, name := range quickWeekdayFullNames {
+		quickWeekdayNames[name] = time.Weekday(i)
+		quickWeekdayNames[name[:3]] = time.Weekday(i)
+	}
+}
+
+// quickAddInput is what parseQuickAdd understood from a line of free text.
+type quickAddInput struct {
+	Amount       decimal.Decimal
+	Currency     string
+	Date         *time.Time
+	TagNames     []string
+	CategoryName string
+	Note         string
+	Tokens       []QuickAddToken
+}
+
+// parseQuickAdd reads text like "lunch 12.50 yesterday #work @Food". The first number is the amount,
+// optionally with a currency symbol in front or an ISO code next to it; "today", "yesterday", "tomorrow",
+// a weekday (the latest one up to today) or a YYYY-MM-DD date set the day; #name adds a tag and @name picks
+// the category, with quotes for names containing spaces. Everything else becomes the note.
+func parseQuickAdd(text string, now time.Time) (*quickAddInput, error) {
+	input := &quickAddInput{}
+	notes := []string{}
+	hasAmount, hasDate := false, false
+	amountIndex := -1
+
+	words := splitQuickAdd(text)
+	for i, word := range words {
+		lower := strings.ToLower(word)
+
+		switch {
+		case len(word) > 1 && word[0] == '#':
+			name := unquote(word[1:])
+			input.TagNames = append(input.TagNames, name)
+			input.Tokens = append(input.Tokens, QuickAddToken{Text: word, Kind: QuickTokenTag, Value: name})
+			continue
+		case len(word) > 1 && word[0] == '@':
+			if input.CategoryName != "" {
+				return nil, fmt.Errorf("%w: more than one category in %q", apperror.ErrInvalidRequest, text)
+			}
+			input.CategoryName = unquote(word[1:])
+			input.Tokens = append(input.Tokens, QuickAddToken{Text: word, Kind: QuickTokenCategory, Value: input.CategoryName})
+			continue
+		}
+
+		if !hasAmount {
+			if amount, code, ok := parseQuickAmount(word); ok {
+				hasAmount, amountIndex = true, i
+				input.Amount = amount
+				input.Tokens = append(input.Tokens, QuickAddToken{Text: word, Kind: QuickTokenAmount, Value: amount.String()})
+				if code != "" {
+					input.Currency = code
+					input.Tokens = append(input.Tokens, QuickAddToken{Text: word, Kind: QuickTokenCurrency, Value: code})
+				}
+				continue
+			}
+		}
+
+		// a lowercase word only counts as a currency right next to the amount, so "all" or "top" stay in the note
+		if input.Currency == "" && len(word) == 3 && (word == strings.ToUpper(word) || (hasAmount && i == amountIndex+1)) {
+			if code, ok := currency.Normalize(word); ok {
+				input.Currency = code
+				input.Tokens = append(input.Tokens, QuickAddToken{Text: word, Kind: QuickTokenCurrency, Value: code})
+				continue
+			}
+		}
+
+		if !hasDate {
+			if date, ok := parseQuickDate(lower, now); ok {
+				hasDate = true
+				input.Date = &date
+				input.Tokens = append(input.Tokens, QuickAddToken{Text: word, Kind: QuickTokenDate, Value: date.Format("2006-01-02")})
+				continue
+			}
+		}
+
+		notes = append(notes, word)
+		input.Tokens = append(input.Tokens, QuickAddToken{Text: word, Kind: QuickTokenNote, Value: word})
+	}
+
+	if !hasAmount || !input.Amount.IsPositive() {
+		return nil, fmt.Errorf("%w: no amount in %q", apperror.ErrInvalidRequest, text)
+	}
+	input.Note = strings.Join(notes, " ")
+
+	return input, nil
+}
+
+// splitQuickAdd splits on whitespace, keeping a quoted name after # or @ in one piece.
+func splitQuickAdd(text string) []string {
+	words := []string{}
+	var word strings.Builder
+	quoted := false
+
+	for _, r := range strings.TrimSpace(text) {
+		switch {
+		case r == '"' && (quoted || word.Len() == 1 && strings.ContainsAny(word.String(), "#@")):
+			quoted = !quoted
+			word.WriteRune(r)
+		case unicode.IsSpace(r) && !quoted:
+			if word.Len() > 0 {
+				words = append(words, word.String())
+				word.Reset()
+			}
+		default:
+			word.WriteRune(r)
+		}
+	}
+	if word.Len() > 0 {
+		words = append(words, word.String())
+	}
+
+	return words
+}
+
+func unquote(name string) string {
+	return strings.TrimSpace(strings.Trim(name, `"`))
+}
+
+// parseQuickAmount accepts "12.50", "12,50", "1,200.50" and the same with a leading currency symbol.
+func parseQuickAmount(word string) (decimal.Decimal, string, bool) {
+	code := ""
+	for symbol, symbolCode := range quickCurrencySymbols {
+		if rest, ok := strings.CutPrefix(word, string(symbol)); ok {
+			word, code = rest, symbolCode
+			break
+		}
+	}
+
+	if !quickAmountPattern.MatchString(word) {
+		return decimal.Zero, "", false
+	}
+	if strings.Count(word, ",") == 1 && !strings.Contains(word, ".") && len(word)-strings.Index(word, ",") != 4 {
+		// a single comma that doesn't group thousands is a decimal comma
+		word = strings.Replace(word, ",", ".", 1)
+	}
+
+	amount, err := decimal.NewFromString(strings.ReplaceAll(word, ",", ""))
+	if err != nil {
+		return decimal.Zero, "", false
+	}
+
+	return amount, code, true
+}
+
+// parseQuickDate resolves a day relative to now, returning the start of that day in now's location.
+func parseQuickDate(word string, now time.Time) (time.Time, bool) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	if days, ok := quickRelativeDays[word]; ok {
+		return today.AddDate(0, 0, days), true
+	}
+
+	if weekday, ok := quickWeekdayNames[word]; ok {
+		return today.AddDate(0, 0, -((int(today.Weekday()) - int(weekday) + 7) % 7)), true
+	}
+
+	if date, err := time.ParseInLocation("2006-01-02", word, now.Location()); err == nil {
+		return date, true
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,20 @@
+package expense
+
+type QuickAddRequest struct {
+	Text       string `json:"text" validate:"required,max=500"`
+	CreateTags bool   `json:"createTags"`
+}
+
+// QuickAddToken explains how one word of the quick-add text was read. Detail says what it was matched to.
+type QuickAddToken struct {
+	Text   string `json:"text"`
+	Kind   string `json:"kind"`
+	Value  string `json:"value"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type QuickAddResponse struct {
+	Expense *ExpenseEntity    `json:"expense"`
+	Warning *DuplicateWarning `json:"warning,omitempty"`
+	Tokens  []QuickAddToken   `json:"tokens"`
+}
@@ -0,0 +1,108 @@
+package expense
+
+import (
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type CreateRecurringExpenseRequest struct {
+	Kind       string          `json:"kind" validate:"omitempty,oneof=expense income"`
+	Amount     decimal.Decimal `json:"amount" validate:"required"`
+	Currency   string          `json:"currency" validate:"omitempty,iso4217"`
+	Note       string          `json:"note"`
+	CategoryID uint            `json:"categoryId"`
+	TagIDs     []uint          `json:"tagIds"`
+	Frequency  string          `json:"frequency" validate:"required,oneof=daily weekly monthly yearly"`
+	Interval   int             `json:"interval" validate:"omitempty,min=1"`
+	ByDay      []string        `json:"byDay"`
+	StartDate  time.Time       `json:"startDate" validate:"required"`
+	EndDate    *time.Time      `json:"endDate"`
+	Count      int             `json:"count" validate:"omitempty,min=1"`
+}
+
+type UpdateRecurringExpenseRequest struct {
+	Kind       *string          `json:"kind" validate:"omitempty,oneof=expense income"`
+	Amount     *decimal.Decimal `json:"amount"`
+	Currency   *string          `json:"currency" validate:"omitempty,iso4217"`
+	Note       *string          `json:"note"`
+	CategoryID *uint            `json:"categoryId"`
+	TagIDs     *[]uint          `json:"tagIds"`
+	Frequency  *string          `json:"frequency" validate:"omitempty,oneof=daily weekly monthly yearly"`
+	Interval   *int             `json:"interval" validate:"omitempty,min=1"`
+	ByDay      *[]string        `json:"byDay"`
+	EndDate    *time.Time       `json:"endDate"`
+	Count      *int             `json:"count" validate:"omitempty,min=0"`
+}
+
+type PreviewRecurringExpenseRequest struct {
+	Count int `query:"count" validate:"omitempty,min=1,max=100"`
+}
+
+type SkipOccurrenceRequest struct {
+	Date time.Time `json:"date" validate:"required"`
+}
+
+type RecurringExpenseResponse struct {
+	ID        uint             `json:"id"`
+	Kind      Kind             `json:"kind"`
+	Amount    decimal.Decimal  `json:"amount"`
+	Currency  string           `json:"currency"`
+	Note      string           `json:"note"`
+	Category  CategoryResponse `json:"category"`
+	Tags      []TagResponse    `json:"tags"`
+	Frequency Frequency        `json:"frequency"`
+	Interval  int              `json:"interval"`
+	ByDay     []string         `json:"byDay"`
+	StartDate time.Time        `json:"startDate"`
+	EndDate   *time.Time       `json:"endDate"`
+	Count     int              `json:"count"`
+	IsPaused  bool             `json:"isPaused"`
+}
+
+func (RecurringExpenseResponse) FromEntity(recurring RecurringExpenseEntity) RecurringExpenseResponse {
+	tagResponses := []TagResponse{}
+	for _, tag := range recurring.Tags {
+		tagResponses = append(tagResponses, TagResponse{}.FromEntity(tag))
+	}
+
+	byDay := []string{}
+	if recurring.ByDay != "" {
+		byDay = strings.Split(recurring.ByDay, ",")
+	}
+
+	var endDate *time.Time
+	if recurring.EndDate != nil {
+		end := time.Unix(*recurring.EndDate, 0).UTC()
+		endDate = &end
+	}
+
+	return RecurringExpenseResponse{
+		ID:        recurring.ID,
+		Kind:      recurring.Kind,
+		Amount:    recurring.Amount,
+		Currency:  recurring.Currency,
+		Note:      recurring.Note,
+		Category:  CategoryResponse{}.FromEntity(recurring.Category),
+		Tags:      tagResponses,
+		Frequency: recurring.Frequency,
+		Interval:  recurring.Interval,
+		ByDay:     byDay,
+		StartDate: time.Unix(recurring.StartDate, 0).UTC(),
+		EndDate:   endDate,
+		Count:     recurring.Count,
+		IsPaused:  recurring.IsPaused,
+	}
+}
+
+type UpcomingOccurrence struct {
+	Recurring RecurringExpenseEntity
+	Date      time.Time
+}
+
+type OccurrenceResponse struct {
+	Date      time.Time `json:"date"`
+	IsSkipped bool      `json:"isSkipped"`
+	ExpenseID *uint     `json:"expenseId"`
+}
@@ -0,0 +1,76 @@
+package expense
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type RecurringExpenseEntity struct {
+	gorm.Model
+	UserID     uint            `gorm:"not null;index:idx_recurring_expenses_user"`
+	User       user.UserEntity `gorm:"foreignKey:UserID"`
+	Kind       Kind            `gorm:"type:varchar(10);not null;default:'expense'"`
+	Amount     decimal.Decimal `gorm:"type:decimal(15,2);not null"`
+	Currency   string          `gorm:"type:char(3);not null;default:'USD'"`
+	Note       string          `gorm:"type:text"`
+	CategoryID uint            `gorm:"not null"`
+	Category   CategoryEntity  `gorm:"foreignKey:CategoryID"`
+	Tags       []TagEntity     `gorm:"many2many:recurring_expenses_tags;"`
+	Frequency  Frequency       `gorm:"type:varchar(10);not null"`
+	Interval   int             `gorm:"not null;default:1"`
+	ByDay      string          `gorm:"type:varchar(20)"`
+	StartDate  int64           `gorm:"not null"`
+	EndDate    *int64
+	Count      int  `gorm:"not null;default:0"`
+	IsPaused   bool `gorm:"index;default:false"`
+}
+
+func (RecurringExpenseEntity) TableName() string {
+	return "recurring_expenses"
+}
+
+func (r *RecurringExpenseEntity) BeforeCreate(tx *gorm.DB) error {
+	if r.Kind == "" {
+		r.Kind = KindExpense
+	}
+
+	return fillDefaultCurrency(tx, r.UserID, &r.Currency)
+}
+
+func (r RecurringExpenseEntity) Schedule() Schedule {
+	byDay, _ := ParseWeekdays(strings.Split(r.ByDay, ","))
+	if r.ByDay == "" {
+		byDay = nil
+	}
+
+	schedule := Schedule{
+		Frequency: r.Frequency,
+		Interval:  r.Interval,
+		ByDay:     byDay,
+		Start:     time.Unix(r.StartDate, 0).UTC(),
+		Count:     r.Count,
+	}
+	if r.EndDate != nil {
+		until := time.Unix(*r.EndDate, 0).UTC()
+		schedule.Until = &until
+	}
+
+	return schedule
+}
+
+type RecurringOccurrenceEntity struct {
+	ID                 uint  `gorm:"primaryKey"`
+	RecurringExpenseID uint  `gorm:"not null;uniqueIndex:idx_recurring_occurrences_date"`
+	OccurrenceDate     int64 `gorm:"not null;uniqueIndex:idx_recurring_occurrences_date"`
+	ExpenseID          *uint
+	IsSkipped          bool `gorm:"default:false"`
+	CreatedAt          time.Time
+}
+
+func (RecurringOccurrenceEntity) TableName() string {
+	return "recurring_occurrences"
+}
@@ -0,0 +1,124 @@
+package expense
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RecurringExpenseRepository interface {
+	WithTx(tx *gorm.DB) RecurringExpenseRepository
+	GetByUser(userID uint) ([]RecurringExpenseEntity, error)
+	GetByIDAndUser(id uint, userID uint) (*RecurringExpenseEntity, error)
+	GetActive() ([]RecurringExpenseEntity, error)
+	IsOwner(id uint, userID uint) (bool, error)
+	Create(recurring *RecurringExpenseEntity) error
+	Update(recurring *RecurringExpenseEntity) error
+	UpdateTags(recurring *RecurringExpenseEntity, tags []TagEntity) error
+	Delete(id uint) error
+	GetOccurrences(recurringID uint) ([]RecurringOccurrenceEntity, error)
+	CreateOccurrence(occurrence *RecurringOccurrenceEntity) (bool, error)
+	UpdateOccurrence(occurrence *RecurringOccurrenceEntity) error
+}
+
+type recurringExpenseRepository struct {
+	db *gorm.DB
+}
+
+func NewRecurringExpenseRepository(db *gorm.DB) RecurringExpenseRepository {
+	return &recurringExpenseRepository{db: db}
+}
+
+func (r *recurringExpenseRepository) WithTx(tx *gorm.DB) RecurringExpenseRepository {
+	if tx == nil {
+		return r
+	}
+
+	return &recurringExpenseRepository{db: tx}
+}
+
+func (r *recurringExpenseRepository) GetByUser(userID uint) ([]RecurringExpenseEntity, error) {
+	var recurrings []RecurringExpenseEntity
+	if err := r.db.Preload("Category").
+		Preload("Tags").
+		Where("user_id = ?", userID).
+		Order("id").
+		Find(&recurrings).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return recurrings, nil
+}
+
+func (r *recurringExpenseRepository) GetByIDAndUser(id uint, userID uint) (*RecurringExpenseEntity, error) {
+	var recurring RecurringExpenseEntity
+	if err := r.db.Preload("Category").
+		Preload("Tags").
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		First(&recurring).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &recurring, nil
+}
+
+func (r *recurringExpenseRepository) GetActive() ([]RecurringExpenseEntity, error) {
+	var recurrings []RecurringExpenseEntity
+	if err := r.db.Preload("Tags").
+		Where("is_paused = ?", false).
+		Order("id").
+		Find(&recurrings).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return recurrings, nil
+}
+
+func (r *recurringExpenseRepository) IsOwner(id uint, userID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&RecurringExpenseEntity{}).Where("id = ?", id).Where("user_id = ?", userID).Count(&count).Error
+
+	return count > 0, err
+}
+
+func (r *recurringExpenseRepository) Create(recurring *RecurringExpenseEntity) error {
+	return r.db.Create(recurring).Error
+}
+
+func (r *recurringExpenseRepository) Update(recurring *RecurringExpenseEntity) error {
+	return r.db.Omit("Category", "Tags").Save(recurring).Error
+}
+
+func (r *recurringExpenseRepository) UpdateTags(recurring *RecurringExpenseEntity, tags []TagEntity) error {
+	return r.db.Model(recurring).Association("Tags").Replace(tags)
+}
+
+func (r *recurringExpenseRepository) Delete(id uint) error {
+	return r.db.Delete(&RecurringExpenseEntity{}, id).Error
+}
+
+func (r *recurringExpenseRepository) GetOccurrences(recurringID uint) ([]RecurringOccurrenceEntity, error) {
+	var occurrences []RecurringOccurrenceEntity
+	if err := r.db.Where("recurring_expense_id = ?", recurringID).
+		Order("occurrence_date").
+		Find(&occurrences).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return occurrences, nil
+}
+
+// CreateOccurrence reports false when the occurrence was already recorded, which keeps materialization idempotent.
+func (r *recurringExpenseRepository) CreateOccurrence(occurrence *RecurringOccurrenceEntity) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(occurrence)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *recurringExpenseRepository) UpdateOccurrence(occurrence *RecurringOccurrenceEntity) error {
+	return r.db.Save(occurrence).Error
+}
@@ -0,0 +1,151 @@
+package expense
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "daily"
+	FrequencyWeekly  Frequency = "weekly"
+	FrequencyMonthly Frequency = "monthly"
+	FrequencyYearly  Frequency = "yearly"
+)
+
+// maxScheduleSteps bounds how far a schedule is walked so a bad rule cannot loop forever.
+const maxScheduleSteps = 100000
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Schedule is an RRULE-like recurrence over calendar dates. All dates are midnight UTC.
+type Schedule struct {
+	Frequency Frequency
+	Interval  int
+	ByDay     []time.Weekday
+	Start     time.Time
+	Until     *time.Time
+	Count     int
+}
+
+func ParseWeekdays(codes []string) ([]time.Weekday, bool) {
+	days := []time.Weekday{}
+	for _, code := range codes {
+		day, ok := weekdayCodes[strings.ToUpper(strings.TrimSpace(code))]
+		if !ok {
+			return nil, false
+		}
+		if !slices.Contains(days, day) {
+			days = append(days, day)
+		}
+	}
+
+	return days, true
+}
+
+func FormatWeekdays(days []time.Weekday) []string {
+	codes := []string{}
+	for _, day := range days {
+		for code, d := range weekdayCodes {
+			if d == day {
+				codes = append(codes, code)
+			}
+		}
+	}
+
+	return codes
+}
+
+func TruncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Between returns the occurrences falling within [from, to], at most limit of them when limit > 0.
+func (s Schedule) Between(from time.Time, to time.Time, limit int) []time.Time {
+	result := []time.Time{}
+	s.walk(func(date time.Time) bool {
+		if date.After(to) {
+			return false
+		}
+		if !date.Before(from) {
+			result = append(result, date)
+		}
+		return limit <= 0 || len(result) < limit
+	})
+
+	return result
+}
+
+// walk calls fn with every occurrence in order until fn returns false or the schedule ends.
+func (s Schedule) walk(fn func(time.Time) bool) {
+	interval := max(s.Interval, 1)
+	start := TruncateToDate(s.Start)
+	emitted := 0
+
+	emit := func(date time.Time) bool {
+		if date.Before(start) {
+			return true
+		}
+		if s.Until != nil && date.After(*s.Until) {
+			return false
+		}
+		if s.Count > 0 && emitted >= s.Count {
+			return false
+		}
+		emitted++
+		return fn(date)
+	}
+
+	for step := 0; step < maxScheduleSteps; step++ {
+		switch s.Frequency {
+		case FrequencyDaily:
+			if !emit(start.AddDate(0, 0, step*interval)) {
+				return
+			}
+		case FrequencyWeekly:
+			days := s.ByDay
+			if len(days) == 0 {
+				days = []time.Weekday{start.Weekday()}
+			}
+			weekStart := start.AddDate(0, 0, -mondayOffset(start.Weekday())+step*interval*7)
+			for offset := range 7 {
+				date := weekStart.AddDate(0, 0, offset)
+				if slices.Contains(days, date.Weekday()) && !emit(date) {
+					return
+				}
+			}
+		case FrequencyMonthly:
+			if !emit(addMonthsClamped(start, step*interval)) {
+				return
+			}
+		case FrequencyYearly:
+			if !emit(addMonthsClamped(start, step*interval*12)) {
+				return
+			}
+		default:
+			return
+		}
+	}
+}
+
+func mondayOffset(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
+
+// addMonthsClamped keeps the day of month, falling back to the last day for shorter months.
+func addMonthsClamped(date time.Time, months int) time.Time {
+	firstOfMonth := time.Date(date.Year(), date.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	return firstOfMonth.AddDate(0, 0, min(date.Day(), lastDay)-1)
+}
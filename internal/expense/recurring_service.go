@@ -0,0 +1,411 @@
+package expense
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"gorm.io/gorm"
+)
+
+const defaultPreviewCount = 10
+
+type RecurringExpenseService interface {
+	GetRecurringExpenses(authUserID uint) ([]RecurringExpenseEntity, error)
+	GetRecurringExpenseByID(id uint, authUserID uint) (*RecurringExpenseEntity, error)
+	CreateRecurringExpense(authUserID uint, dto CreateRecurringExpenseRequest) (*RecurringExpenseEntity, error)
+	UpdateRecurringExpense(id uint, authUserID uint, dto UpdateRecurringExpenseRequest) error
+	DeleteRecurringExpense(id uint, authUserID uint) error
+	PreviewOccurrences(id uint, authUserID uint, dto PreviewRecurringExpenseRequest) ([]OccurrenceResponse, error)
+	SkipOccurrence(id uint, authUserID uint, dto SkipOccurrenceRequest) error
+	SetPaused(id uint, authUserID uint, paused bool) error
+	MaterializeDue(now time.Time) (int, error)
+	GetUpcoming(from time.Time, to time.Time) ([]UpcomingOccurrence, error)
+}
+
+type recurringExpenseService struct {
+	db              *gorm.DB
+	recurringRepo   RecurringExpenseRepository
+	expenseRepo     ExpenseRepository
+	revisionRepo    RevisionRepository
+	categoryService CategoryService
+	tagService      TagService
+}
+
+func NewRecurringExpenseService(
+	db *gorm.DB,
+	recurringRepo RecurringExpenseRepository,
+	expenseRepo ExpenseRepository,
+	revisionRepo RevisionRepository,
+	categoryService CategoryService,
+	tagService TagService,
+) RecurringExpenseService {
+	return &recurringExpenseService{
+		db:              db,
+		recurringRepo:   recurringRepo,
+		expenseRepo:     expenseRepo,
+		revisionRepo:    revisionRepo,
+		categoryService: categoryService,
+		tagService:      tagService,
+	}
+}
+
+func (s *recurringExpenseService) GetRecurringExpenses(authUserID uint) ([]RecurringExpenseEntity, error) {
+	return s.recurringRepo.GetByUser(authUserID)
+}
+
+func (s *recurringExpenseService) GetRecurringExpenseByID(id uint, authUserID uint) (*RecurringExpenseEntity, error) {
+	return s.recurringRepo.GetByIDAndUser(id, authUserID)
+}
+
+func (s *recurringExpenseService) CreateRecurringExpense(authUserID uint, dto CreateRecurringExpenseRequest) (*RecurringExpenseEntity, error) {
+	if !dto.Amount.IsPositive() {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	isOwner, err := s.categoryService.IsCategoryOwner(dto.CategoryID, authUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOwner {
+		return nil, apperror.ErrUnauthorized
+	}
+
+	tags, err := s.tagService.GetTagsByIDs(dto.TagIDs, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	byDay, ok := ParseWeekdays(dto.ByDay)
+	if !ok {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	recurring := &RecurringExpenseEntity{
+		UserID:     authUserID,
+		Kind:       Kind(dto.Kind),
+		Amount:     dto.Amount,
+		Currency:   dto.Currency,
+		Note:       dto.Note,
+		CategoryID: dto.CategoryID,
+		Tags:       tags,
+		Frequency:  Frequency(dto.Frequency),
+		Interval:   max(dto.Interval, 1),
+		ByDay:      strings.Join(FormatWeekdays(byDay), ","),
+		StartDate:  TruncateToDate(dto.StartDate).Unix(),
+		Count:      dto.Count,
+	}
+
+	if dto.EndDate != nil {
+		endDate := TruncateToDate(*dto.EndDate).Unix()
+		if endDate < recurring.StartDate {
+			return nil, apperror.ErrInvalidRequest
+		}
+		recurring.EndDate = &endDate
+	}
+
+	if err := s.recurringRepo.Create(recurring); err != nil {
+		return nil, err
+	}
+
+	return recurring, nil
+}
+
+func (s *recurringExpenseService) UpdateRecurringExpense(id uint, authUserID uint, dto UpdateRecurringExpenseRequest) error {
+	recurring, err := s.recurringRepo.GetByIDAndUser(id, authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	if dto.Kind != nil {
+		recurring.Kind = Kind(*dto.Kind)
+	}
+
+	if dto.Amount != nil {
+		if !dto.Amount.IsPositive() {
+			return apperror.ErrInvalidRequest
+		}
+		recurring.Amount = *dto.Amount
+	}
+
+	if dto.Currency != nil {
+		recurring.Currency = *dto.Currency
+	}
+
+	if dto.Note != nil {
+		recurring.Note = *dto.Note
+	}
+
+	if dto.CategoryID != nil {
+		isOwner, err := s.categoryService.IsCategoryOwner(*dto.CategoryID, authUserID)
+		if err != nil {
+			return err
+		}
+		if !isOwner {
+			return apperror.ErrUnauthorized
+		}
+
+		recurring.CategoryID = *dto.CategoryID
+	}
+
+	if dto.TagIDs != nil {
+		tags, err := s.tagService.GetTagsByIDs(*dto.TagIDs, authUserID)
+		if err != nil {
+			return err
+		}
+
+		recurring.Tags = tags
+	}
+
+	if dto.Frequency != nil {
+		recurring.Frequency = Frequency(*dto.Frequency)
+	}
+
+	if dto.Interval != nil {
+		recurring.Interval = *dto.Interval
+	}
+
+	if dto.ByDay != nil {
+		byDay, ok := ParseWeekdays(*dto.ByDay)
+		if !ok {
+			return apperror.ErrInvalidRequest
+		}
+
+		recurring.ByDay = strings.Join(FormatWeekdays(byDay), ",")
+	}
+
+	if dto.EndDate != nil {
+		endDate := TruncateToDate(*dto.EndDate).Unix()
+		if endDate < recurring.StartDate {
+			return apperror.ErrInvalidRequest
+		}
+		recurring.EndDate = &endDate
+	}
+
+	if dto.Count != nil {
+		recurring.Count = *dto.Count
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		recurringRepo := s.recurringRepo.WithTx(tx)
+
+		if err := recurringRepo.Update(recurring); err != nil {
+			return err
+		}
+
+		if err := recurringRepo.UpdateTags(recurring, recurring.Tags); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (s *recurringExpenseService) DeleteRecurringExpense(id uint, authUserID uint) error {
+	isOwner, err := s.recurringRepo.IsOwner(id, authUserID)
+	if err != nil {
+		return err
+	}
+	if !isOwner {
+		return apperror.ErrUnauthorized
+	}
+
+	return s.recurringRepo.Delete(id)
+}
+
+func (s *recurringExpenseService) PreviewOccurrences(id uint, authUserID uint, dto PreviewRecurringExpenseRequest) ([]OccurrenceResponse, error) {
+	recurring, err := s.recurringRepo.GetByIDAndUser(id, authUserID)
+	if err != nil {
+		return nil, apperror.ErrNotFound
+	}
+
+	recorded, err := s.recordedOccurrences(recurring.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	count := dto.Count
+	if count == 0 {
+		count = defaultPreviewCount
+	}
+
+	today := TruncateToDate(time.Now())
+	dates := recurring.Schedule().Between(today, today.AddDate(100, 0, 0), count)
+
+	occurrences := []OccurrenceResponse{}
+	for _, date := range dates {
+		occurrence := OccurrenceResponse{Date: date}
+		if o, ok := recorded[date.Unix()]; ok {
+			occurrence.IsSkipped = o.IsSkipped
+			occurrence.ExpenseID = o.ExpenseID
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+
+	return occurrences, nil
+}
+
+func (s *recurringExpenseService) SkipOccurrence(id uint, authUserID uint, dto SkipOccurrenceRequest) error {
+	recurring, err := s.recurringRepo.GetByIDAndUser(id, authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	date := TruncateToDate(dto.Date)
+	if len(recurring.Schedule().Between(date, date, 1)) == 0 {
+		return apperror.ErrInvalidRequest
+	}
+
+	inserted, err := s.recurringRepo.CreateOccurrence(&RecurringOccurrenceEntity{
+		RecurringExpenseID: recurring.ID,
+		OccurrenceDate:     date.Unix(),
+		IsSkipped:          true,
+	})
+	if err != nil {
+		return err
+	}
+	if !inserted {
+		return apperror.ErrRecordDuplication
+	}
+
+	return nil
+}
+
+func (s *recurringExpenseService) SetPaused(id uint, authUserID uint, paused bool) error {
+	recurring, err := s.recurringRepo.GetByIDAndUser(id, authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	recurring.IsPaused = paused
+
+	return s.recurringRepo.Update(recurring)
+}
+
+// MaterializeDue creates the expenses for every occurrence up to now that has not been recorded yet.
+func (s *recurringExpenseService) MaterializeDue(now time.Time) (int, error) {
+	recurrings, err := s.recurringRepo.GetActive()
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	var errs []error
+	today := TruncateToDate(now)
+
+	for _, recurring := range recurrings {
+		recorded, err := s.recordedOccurrences(recurring.ID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		schedule := recurring.Schedule()
+		for _, date := range schedule.Between(schedule.Start, today, 0) {
+			if _, ok := recorded[date.Unix()]; ok {
+				continue
+			}
+
+			ok, err := s.materialize(recurring, date)
+			if err != nil {
+				errs = append(errs, err)
+				break
+			}
+			if ok {
+				created++
+			}
+		}
+	}
+
+	return created, errors.Join(errs...)
+}
+
+// GetUpcoming lists the occurrences of every active recurring expense between from and to
+// that have been neither skipped nor created yet.
+func (s *recurringExpenseService) GetUpcoming(from time.Time, to time.Time) ([]UpcomingOccurrence, error) {
+	recurrings, err := s.recurringRepo.GetActive()
+	if err != nil {
+		return nil, err
+	}
+
+	upcoming := []UpcomingOccurrence{}
+	for _, recurring := range recurrings {
+		recorded, err := s.recordedOccurrences(recurring.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, date := range recurring.Schedule().Between(TruncateToDate(from), to, 0) {
+			if _, ok := recorded[date.Unix()]; !ok {
+				upcoming = append(upcoming, UpcomingOccurrence{Recurring: recurring, Date: date})
+			}
+		}
+	}
+
+	return upcoming, nil
+}
+
+func (s *recurringExpenseService) materialize(recurring RecurringExpenseEntity, date time.Time) (bool, error) {
+	created := false
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		recurringRepo := s.recurringRepo.WithTx(tx)
+		expenseRepo := s.expenseRepo.WithTx(tx)
+
+		occurrence := &RecurringOccurrenceEntity{
+			RecurringExpenseID: recurring.ID,
+			OccurrenceDate:     date.Unix(),
+		}
+		inserted, err := recurringRepo.CreateOccurrence(occurrence)
+		if err != nil {
+			return err
+		}
+		if !inserted {
+			return nil
+		}
+
+		expense := &ExpenseEntity{
+			UserID:     recurring.UserID,
+			Date:       date.Unix(),
+			Kind:       recurring.Kind,
+			Amount:     recurring.Amount,
+			Currency:   recurring.Currency,
+			Note:       recurring.Note,
+			CategoryID: recurring.CategoryID,
+			Tags:       recurring.Tags,
+		}
+		if err := expenseRepo.Create(expense); err != nil {
+			return err
+		}
+
+		if err := RecordRevision(s.revisionRepo.WithTx(tx), *expense, nil, recurring.UserID, RevisionActionCreate); err != nil {
+			return err
+		}
+
+		occurrence.ExpenseID = &expense.ID
+		if err := recurringRepo.UpdateOccurrence(occurrence); err != nil {
+			return err
+		}
+
+		created = true
+
+		return nil
+	})
+
+	return created, err
+}
+
+func (s *recurringExpenseService) recordedOccurrences(recurringID uint) (map[int64]RecurringOccurrenceEntity, error) {
+	occurrences, err := s.recurringRepo.GetOccurrences(recurringID)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := map[int64]RecurringOccurrenceEntity{}
+	for _, occurrence := range occurrences {
+		recorded[occurrence.OccurrenceDate] = occurrence
+	}
+
+	return recorded, nil
+}
@@ -0,0 +1,129 @@
+package expense_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestMaterializeDue(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		startDate := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+		dueDate := time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)
+		recurring := expense.RecurringExpenseEntity{
+			Model:      gorm.Model{ID: 5},
+			UserID:     11,
+			Amount:     decimal.NewFromInt(100),
+			Note:       "rent",
+			CategoryID: 2,
+			Frequency:  expense.FrequencyMonthly,
+			Interval:   1,
+			StartDate:  startDate.Unix(),
+		}
+		recorded := []expense.RecurringOccurrenceEntity{
+			{ID: 1, RecurringExpenseID: recurring.ID, OccurrenceDate: startDate.Unix()},
+		}
+		var expenseID uint = 9
+
+		db := testutil.SetupDB()
+
+		mockRecurringRepo := new(mocks.MockRecurringExpenseRepository)
+		mockRecurringRepo.On("GetActive").Return([]expense.RecurringExpenseEntity{recurring}, nil).Once()
+		mockRecurringRepo.On("GetOccurrences", recurring.ID).Return(recorded, nil).Once()
+		mockRecurringRepo.On("WithTx", mock.Anything).Return(mockRecurringRepo).Once()
+		mockRecurringRepo.On("CreateOccurrence", mock.MatchedBy(func(o *expense.RecurringOccurrenceEntity) bool {
+			return o.RecurringExpenseID == recurring.ID && o.OccurrenceDate == dueDate.Unix()
+		})).Return(true, nil).Once()
+		mockRecurringRepo.On("UpdateOccurrence", mock.MatchedBy(func(o *expense.RecurringOccurrenceEntity) bool {
+			return o.ExpenseID != nil && *o.ExpenseID == expenseID
+		})).Return(nil).Once()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Create", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.UserID == recurring.UserID && e.Date == dueDate.Unix() && e.Amount.Equal(recurring.Amount)
+		})).Run(func(args mock.Arguments) {
+			args.Get(0).(*expense.ExpenseEntity).ID = expenseID
+		}).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.ExpenseID == expenseID && r.ActorID == recurring.UserID && r.Action == expense.RevisionActionCreate
+		})).Return(nil).Once()
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockTagService := new(mocks.MockTagService)
+
+		service := expense.NewRecurringExpenseService(db, mockRecurringRepo, mockExpenseRepo, mockRevisionRepo, mockCategoryService, mockTagService)
+		created, err := service.MaterializeDue(time.Date(2025, 3, 5, 10, 0, 0, 0, time.UTC))
+
+		assert.Equal(t, 1, created)
+		assert.NoError(t, err)
+		mockRecurringRepo.AssertExpectations(t)
+		mockExpenseRepo.AssertExpectations(t)
+		mockRevisionRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_nothing_due", func(t *testing.T) {
+		startDate := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+		recurring := expense.RecurringExpenseEntity{
+			Model:     gorm.Model{ID: 5},
+			UserID:    11,
+			Frequency: expense.FrequencyWeekly,
+			Interval:  1,
+			ByDay:     "MO,WE",
+			StartDate: startDate.Unix(),
+		}
+		recorded := []expense.RecurringOccurrenceEntity{
+			{ID: 1, RecurringExpenseID: recurring.ID, OccurrenceDate: startDate.Unix()},
+			{ID: 2, RecurringExpenseID: recurring.ID, OccurrenceDate: startDate.AddDate(0, 0, 2).Unix(), IsSkipped: true},
+		}
+
+		db := testutil.SetupDB()
+
+		mockRecurringRepo := new(mocks.MockRecurringExpenseRepository)
+		mockRecurringRepo.On("GetActive").Return([]expense.RecurringExpenseEntity{recurring}, nil).Once()
+		mockRecurringRepo.On("GetOccurrences", recurring.ID).Return(recorded, nil).Once()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockTagService := new(mocks.MockTagService)
+
+		service := expense.NewRecurringExpenseService(db, mockRecurringRepo, mockExpenseRepo, new(mocks.MockRevisionRepository), mockCategoryService, mockTagService)
+		created, err := service.MaterializeDue(time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC))
+
+		assert.Equal(t, 0, created)
+		assert.NoError(t, err)
+		mockRecurringRepo.AssertExpectations(t)
+		mockExpenseRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("error_get_active", func(t *testing.T) {
+		expectedErr := errors.New("db error")
+
+		db := testutil.SetupDB()
+
+		mockRecurringRepo := new(mocks.MockRecurringExpenseRepository)
+		mockRecurringRepo.On("GetActive").Return(nil, expectedErr).Once()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockTagService := new(mocks.MockTagService)
+
+		service := expense.NewRecurringExpenseService(db, mockRecurringRepo, mockExpenseRepo, new(mocks.MockRevisionRepository), mockCategoryService, mockTagService)
+		created, err := service.MaterializeDue(time.Now())
+
+		assert.Equal(t, 0, created)
+		assert.Equal(t, expectedErr, err)
+		mockRecurringRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,248 @@
+package expense
+
+import (
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/shopspring/decimal"
+)
+
+// revisionFieldNames lists the fields a revision tracks, in the order changes are listed.
+var revisionFieldNames = []string{"date", "kind", "amount", "currency", "accountId", "merchantId", "note", "categoryId", "tagIds", "splits"}
+
+// revisionFields writes the tracked fields of an expense as strings: the date as a unix timestamp,
+// a missing account as an empty string, the tags as their sorted, comma-separated ids and the splits as JSON.
+func revisionFields(expense ExpenseEntity) map[string]string {
+	accountID := ""
+	if expense.AccountID != nil {
+		accountID = strconv.FormatUint(uint64(*expense.AccountID), 10)
+	}
+
+	merchantID := ""
+	if expense.MerchantID != nil {
+		merchantID = strconv.FormatUint(uint64(*expense.MerchantID), 10)
+	}
+
+	tagIDs := []uint{}
+	for _, tag := range expense.Tags {
+		tagIDs = append(tagIDs, tag.ID)
+	}
+	slices.Sort(tagIDs)
+
+	splits := ""
+	if len(expense.Splits) > 0 {
+		data, _ := json.Marshal(splitRequests(expense.Splits))
+		splits = string(data)
+	}
+
+	return map[string]string{
+		"date":       strconv.FormatInt(expense.Date, 10),
+		"kind":       string(expense.Kind),
+		"amount":     expense.Amount.String(),
+		"currency":   expense.Currency,
+		"accountId":  accountID,
+		"merchantId": merchantID,
+		"note":       expense.Note,
+		"categoryId": strconv.FormatUint(uint64(expense.CategoryID), 10),
+		"tagIds":     joinIDs(tagIDs),
+		"splits":     splits,
+	}
+}
+
+func diffRevisionFields(before map[string]string, after map[string]string) FieldChanges {
+	changes := FieldChanges{}
+	for _, field := range revisionFieldNames {
+		if before[field] != after[field] {
+			changes = append(changes, FieldChange{Field: field, Before: before[field], After: after[field]})
+		}
+	}
+
+	return changes
+}
+
+// RecordRevision writes the revision for one change to an expense. before holds the tracked fields from ahead of the
+// change, nil for a new expense. Creates, updates and reverts record the fields that differ and are skipped when none
+// does; deletes and restores record no fields.
+func RecordRevision(revisionRepo RevisionRepository, expense ExpenseEntity, before map[string]string, actorID uint, action RevisionAction) error {
+	changes := FieldChanges{}
+	if action != RevisionActionDelete && action != RevisionActionRestore {
+		if before == nil {
+			before = map[string]string{}
+		}
+		changes = diffRevisionFields(before, revisionFields(expense))
+		if len(changes) == 0 {
+			return nil
+		}
+	}
+
+	return revisionRepo.Create(&ExpenseRevisionEntity{
+		ExpenseID: expense.ID,
+		ActorID:   actorID,
+		Action:    action,
+		Changes:   changes,
+	})
+}
+
+// recordRevisions runs a change made straight in SQL, such as a category or tag merge, and writes an update revision
+// for each of the given expenses it changed, comparing them as loaded before and after.
+func recordRevisions(expenseRepo ExpenseRepository, revisionRepo RevisionRepository, ids []uint, actorID uint, change func() error) error {
+	if len(ids) == 0 {
+		return change()
+	}
+
+	expenses, err := expenseRepo.GetByIDs(ids)
+	if err != nil {
+		return err
+	}
+
+	before := map[uint]map[string]string{}
+	for _, expense := range expenses {
+		before[expense.ID] = revisionFields(expense)
+	}
+
+	if err := change(); err != nil {
+		return err
+	}
+
+	expenses, err = expenseRepo.GetByIDs(ids)
+	if err != nil {
+		return err
+	}
+
+	for _, expense := range expenses {
+		if err := RecordRevision(revisionRepo, expense, before[expense.ID], actorID, RevisionActionUpdate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// revisionState replays the revisions up to and including the given one and returns the fields as they were then.
+// Fields no revision touched are left out.
+func revisionState(revisions []ExpenseRevisionEntity, revisionID uint) (map[string]string, bool) {
+	state := map[string]string{}
+	for _, revision := range revisions {
+		for _, change := range revision.Changes {
+			state[change.Field] = change.After
+		}
+		if revision.ID == revisionID {
+			return state, true
+		}
+	}
+
+	return nil, false
+}
+
+// revertRequest turns the fields of a past state that differ from the current ones into an update.
+func revertRequest(state map[string]string, current map[string]string) (UpdateExpenseRequest, error) {
+	dto := UpdateExpenseRequest{}
+	for field, value := range state {
+		if current[field] == value {
+			continue
+		}
+
+		switch field {
+		case "date":
+			unix, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return dto, apperror.ErrInvalidRequest
+			}
+			date := time.Unix(unix, 0)
+			dto.Date = &date
+		case "kind":
+			dto.Kind = &value
+		case "amount":
+			amount, err := decimal.NewFromString(value)
+			if err != nil {
+				return dto, apperror.ErrInvalidRequest
+			}
+			dto.Amount = &amount
+		case "currency":
+			dto.Currency = &value
+		case "accountId":
+			accountID, err := parseID(value)
+			if err != nil {
+				return dto, apperror.ErrInvalidRequest
+			}
+			dto.AccountID = &accountID
+		case "merchantId":
+			merchantID, err := parseID(value)
+			if err != nil {
+				return dto, apperror.ErrInvalidRequest
+			}
+			dto.MerchantID = &merchantID
+		case "note":
+			dto.Note = &value
+		case "categoryId":
+			categoryID, err := parseID(value)
+			if err != nil {
+				return dto, apperror.ErrInvalidRequest
+			}
+			dto.CategoryID = &categoryID
+		case "tagIds":
+			tagIDs := []uint{}
+			for _, part := range strings.Split(value, ",") {
+				if part == "" {
+					continue
+				}
+				tagID, err := parseID(part)
+				if err != nil {
+					return dto, apperror.ErrInvalidRequest
+				}
+				tagIDs = append(tagIDs, tagID)
+			}
+			dto.TagIDs = &tagIDs
+		case "splits":
+			splits := []SplitRequest{}
+			if value != "" {
+				if err := json.Unmarshal([]byte(value), &splits); err != nil {
+					return dto, apperror.ErrInvalidRequest
+				}
+			}
+			dto.Splits = &splits
+		}
+	}
+
+	return dto, nil
+}
+
+// splitRequests turns stored splits back into the request that would create them, with sorted tag ids.
+func splitRequests(splits []ExpenseSplitEntity) []SplitRequest {
+	requests := make([]SplitRequest, len(splits))
+	for i, split := range splits {
+		tagIDs := []uint{}
+		for _, tag := range split.Tags {
+			tagIDs = append(tagIDs, tag.ID)
+		}
+		slices.Sort(tagIDs)
+
+		requests[i] = SplitRequest{Amount: split.Amount, CategoryID: split.CategoryID, Note: split.Note, TagIDs: tagIDs}
+	}
+
+	return requests
+}
+
+func joinIDs(ids []uint) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatUint(uint64(id), 10)
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// parseID reads an id written by revisionFields, where an empty string stands for none.
+func parseID(value string) (uint, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseUint(value, 10, 64)
+
+	return uint(id), err
+}
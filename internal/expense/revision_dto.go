@@ -0,0 +1,26 @@
+package expense
+
+import "time"
+
+type ExpenseRevisionResponse struct {
+	ID        uint           `json:"id"`
+	ExpenseID uint           `json:"expenseId"`
+	ActorID   uint           `json:"actorId"`
+	Action    RevisionAction `json:"action"`
+	Changes   []FieldChange  `json:"changes"`
+	CreatedAt time.Time      `json:"createdAt"`
+}
+
+func (ExpenseRevisionResponse) FromEntity(revision ExpenseRevisionEntity) ExpenseRevisionResponse {
+	changes := []FieldChange{}
+	changes = append(changes, revision.Changes...)
+
+	return ExpenseRevisionResponse{
+		ID:        revision.ID,
+		ExpenseID: revision.ExpenseID,
+		ActorID:   revision.ActorID,
+		Action:    revision.Action,
+		Changes:   changes,
+		CreatedAt: revision.CreatedAt,
+	}
+}
@@ -0,0 +1,66 @@
+package expense
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type RevisionAction string
+
+const (
+	RevisionActionCreate  RevisionAction = "create"
+	RevisionActionUpdate  RevisionAction = "update"
+	RevisionActionDelete  RevisionAction = "delete"
+	RevisionActionRevert  RevisionAction = "revert"
+	RevisionActionRestore RevisionAction = "restore"
+)
+
+// ExpenseRevisionEntity records one change to an expense: who made it, when, and the fields it changed.
+// Revisions are never edited, so they carry no UpdatedAt or DeletedAt.
+type ExpenseRevisionEntity struct {
+	ID        uint           `gorm:"primarykey"`
+	CreatedAt time.Time      `gorm:"index"`
+	ExpenseID uint           `gorm:"not null;index:idx_expense_revisions_expense"`
+	ActorID   uint           `gorm:"not null"`
+	Action    RevisionAction `gorm:"type:varchar(10);not null"`
+	Changes   FieldChanges   `gorm:"type:jsonb;not null"`
+}
+
+func (ExpenseRevisionEntity) TableName() string {
+	return "expense_revisions"
+}
+
+// FieldChange holds a field's value before and after a change, both written the way revisionFields writes them.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+type FieldChanges []FieldChange
+
+func (c *FieldChanges) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*c = FieldChanges{}
+		return nil
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	}
+
+	return fmt.Errorf("cannot scan %T into FieldChanges", value)
+}
+
+func (c FieldChanges) Value() (driver.Value, error) {
+	if c == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(c)
+
+	return string(data), err
+}
@@ -0,0 +1,42 @@
+package expense
+
+import "gorm.io/gorm"
+
+type RevisionRepository interface {
+	WithTx(tx *gorm.DB) RevisionRepository
+	GetByExpense(expenseID uint) ([]ExpenseRevisionEntity, error)
+	Create(revision *ExpenseRevisionEntity) error
+}
+
+type revisionRepository struct {
+	db *gorm.DB
+}
+
+func NewRevisionRepository(db *gorm.DB) RevisionRepository {
+	return &revisionRepository{db: db}
+}
+
+func (r *revisionRepository) WithTx(tx *gorm.DB) RevisionRepository {
+	if tx == nil {
+		return r
+	}
+
+	return &revisionRepository{db: tx}
+}
+
+// GetByExpense returns an expense's revisions oldest first.
+func (r *revisionRepository) GetByExpense(expenseID uint) ([]ExpenseRevisionEntity, error) {
+	var revisions []ExpenseRevisionEntity
+	if err := r.db.Where("expense_id = ?", expenseID).
+		Order("id").
+		Find(&revisions).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+func (r *revisionRepository) Create(revision *ExpenseRevisionEntity) error {
+	return r.db.Create(revision).Error
+}
@@ -0,0 +1,108 @@
+package expense
+
+import (
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+type CreateRuleRequest struct {
+	Name           string           `json:"name" validate:"required"`
+	Priority       int              `json:"priority"`
+	Enabled        *bool            `json:"enabled"`
+	StopProcessing bool             `json:"stopProcessing"`
+	NoteContains   string           `json:"noteContains"`
+	NotePattern    string           `json:"notePattern"`
+	AmountMin      *decimal.Decimal `json:"amountMin"`
+	AmountMax      *decimal.Decimal `json:"amountMax"`
+	ByDay          []string         `json:"byDay"`
+	AccountID      *uint            `json:"accountId"`
+	SetCategoryID  *uint            `json:"setCategoryId"`
+	SetNote        *string          `json:"setNote"`
+	AddTagIDs      []uint           `json:"addTagIds"`
+}
+
+// UpdateRuleRequest changes only the fields given; a zero id or an empty string clears that condition or action.
+type UpdateRuleRequest struct {
+	Name           *string          `json:"name"`
+	Priority       *int             `json:"priority"`
+	Enabled        *bool            `json:"enabled"`
+	StopProcessing *bool            `json:"stopProcessing"`
+	NoteContains   *string          `json:"noteContains"`
+	NotePattern    *string          `json:"notePattern"`
+	AmountMin      *decimal.Decimal `json:"amountMin"`
+	AmountMax      *decimal.Decimal `json:"amountMax"`
+	ByDay          *[]string        `json:"byDay"`
+	AccountID      *uint            `json:"accountId"`
+	SetCategoryID  *uint            `json:"setCategoryId"`
+	SetNote        *string          `json:"setNote"`
+	AddTagIDs      *[]uint          `json:"addTagIds"`
+}
+
+type ApplyRuleRequest struct {
+	Preview bool `query:"preview"`
+}
+
+// RuleChange is what applying a rule does, or would do, to one expense.
+type RuleChange struct {
+	ExpenseID      uint   `json:"expenseId"`
+	FromCategoryID uint   `json:"fromCategoryId"`
+	ToCategoryID   uint   `json:"toCategoryId"`
+	FromNote       string `json:"fromNote"`
+	ToNote         string `json:"toNote"`
+	AddedTagIDs    []uint `json:"addedTagIds"`
+}
+
+// RuleApplyResult counts the expenses a rule matched and changed; Changes lists at most the first hundred.
+type RuleApplyResult struct {
+	Preview bool         `json:"preview"`
+	Matched int          `json:"matched"`
+	Changed int          `json:"changed"`
+	Changes []RuleChange `json:"changes"`
+}
+
+type RuleResponse struct {
+	ID             uint             `json:"id"`
+	Name           string           `json:"name"`
+	Priority       int              `json:"priority"`
+	Enabled        bool             `json:"enabled"`
+	StopProcessing bool             `json:"stopProcessing"`
+	NoteContains   string           `json:"noteContains"`
+	NotePattern    string           `json:"notePattern"`
+	AmountMin      *decimal.Decimal `json:"amountMin"`
+	AmountMax      *decimal.Decimal `json:"amountMax"`
+	ByDay          []string         `json:"byDay"`
+	AccountID      *uint            `json:"accountId"`
+	SetCategoryID  *uint            `json:"setCategoryId"`
+	SetNote        *string          `json:"setNote"`
+	AddTags        []TagResponse    `json:"addTags"`
+}
+
+func (RuleResponse) FromEntity(rule RuleEntity) RuleResponse {
+	tags := make([]TagResponse, len(rule.AddTags))
+	for i, tag := range rule.AddTags {
+		tags[i] = TagResponse{}.FromEntity(tag)
+	}
+
+	byDay := []string{}
+	if rule.ByDay != "" {
+		byDay = strings.Split(rule.ByDay, ",")
+	}
+
+	return RuleResponse{
+		ID:             rule.ID,
+		Name:           rule.Name,
+		Priority:       rule.Priority,
+		Enabled:        rule.Enabled,
+		StopProcessing: rule.StopProcessing,
+		NoteContains:   rule.NoteContains,
+		NotePattern:    rule.NotePattern,
+		AmountMin:      rule.AmountMin,
+		AmountMax:      rule.AmountMax,
+		ByDay:          byDay,
+		AccountID:      rule.AccountID,
+		SetCategoryID:  rule.SetCategoryID,
+		SetNote:        rule.SetNote,
+		AddTags:        tags,
+	}
+}
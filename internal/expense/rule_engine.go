@@ -0,0 +1,92 @@
+package expense
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// NotePlaceholder in a rule's new note stands for the note it replaces.
+const NotePlaceholder = "{note}"
+
+// RuleSubject is the part of an expense that rules look at and change.
+type RuleSubject struct {
+	Date       time.Time
+	Amount     decimal.Decimal
+	Note       string
+	AccountID  *uint
+	CategoryID uint
+	Tags       []TagEntity
+}
+
+func (r RuleEntity) Matches(subject RuleSubject) bool {
+	if r.NoteContains != "" && !strings.Contains(strings.ToLower(subject.Note), strings.ToLower(r.NoteContains)) {
+		return false
+	}
+
+	if r.NotePattern != "" {
+		matched, err := regexp.MatchString(r.NotePattern, subject.Note)
+		if err != nil || !matched {
+			return false
+		}
+	}
+
+	if r.AmountMin != nil && subject.Amount.LessThan(*r.AmountMin) {
+		return false
+	}
+
+	if r.AmountMax != nil && subject.Amount.GreaterThan(*r.AmountMax) {
+		return false
+	}
+
+	if r.ByDay != "" {
+		days, _ := ParseWeekdays(strings.Split(r.ByDay, ","))
+		if !slices.Contains(days, subject.Date.Weekday()) {
+			return false
+		}
+	}
+
+	if r.AccountID != nil && (subject.AccountID == nil || *subject.AccountID != *r.AccountID) {
+		return false
+	}
+
+	return true
+}
+
+func (r RuleEntity) Apply(subject *RuleSubject) {
+	if r.SetCategoryID != nil {
+		subject.CategoryID = *r.SetCategoryID
+	}
+
+	for _, tag := range r.AddTags {
+		if !slices.ContainsFunc(subject.Tags, func(t TagEntity) bool { return t.ID == tag.ID }) {
+			subject.Tags = append(subject.Tags, tag)
+		}
+	}
+
+	if r.SetNote != nil {
+		subject.Note = strings.ReplaceAll(*r.SetNote, NotePlaceholder, subject.Note)
+	}
+}
+
+// ApplyRules runs the enabled rules in the order given and returns the ids of those that matched.
+func ApplyRules(rules []RuleEntity, subject *RuleSubject) []uint {
+	matched := []uint{}
+	for _, rule := range rules {
+		if !rule.Enabled || !rule.Matches(*subject) {
+			continue
+		}
+
+		rule.Apply(subject)
+		matched = append(matched, rule.ID)
+
+		if rule.StopProcessing {
+			break
+		}
+	}
+
+	return matched
+}
@@ -0,0 +1,31 @@
+package expense
+
+import (
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// RuleEntity files expenses automatically. Every condition that is set has to match,
+// then the actions set the category, add tags and rewrite the note.
+// Rules run by ascending priority; StopProcessing keeps later rules from running once this one matched.
+type RuleEntity struct {
+	gorm.Model
+	UserID         uint             `gorm:"not null;index:idx_rules_user_priority"`
+	Name           string           `gorm:"not null"`
+	Priority       int              `gorm:"not null;default:0;index:idx_rules_user_priority"`
+	Enabled        bool             `gorm:"not null"`
+	StopProcessing bool             `gorm:"not null;default:false"`
+	NoteContains   string           `gorm:"type:text"`
+	NotePattern    string           `gorm:"type:text"`
+	AmountMin      *decimal.Decimal `gorm:"type:decimal(15,2)"`
+	AmountMax      *decimal.Decimal `gorm:"type:decimal(15,2)"`
+	ByDay          string           `gorm:"type:varchar(20)"`
+	AccountID      *uint
+	SetCategoryID  *uint
+	SetNote        *string     `gorm:"type:text"`
+	AddTags        []TagEntity `gorm:"many2many:rules_tags;"`
+}
+
+func (RuleEntity) TableName() string {
+	return "rules"
+}
@@ -0,0 +1,87 @@
+package expense
+
+import "gorm.io/gorm"
+
+type RuleRepository interface {
+	WithTx(tx *gorm.DB) RuleRepository
+	GetByUser(userID uint) ([]RuleEntity, error)
+	GetEnabledByUser(userID uint) ([]RuleEntity, error)
+	GetByIDAndUser(id uint, userID uint) (*RuleEntity, error)
+	Create(rule *RuleEntity) error
+	Update(rule *RuleEntity) error
+	UpdateTags(rule *RuleEntity, tags []TagEntity) error
+	Delete(id uint) error
+}
+
+type ruleRepository struct {
+	db *gorm.DB
+}
+
+func NewRuleRepository(db *gorm.DB) RuleRepository {
+	return &ruleRepository{db: db}
+}
+
+func (r *ruleRepository) WithTx(tx *gorm.DB) RuleRepository {
+	if tx == nil {
+		return r
+	}
+
+	return &ruleRepository{db: tx}
+}
+
+// GetByUser returns the user's rules in the order they run.
+func (r *ruleRepository) GetByUser(userID uint) ([]RuleEntity, error) {
+	var rules []RuleEntity
+	if err := r.db.Preload("AddTags").
+		Where("user_id = ?", userID).
+		Order("priority, id").
+		Find(&rules).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (r *ruleRepository) GetEnabledByUser(userID uint) ([]RuleEntity, error) {
+	var rules []RuleEntity
+	if err := r.db.Preload("AddTags").
+		Where("user_id = ?", userID).
+		Where("enabled = ?", true).
+		Order("priority, id").
+		Find(&rules).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (r *ruleRepository) GetByIDAndUser(id uint, userID uint) (*RuleEntity, error) {
+	var rule RuleEntity
+	if err := r.db.Preload("AddTags").
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		First(&rule).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func (r *ruleRepository) Create(rule *RuleEntity) error {
+	return r.db.Create(rule).Error
+}
+
+func (r *ruleRepository) Update(rule *RuleEntity) error {
+	return r.db.Omit("AddTags").Save(rule).Error
+}
+
+func (r *ruleRepository) UpdateTags(rule *RuleEntity, tags []TagEntity) error {
+	return r.db.Model(rule).Association("AddTags").Replace(tags)
+}
+
+func (r *ruleRepository) Delete(id uint) error {
+	return r.db.Delete(&RuleEntity{}, id).Error
+}
@@ -0,0 +1,425 @@
+package expense
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/account"
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	"gorm.io/gorm"
+)
+
+const (
+	ruleApplyBatchSize   = 500
+	ruleChangePreviewMax = 100
+)
+
+type RuleService interface {
+	GetRules(authUserID uint) ([]RuleEntity, error)
+	GetRuleByID(id uint, authUserID uint) (*RuleEntity, error)
+	CreateRule(authUserID uint, dto CreateRuleRequest) (*RuleEntity, error)
+	UpdateRule(id uint, authUserID uint, dto UpdateRuleRequest) error
+	DeleteRule(id uint, authUserID uint) error
+	ApplyRule(id uint, authUserID uint, dto ApplyRuleRequest) (*RuleApplyResult, error)
+	Evaluate(authUserID uint, subject *RuleSubject) error
+}
+
+type ruleService struct {
+	db              *gorm.DB
+	ruleRepo        RuleRepository
+	expenseRepo     ExpenseRepository
+	revisionRepo    RevisionRepository
+	categoryService CategoryService
+	tagService      TagService
+	userService     user.UserService
+	accountService  account.AccountService
+}
+
+func NewRuleService(
+	db *gorm.DB,
+	ruleRepo RuleRepository,
+	expenseRepo ExpenseRepository,
+	revisionRepo RevisionRepository,
+	categoryService CategoryService,
+	tagService TagService,
+	userService user.UserService,
+	accountService account.AccountService,
+) RuleService {
+	return &ruleService{
+		db:              db,
+		ruleRepo:        ruleRepo,
+		expenseRepo:     expenseRepo,
+		revisionRepo:    revisionRepo,
+		categoryService: categoryService,
+		tagService:      tagService,
+		userService:     userService,
+		accountService:  accountService,
+	}
+}
+
+func (s *ruleService) GetRules(authUserID uint) ([]RuleEntity, error) {
+	return s.ruleRepo.GetByUser(authUserID)
+}
+
+func (s *ruleService) GetRuleByID(id uint, authUserID uint) (*RuleEntity, error) {
+	return s.ruleRepo.GetByIDAndUser(id, authUserID)
+}
+
+func (s *ruleService) CreateRule(authUserID uint, dto CreateRuleRequest) (*RuleEntity, error) {
+	tags, err := s.getTags(dto.AddTagIDs, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	byDay, ok := ParseWeekdays(dto.ByDay)
+	if !ok {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	enabled := true
+	if dto.Enabled != nil {
+		enabled = *dto.Enabled
+	}
+
+	rule := &RuleEntity{
+		UserID:         authUserID,
+		Name:           dto.Name,
+		Priority:       dto.Priority,
+		Enabled:        enabled,
+		StopProcessing: dto.StopProcessing,
+		NoteContains:   dto.NoteContains,
+		NotePattern:    dto.NotePattern,
+		AmountMin:      dto.AmountMin,
+		AmountMax:      dto.AmountMax,
+		ByDay:          strings.Join(FormatWeekdays(byDay), ","),
+		AccountID:      dto.AccountID,
+		SetCategoryID:  dto.SetCategoryID,
+		SetNote:        dto.SetNote,
+		AddTags:        tags,
+	}
+	if err := s.validate(rule, authUserID); err != nil {
+		return nil, err
+	}
+
+	if err := s.ruleRepo.Create(rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (s *ruleService) UpdateRule(id uint, authUserID uint, dto UpdateRuleRequest) error {
+	rule, err := s.ruleRepo.GetByIDAndUser(id, authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	if dto.Name != nil {
+		rule.Name = *dto.Name
+	}
+
+	if dto.Priority != nil {
+		rule.Priority = *dto.Priority
+	}
+
+	if dto.Enabled != nil {
+		rule.Enabled = *dto.Enabled
+	}
+
+	if dto.StopProcessing != nil {
+		rule.StopProcessing = *dto.StopProcessing
+	}
+
+	if dto.NoteContains != nil {
+		rule.NoteContains = *dto.NoteContains
+	}
+
+	if dto.NotePattern != nil {
+		rule.NotePattern = *dto.NotePattern
+	}
+
+	if dto.AmountMin != nil {
+		rule.AmountMin = dto.AmountMin
+	}
+
+	if dto.AmountMax != nil {
+		rule.AmountMax = dto.AmountMax
+	}
+
+	if dto.ByDay != nil {
+		byDay, ok := ParseWeekdays(*dto.ByDay)
+		if !ok {
+			return apperror.ErrInvalidRequest
+		}
+		rule.ByDay = strings.Join(FormatWeekdays(byDay), ",")
+	}
+
+	if dto.AccountID != nil {
+		rule.AccountID = dto.AccountID
+		if *dto.AccountID == 0 {
+			rule.AccountID = nil
+		}
+	}
+
+	if dto.SetCategoryID != nil {
+		rule.SetCategoryID = dto.SetCategoryID
+		if *dto.SetCategoryID == 0 {
+			rule.SetCategoryID = nil
+		}
+	}
+
+	if dto.SetNote != nil {
+		rule.SetNote = dto.SetNote
+		if *dto.SetNote == "" {
+			rule.SetNote = nil
+		}
+	}
+
+	if dto.AddTagIDs != nil {
+		tags, err := s.getTags(*dto.AddTagIDs, authUserID)
+		if err != nil {
+			return err
+		}
+		rule.AddTags = tags
+	}
+
+	if err := s.validate(rule, authUserID); err != nil {
+		return err
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		ruleRepo := s.ruleRepo.WithTx(tx)
+
+		if err := ruleRepo.Update(rule); err != nil {
+			return err
+		}
+
+		return ruleRepo.UpdateTags(rule, rule.AddTags)
+	})
+}
+
+func (s *ruleService) DeleteRule(id uint, authUserID uint) error {
+	if _, err := s.ruleRepo.GetByIDAndUser(id, authUserID); err != nil {
+		return apperror.ErrNotFound
+	}
+
+	return s.ruleRepo.Delete(id)
+}
+
+// ApplyRule runs one rule over the user's existing expenses, whether or not they already have a category.
+// Weekdays are judged in the user's timezone. A preview reports the changes without saving them.
+func (s *ruleService) ApplyRule(id uint, authUserID uint, dto ApplyRuleRequest) (*RuleApplyResult, error) {
+	rule, err := s.ruleRepo.GetByIDAndUser(id, authUserID)
+	if err != nil {
+		return nil, apperror.ErrNotFound
+	}
+
+	loc, err := s.location(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// narrow the scan down with the conditions the database can check
+	query := ExpenseQuery{
+		UserID:    authUserID,
+		AccountID: rule.AccountID,
+		AmountMin: rule.AmountMin,
+		AmountMax: rule.AmountMax,
+		Note:      rule.NoteContains,
+		TagMatch:  TagMatchAny,
+		SortBy:    SortByCreated,
+		Limit:     ruleApplyBatchSize,
+	}
+
+	result := &RuleApplyResult{Preview: dto.Preview, Changes: []RuleChange{}}
+	changed := []ExpenseEntity{}
+	befores := []map[string]string{}
+	for {
+		expenses, err := s.expenseRepo.Find(query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, expense := range expenses[:min(len(expenses), query.Limit)] {
+			subject := RuleSubject{
+				Date:       time.Unix(expense.Date, 0).In(loc),
+				Amount:     expense.Amount,
+				Note:       expense.Note,
+				AccountID:  expense.AccountID,
+				CategoryID: expense.CategoryID,
+				Tags:       slices.Clone(expense.Tags),
+			}
+			if !rule.Matches(subject) {
+				continue
+			}
+			result.Matched++
+
+			rule.Apply(&subject)
+			// a split expense is categorized by its splits, so only its note and tags can change
+			if len(expense.Splits) > 0 {
+				subject.CategoryID = expense.CategoryID
+			}
+			change := RuleChange{
+				ExpenseID:      expense.ID,
+				FromCategoryID: expense.CategoryID,
+				ToCategoryID:   subject.CategoryID,
+				FromNote:       expense.Note,
+				ToNote:         subject.Note,
+				AddedTagIDs:    []uint{},
+			}
+			for _, tag := range subject.Tags[len(expense.Tags):] {
+				change.AddedTagIDs = append(change.AddedTagIDs, tag.ID)
+			}
+			if change.FromCategoryID == change.ToCategoryID && change.FromNote == change.ToNote && len(change.AddedTagIDs) == 0 {
+				continue
+			}
+
+			result.Changed++
+			if len(result.Changes) < ruleChangePreviewMax {
+				result.Changes = append(result.Changes, change)
+			}
+
+			befores = append(befores, revisionFields(expense))
+			expense.CategoryID = subject.CategoryID
+			expense.Category = CategoryEntity{}
+			expense.Note = subject.Note
+			expense.Tags = subject.Tags
+			changed = append(changed, expense)
+		}
+
+		if len(expenses) <= query.Limit {
+			break
+		}
+		last := expenses[query.Limit-1]
+		query.Cursor = &ExpenseCursor{SortBy: SortByCreated, ID: last.ID}
+	}
+
+	if dto.Preview || len(changed) == 0 {
+		return result, nil
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		expenseRepo := s.expenseRepo.WithTx(tx)
+		revisionRepo := s.revisionRepo.WithTx(tx)
+
+		for i := range changed {
+			expense := &changed[i]
+			revised := *expense
+			tags := expense.Tags
+			expense.Category = CategoryEntity{}
+			expense.Tags = nil
+			expense.Splits = nil
+
+			if err := expenseRepo.Update(expense); err != nil {
+				return err
+			}
+
+			if err := expenseRepo.UpdateTags(expense, tags); err != nil {
+				return err
+			}
+
+			if err := RecordRevision(revisionRepo, revised, befores[i], authUserID, RevisionActionUpdate); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Evaluate runs the user's enabled rules over a new expense, judging weekdays in the user's timezone like ApplyRule.
+func (s *ruleService) Evaluate(authUserID uint, subject *RuleSubject) error {
+	rules, err := s.ruleRepo.GetEnabledByUser(authUserID)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	loc, err := s.location(authUserID)
+	if err != nil {
+		return err
+	}
+	subject.Date = subject.Date.In(loc)
+
+	ApplyRules(rules, subject)
+
+	return nil
+}
+
+func (s *ruleService) location(authUserID uint) (*time.Location, error) {
+	u, err := s.userService.GetUserByID(authUserID, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	return loc, nil
+}
+
+func (s *ruleService) validate(rule *RuleEntity, authUserID uint) error {
+	if rule.SetCategoryID == nil && rule.SetNote == nil && len(rule.AddTags) == 0 {
+		return apperror.ErrInvalidRequest
+	}
+
+	if rule.NotePattern != "" {
+		if _, err := regexp.Compile(rule.NotePattern); err != nil {
+			return apperror.ErrInvalidRequest
+		}
+	}
+
+	if rule.AmountMin != nil && rule.AmountMax != nil && rule.AmountMin.GreaterThan(*rule.AmountMax) {
+		return apperror.ErrInvalidRequest
+	}
+
+	if rule.SetCategoryID != nil {
+		isOwner, err := s.categoryService.IsCategoryOwner(*rule.SetCategoryID, authUserID)
+		if err != nil {
+			return err
+		}
+		if !isOwner {
+			return apperror.ErrUnauthorized
+		}
+	}
+
+	if rule.AccountID != nil {
+		isOwner, err := s.accountService.IsAccountOwner(*rule.AccountID, authUserID)
+		if err != nil {
+			return err
+		}
+		if !isOwner {
+			return apperror.ErrUnauthorized
+		}
+	}
+
+	return nil
+}
+
+func (s *ruleService) getTags(ids []uint, authUserID uint) ([]TagEntity, error) {
+	if len(ids) == 0 {
+		return []TagEntity{}, nil
+	}
+
+	tags, err := s.tagService.GetTagsByIDs(ids, authUserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) != len(ids) {
+		return nil, apperror.ErrUnauthorized
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,174 @@
+package expense_test
+
+import (
+	"testing"
+	"time"
+
+	accountMocks "github.com/Perajit/expense-tracker-go/internal/account/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestApplyRule(t *testing.T) {
+	var categoryID uint = 5
+	tag := expense.TagEntity{Model: gorm.Model{ID: 9}, Name: "coffee"}
+	newRule := func(id uint, userID uint) *expense.RuleEntity {
+		return &expense.RuleEntity{
+			Model:         gorm.Model{ID: id},
+			UserID:        userID,
+			Enabled:       true,
+			NoteContains:  "starbucks",
+			SetCategoryID: &categoryID,
+			AddTags:       []expense.TagEntity{tag},
+		}
+	}
+	newExpenses := func(userID uint) []expense.ExpenseEntity {
+		date := time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC).Unix()
+		return []expense.ExpenseEntity{
+			{Model: gorm.Model{ID: 1}, UserID: userID, Date: date, Amount: decimal.NewFromInt(4), Note: "Starbucks", CategoryID: 2, Tags: []expense.TagEntity{}},
+			{Model: gorm.Model{ID: 2}, UserID: userID, Date: date, Amount: decimal.NewFromInt(5), Note: "starbucks", CategoryID: categoryID, Tags: []expense.TagEntity{tag}},
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+
+		db := testutil.SetupDB()
+
+		mockRuleRepo := new(mocks.MockRuleRepository)
+		mockRuleRepo.On("GetByIDAndUser", id, userID).Return(newRule(id, userID), nil).Once()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("Find", mock.MatchedBy(func(q expense.ExpenseQuery) bool {
+			return q.UserID == userID && q.Note == "starbucks" && q.SortBy == expense.SortByCreated
+		})).Return(newExpenses(userID), nil).Once()
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Update", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.ID == 1 && e.CategoryID == categoryID
+		})).Return(nil).Once()
+		mockExpenseRepo.On("UpdateTags", mock.Anything, []expense.TagEntity{tag}).Return(nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(&user.UserEntity{Timezone: "UTC"}, nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", &expense.ExpenseRevisionEntity{
+			ExpenseID: 1,
+			ActorID:   userID,
+			Action:    expense.RevisionActionUpdate,
+			Changes: expense.FieldChanges{
+				{Field: "categoryId", Before: "2", After: "5"},
+				{Field: "tagIds", Before: "", After: "9"},
+			},
+		}).Return(nil).Once()
+
+		service := expense.NewRuleService(db, mockRuleRepo, mockExpenseRepo, mockRevisionRepo, new(mocks.MockCategoryService), new(mocks.MockTagService), mockUserService, new(accountMocks.MockAccountService))
+		result, err := service.ApplyRule(id, userID, expense.ApplyRuleRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &expense.RuleApplyResult{
+			Matched: 2,
+			Changed: 1,
+			Changes: []expense.RuleChange{
+				{ExpenseID: 1, FromCategoryID: 2, ToCategoryID: categoryID, FromNote: "Starbucks", ToNote: "Starbucks", AddedTagIDs: []uint{tag.ID}},
+			},
+		}, result)
+		mockExpenseRepo.AssertExpectations(t)
+		mockRevisionRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_split_keeps_category", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+		split := expense.ExpenseSplitEntity{ID: 7, ExpenseID: 3, CategoryID: 2, Amount: decimal.NewFromInt(4)}
+		expenses := []expense.ExpenseEntity{
+			{Model: gorm.Model{ID: 3}, UserID: userID, Amount: decimal.NewFromInt(4), Note: "starbucks", CategoryID: 2,
+				Tags: []expense.TagEntity{}, Splits: []expense.ExpenseSplitEntity{split}},
+		}
+
+		db := testutil.SetupDB()
+
+		mockRuleRepo := new(mocks.MockRuleRepository)
+		mockRuleRepo.On("GetByIDAndUser", id, userID).Return(newRule(id, userID), nil).Once()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("Find", mock.Anything).Return(expenses, nil).Once()
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Update", mock.MatchedBy(func(e *expense.ExpenseEntity) bool {
+			return e.ID == 3 && e.CategoryID == 2 && e.Splits == nil
+		})).Return(nil).Once()
+		mockExpenseRepo.On("UpdateTags", mock.Anything, []expense.TagEntity{tag}).Return(nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(&user.UserEntity{Timezone: "UTC"}, nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.ExpenseID == 3 && len(r.Changes) == 1 && r.Changes[0].Field == "tagIds"
+		})).Return(nil).Once()
+
+		service := expense.NewRuleService(db, mockRuleRepo, mockExpenseRepo, mockRevisionRepo, new(mocks.MockCategoryService), new(mocks.MockTagService), mockUserService, new(accountMocks.MockAccountService))
+		result, err := service.ApplyRule(id, userID, expense.ApplyRuleRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []expense.RuleChange{
+			{ExpenseID: 3, FromCategoryID: 2, ToCategoryID: 2, FromNote: "starbucks", ToNote: "starbucks", AddedTagIDs: []uint{tag.ID}},
+		}, result.Changes)
+		mockExpenseRepo.AssertExpectations(t)
+		mockRevisionRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_preview", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+
+		db := testutil.SetupDB()
+
+		mockRuleRepo := new(mocks.MockRuleRepository)
+		mockRuleRepo.On("GetByIDAndUser", id, userID).Return(newRule(id, userID), nil).Once()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("Find", mock.Anything).Return(newExpenses(userID), nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(&user.UserEntity{Timezone: "UTC"}, nil).Once()
+
+		service := expense.NewRuleService(db, mockRuleRepo, mockExpenseRepo, new(mocks.MockRevisionRepository), new(mocks.MockCategoryService), new(mocks.MockTagService), mockUserService, new(accountMocks.MockAccountService))
+		result, err := service.ApplyRule(id, userID, expense.ApplyRuleRequest{Preview: true})
+
+		assert.NoError(t, err)
+		assert.True(t, result.Preview)
+		assert.Equal(t, 1, result.Changed)
+		mockExpenseRepo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("error_not_found", func(t *testing.T) {
+		var id uint = 1
+		var userID uint = 11
+
+		db := testutil.SetupDB()
+
+		mockRuleRepo := new(mocks.MockRuleRepository)
+		mockRuleRepo.On("GetByIDAndUser", id, userID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+
+		service := expense.NewRuleService(db, mockRuleRepo, mockExpenseRepo, new(mocks.MockRevisionRepository), new(mocks.MockCategoryService), new(mocks.MockTagService), new(userMocks.MockUserService), new(accountMocks.MockAccountService))
+		result, err := service.ApplyRule(id, userID, expense.ApplyRuleRequest{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+		mockExpenseRepo.AssertNotCalled(t, "Find", mock.Anything)
+	})
+}
@@ -0,0 +1,80 @@
+package expense_test
+
+import (
+	"testing"
+
+	accountMocks "github.com/Perajit/expense-tracker-go/internal/account/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateRule(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var userID uint = 11
+		var categoryID uint = 5
+		dto := expense.CreateRuleRequest{
+			Name:          "Coffee",
+			NotePattern:   "(?i)^starbucks",
+			ByDay:         []string{"FR", "MO"},
+			SetCategoryID: &categoryID,
+		}
+
+		db := testutil.SetupDB()
+
+		mockRuleRepo := new(mocks.MockRuleRepository)
+		mockRuleRepo.On("Create", mock.MatchedBy(func(r *expense.RuleEntity) bool {
+			return r.UserID == userID && r.Enabled && r.ByDay == "FR,MO" && *r.SetCategoryID == categoryID
+		})).Return(nil).Once()
+
+		mockCategoryService := new(mocks.MockCategoryService)
+		mockCategoryService.On("IsCategoryOwner", categoryID, userID).Return(true, nil).Once()
+
+		service := expense.NewRuleService(db, mockRuleRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository), mockCategoryService, new(mocks.MockTagService), new(userMocks.MockUserService), new(accountMocks.MockAccountService))
+		rule, err := service.CreateRule(userID, dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Coffee", rule.Name)
+		mockRuleRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_no_action", func(t *testing.T) {
+		var userID uint = 11
+		dto := expense.CreateRuleRequest{Name: "Coffee", NoteContains: "starbucks"}
+
+		db := testutil.SetupDB()
+
+		mockRuleRepo := new(mocks.MockRuleRepository)
+
+		service := expense.NewRuleService(db, mockRuleRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository), new(mocks.MockCategoryService), new(mocks.MockTagService), new(userMocks.MockUserService), new(accountMocks.MockAccountService))
+		rule, err := service.CreateRule(userID, dto)
+
+		assert.Nil(t, rule)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockRuleRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("error_invalid_amount_range", func(t *testing.T) {
+		var userID uint = 11
+		setNote := "{note} (coffee)"
+		amountMin := decimal.NewFromInt(10)
+		amountMax := decimal.NewFromInt(5)
+		dto := expense.CreateRuleRequest{Name: "Coffee", AmountMin: &amountMin, AmountMax: &amountMax, SetNote: &setNote}
+
+		db := testutil.SetupDB()
+
+		mockRuleRepo := new(mocks.MockRuleRepository)
+
+		service := expense.NewRuleService(db, mockRuleRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository), new(mocks.MockCategoryService), new(mocks.MockTagService), new(userMocks.MockUserService), new(accountMocks.MockAccountService))
+		rule, err := service.CreateRule(userID, dto)
+
+		assert.Nil(t, rule)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockRuleRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
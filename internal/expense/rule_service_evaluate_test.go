@@ -0,0 +1,63 @@
+package expense_test
+
+import (
+	"testing"
+	"time"
+
+	accountMocks "github.com/Perajit/expense-tracker-go/internal/account/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestEvaluate(t *testing.T) {
+	var categoryID uint = 5
+	thursdays := []expense.RuleEntity{
+		{Model: gorm.Model{ID: 3}, Enabled: true, ByDay: "TH", SetCategoryID: &categoryID},
+	}
+	// midnight UTC on Friday is still Thursday evening in New York
+	date := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success_user_timezone", func(t *testing.T) {
+		var userID uint = 11
+
+		db := testutil.SetupDB()
+
+		mockRuleRepo := new(mocks.MockRuleRepository)
+		mockRuleRepo.On("GetEnabledByUser", userID).Return(thursdays, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(&user.UserEntity{Timezone: "America/New_York"}, nil).Once()
+
+		service := expense.NewRuleService(db, mockRuleRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository), new(mocks.MockCategoryService), new(mocks.MockTagService), mockUserService, new(accountMocks.MockAccountService))
+		subject := &expense.RuleSubject{Date: date, Amount: decimal.NewFromInt(4), Tags: []expense.TagEntity{}}
+		err := service.Evaluate(userID, subject)
+
+		assert.NoError(t, err)
+		assert.Equal(t, categoryID, subject.CategoryID)
+	})
+
+	t.Run("success_utc", func(t *testing.T) {
+		var userID uint = 11
+
+		db := testutil.SetupDB()
+
+		mockRuleRepo := new(mocks.MockRuleRepository)
+		mockRuleRepo.On("GetEnabledByUser", userID).Return(thursdays, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(&user.UserEntity{Timezone: "UTC"}, nil).Once()
+
+		service := expense.NewRuleService(db, mockRuleRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository), new(mocks.MockCategoryService), new(mocks.MockTagService), mockUserService, new(accountMocks.MockAccountService))
+		subject := &expense.RuleSubject{Date: date, Amount: decimal.NewFromInt(4), Tags: []expense.TagEntity{}}
+		err := service.Evaluate(userID, subject)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(0), subject.CategoryID)
+	})
+}
@@ -0,0 +1,30 @@
+package expense
+
+import "github.com/shopspring/decimal"
+
+// ExpenseSplitEntity is one line of an expense filed under its own category; the splits of an expense add up to its amount.
+type ExpenseSplitEntity struct {
+	ID         uint            `gorm:"primarykey"`
+	ExpenseID  uint            `gorm:"not null;index"`
+	Amount     decimal.Decimal `gorm:"type:decimal(15,2);not null"`
+	CategoryID uint            `gorm:"not null;index"`
+	Category   CategoryEntity  `gorm:"foreignKey:CategoryID"`
+	Note       string          `gorm:"type:text"`
+	Tags       []TagEntity     `gorm:"many2many:expense_splits_tags;"`
+}
+
+func (ExpenseSplitEntity) TableName() string {
+	return "expense_splits"
+}
+
+// largestSplit returns the split with the biggest amount, the first one on a tie.
+func largestSplit(splits []ExpenseSplitEntity) ExpenseSplitEntity {
+	largest := splits[0]
+	for _, split := range splits[1:] {
+		if split.Amount.GreaterThan(largest.Amount) {
+			largest = split
+		}
+	}
+
+	return largest
+}
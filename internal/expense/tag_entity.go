@@ -4,8 +4,8 @@ import "gorm.io/gorm"
 
 type TagEntity struct {
 	gorm.Model
-	UserID uint   `gorm:"not null;uniqueIndex:idx_tags_user_name"`
-	Name   string `gorm:"not null;uniqueIndex:idx_tags_user_name"`
+	UserID uint   `gorm:"not null;uniqueIndex:idx_tags_user_name_active,where:deleted_at IS NULL"`
+	Name   string `gorm:"not null;uniqueIndex:idx_tags_user_name_active,where:deleted_at IS NULL"`
 }
 
 func (TagEntity) TableName() string {
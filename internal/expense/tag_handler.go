@@ -22,11 +22,12 @@ func NewTagHandler(tagService TagService, validate *validator.Validate) *TagHand
 	}
 }
 
-func (h *TagHandler) RegisterRoutes(app *fiber.App) {
-	group := app.Group("/expenses")
+func (h *TagHandler) RegisterRoutes(app *fiber.App, authMiddleware fiber.Handler) {
+	group := app.Group("/tags", authMiddleware)
 	group.Get("/", h.GetTags)
 	group.Get("/:ids", h.GetTagByIDs)
 	group.Post("/", h.CreateTag)
+	group.Post("/:id/merge-into/:targetId", h.MergeTag)
 	group.Patch("/:id", h.UpateTag)
 	group.Delete("/:id", h.DeleteTag)
 }
@@ -120,6 +121,46 @@ func (h *TagHandler) UpateTag(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{"status": "success"})
 }
 
+func (h *TagHandler) MergeTag(c *fiber.Ctx) error {
+	id, errID := util.ExtractIDParam(c)
+	if errID != nil {
+		log.Error(errID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	targetID, errTargetID := util.ExtractNamedIDParam(c, "targetId")
+	if errTargetID != nil {
+		log.Error(errTargetID)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractQuery[MergeRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	result, err := h.tagService.MergeTag(id, targetID, authUserID, dto)
+	if err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, apperror.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
 func (h *TagHandler) DeleteTag(c *fiber.Ctx) error {
 	id, errID := util.ExtractIDParam(c)
 	if errID != nil {
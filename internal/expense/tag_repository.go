@@ -1,17 +1,29 @@
 package expense
 
 import (
+	"fmt"
+	"time"
+
 	"gorm.io/gorm"
 )
 
 type TagRepository interface {
+	WithTx(tx *gorm.DB) TagRepository
 	GetByIDAndUser(id uint, userID uint) (*TagEntity, error)
 	GetByIDsAndUser(ids []uint, userID uint) ([]TagEntity, error)
 	GetByUser(userID uint) ([]TagEntity, error)
+	GetByNames(userID uint, names []string) ([]TagEntity, error)
 	IsOwner(id uint, userID uint) (bool, error)
 	Create(tag *TagEntity) error
 	Update(tag *TagEntity) error
 	Delete(id uint) error
+	CountExpenses(id uint) (int64, error)
+	GetExpenseIDs(id uint) ([]uint, error)
+	Reassign(fromID uint, toID uint) error
+	GetDeletedByUser(userID uint) ([]TagEntity, error)
+	GetDeletedByIDAndUser(id uint, userID uint) (*TagEntity, error)
+	Restore(id uint) error
+	Purge(before time.Time) (int64, error)
 }
 
 type tagRepository struct {
@@ -22,6 +34,14 @@ func NewTagRepository(db *gorm.DB) TagRepository {
 	return &tagRepository{db: db}
 }
 
+func (r *tagRepository) WithTx(tx *gorm.DB) TagRepository {
+	if tx == nil {
+		return r
+	}
+
+	return &tagRepository{db: tx}
+}
+
 func (r *tagRepository) GetByIDAndUser(id uint, userID uint) (*TagEntity, error) {
 	var tag TagEntity
 	if err := r.db.Where("id = ?", id).Where("user_id = ?", userID).First(&tag).Error; err != nil {
@@ -49,6 +69,19 @@ func (r *tagRepository) GetByUser(userID uint) ([]TagEntity, error) {
 	return tags, nil
 }
 
+func (r *tagRepository) GetByNames(userID uint, names []string) ([]TagEntity, error) {
+	var tags []TagEntity
+	if len(names) == 0 {
+		return tags, nil
+	}
+
+	if err := r.db.Where("LOWER(name) IN ?", lowerNames(names)).Where("user_id = ?", userID).Find(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
 func (r *tagRepository) IsOwner(id uint, userID uint) (bool, error) {
 	var count int64
 	err := r.db.Model(&TagEntity{}).Where("id = ?", id).Where("user_id = ?", userID).Count(&count).Error
@@ -65,5 +98,102 @@ func (r *tagRepository) Update(tag *TagEntity) error {
 }
 
 func (r *tagRepository) Delete(id uint) error {
-	return r.db.Delete(id).Error
+	return r.db.Delete(&TagEntity{}, id).Error
+}
+
+func (r *tagRepository) GetDeletedByUser(userID uint) ([]TagEntity, error) {
+	var tags []TagEntity
+	if err := r.db.Unscoped().
+		Where("user_id = ?", userID).
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").
+		Find(&tags).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (r *tagRepository) GetDeletedByIDAndUser(id uint, userID uint) (*TagEntity, error) {
+	var tag TagEntity
+	if err := r.db.Unscoped().
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		Where("deleted_at IS NOT NULL").
+		First(&tag).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+func (r *tagRepository) Restore(id uint) error {
+	return r.db.Unscoped().Model(&TagEntity{}).Where("id = ?", id).Update("deleted_at", nil).Error
+}
+
+func (r *tagRepository) CountExpenses(id uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&ExpenseEntity{}).
+		Where("id IN (SELECT expense_entity_id FROM expenses_tags WHERE tag_entity_id = ?) "+
+			"OR id IN (SELECT s.expense_id FROM expense_splits s JOIN expense_splits_tags st ON st.expense_split_entity_id = s.id WHERE st.tag_entity_id = ?)", id, id).
+		Count(&count).
+		Error
+
+	return count, err
+}
+
+// GetExpenseIDs returns the expenses carrying the tag, on the expense itself or on one of its splits.
+func (r *tagRepository) GetExpenseIDs(id uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.Model(&ExpenseEntity{}).
+		Where("id IN (SELECT expense_entity_id FROM expenses_tags WHERE tag_entity_id = ?) "+
+			"OR id IN (SELECT s.expense_id FROM expense_splits s JOIN expense_splits_tags st ON st.expense_split_entity_id = s.id WHERE st.tag_entity_id = ?)", id, id).
+		Pluck("id", &ids).
+		Error
+
+	return ids, err
+}
+
+// Reassign moves every link to one tag over to another, skipping rows that already carry both.
+func (r *tagRepository) Reassign(fromID uint, toID uint) error {
+	links := [][2]string{
+		{"expenses_tags", "expense_entity_id"},
+		{"expense_splits_tags", "expense_split_entity_id"},
+		{"recurring_expenses_tags", "recurring_expense_entity_id"},
+		{"budgets_tags", "budget_entity_id"},
+		{"rules_tags", "rule_entity_id"},
+		{"merchants_tags", "merchant_entity_id"},
+	}
+
+	for _, link := range links {
+		table, column := link[0], link[1]
+		if err := r.db.Exec(fmt.Sprintf("INSERT INTO %[1]s (%[2]s, tag_entity_id) SELECT l.%[2]s, ? FROM %[1]s l "+
+			"WHERE l.tag_entity_id = ? AND NOT EXISTS (SELECT 1 FROM %[1]s x WHERE x.%[2]s = l.%[2]s AND x.tag_entity_id = ?)", table, column),
+			toID, fromID, toID).Error; err != nil {
+			return err
+		}
+
+		if err := r.db.Exec("DELETE FROM "+table+" WHERE tag_entity_id = ?", fromID).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Purge hard-deletes tags soft-deleted before the given time and unlinks them from everything they tagged.
+func (r *tagRepository) Purge(before time.Time) (int64, error) {
+	ids := r.db.Unscoped().Model(&TagEntity{}).Select("id").Where("deleted_at < ?", before)
+
+	for _, table := range []string{"expenses_tags", "expense_splits_tags", "recurring_expenses_tags", "budgets_tags", "rules_tags", "merchants_tags"} {
+		if err := r.db.Exec("DELETE FROM "+table+" WHERE tag_entity_id IN (?)", ids).Error; err != nil {
+			return 0, err
+		}
+	}
+
+	result := r.db.Unscoped().Where("deleted_at < ?", before).Delete(&TagEntity{})
+
+	return result.RowsAffected, result.Error
 }
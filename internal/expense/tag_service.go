@@ -1,26 +1,34 @@
 package expense
 
-import "github.com/Perajit/expense-tracker-go/internal/apperror"
+import (
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"gorm.io/gorm"
+)
 
 type TagService interface {
 	GetTags(authUserID uint) ([]TagEntity, error)
 	GetTagByID(id uint, authUserID uint) (*TagEntity, error)
 	GetTagsByIDs(ids []uint, authUserID uint) ([]TagEntity, error)
+	GetTagsByNames(names []string, authUserID uint) ([]TagEntity, error)
 	CreateTag(authUserID uint, dto CreateTagRequest) (*TagEntity, error)
 	UpdateTag(id uint, authUserID uint, dto UpdateTagRequest) error
+	MergeTag(id uint, targetID uint, authUserID uint, dto MergeRequest) (*MergeResult, error)
 	DeleteTag(id uint, authUserID uint) error
 }
 
 type tagService struct {
-	tagRepo TagRepository
+	db           *gorm.DB
+	tagRepo      TagRepository
+	expenseRepo  ExpenseRepository
+	revisionRepo RevisionRepository
 }
 
 func (s *tagService) GetTags(authUserID uint) ([]TagEntity, error) {
 	return s.tagRepo.GetByUser(authUserID)
 }
 
-func NewTagService(tagRepo TagRepository) TagService {
-	return &tagService{tagRepo: tagRepo}
+func NewTagService(db *gorm.DB, tagRepo TagRepository, expenseRepo ExpenseRepository, revisionRepo RevisionRepository) TagService {
+	return &tagService{db: db, tagRepo: tagRepo, expenseRepo: expenseRepo, revisionRepo: revisionRepo}
 }
 
 func (s *tagService) GetTagByID(id uint, authUserID uint) (*TagEntity, error) {
@@ -31,6 +39,10 @@ func (s *tagService) GetTagsByIDs(ids []uint, authUserID uint) ([]TagEntity, err
 	return s.tagRepo.GetByIDsAndUser(ids, authUserID)
 }
 
+func (s *tagService) GetTagsByNames(names []string, authUserID uint) ([]TagEntity, error) {
+	return s.tagRepo.GetByNames(authUserID, names)
+}
+
 func (s *tagService) CreateTag(authUserID uint, dto CreateTagRequest) (*TagEntity, error) {
 	tag := &TagEntity{
 		UserID: authUserID,
@@ -60,6 +72,53 @@ func (s *tagService) UpdateTag(id uint, authUserID uint, dto UpdateTagRequest) e
 	return nil
 }
 
+// MergeTag moves every use of the tag over to the target, then deletes it.
+func (s *tagService) MergeTag(id uint, targetID uint, authUserID uint, dto MergeRequest) (*MergeResult, error) {
+	if id == targetID {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	tags, err := s.tagRepo.GetByIDsAndUser([]uint{id, targetID}, authUserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) != 2 {
+		return nil, apperror.ErrNotFound
+	}
+
+	count, err := s.tagRepo.CountExpenses(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MergeResult{Expenses: count, Preview: dto.Preview}
+	if dto.Preview {
+		return result, nil
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		tagRepo := s.tagRepo.WithTx(tx)
+
+		expenseIDs, err := tagRepo.GetExpenseIDs(id)
+		if err != nil {
+			return err
+		}
+
+		if err := recordRevisions(s.expenseRepo.WithTx(tx), s.revisionRepo.WithTx(tx), expenseIDs, authUserID, func() error {
+			return tagRepo.Reassign(id, targetID)
+		}); err != nil {
+			return err
+		}
+
+		return tagRepo.Delete(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (s *tagService) DeleteTag(id uint, authUserID uint) error {
 	isOwner, err := s.tagRepo.IsOwner(id, authUserID)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Perajit/expense-tracker-go/internal/expense"
 	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -17,6 +18,8 @@ func TestCreateTag(t *testing.T) {
 		}
 		var newEntity *expense.TagEntity
 
+		db := testutil.SetupDB()
+
 		mockTagRepo := new(mocks.MockTagRepository)
 		mockTagRepo.On("Create", mock.MatchedBy(func(e *expense.TagEntity) bool {
 			if e.UserID != userID || e.Name != dto.Name {
@@ -26,7 +29,7 @@ func TestCreateTag(t *testing.T) {
 			return true
 		})).Return(nil).Once()
 
-		service := expense.NewTagService(mockTagRepo)
+		service := expense.NewTagService(db, mockTagRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
 		entity, err := service.CreateTag(userID, dto)
 
 		assert.Equal(t, newEntity, entity)
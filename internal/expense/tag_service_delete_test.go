@@ -5,6 +5,7 @@ import (
 
 	"github.com/Perajit/expense-tracker-go/internal/expense"
 	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -13,11 +14,13 @@ func TestDeleteTag(t *testing.T) {
 		var id uint = 1
 		var userID uint = 11
 
+		db := testutil.SetupDB()
+
 		mockTagRepo := new(mocks.MockTagRepository)
 		mockTagRepo.On("IsOwner", id, userID).Return(true, nil).Once()
 		mockTagRepo.On("Delete", id).Return(nil).Once()
 
-		service := expense.NewTagService(mockTagRepo)
+		service := expense.NewTagService(db, mockTagRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
 		err := service.DeleteTag(id, userID)
 
 		assert.NoError(t, err)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Perajit/expense-tracker-go/internal/expense"
 	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 )
@@ -19,10 +20,12 @@ func TestGetTagByID(t *testing.T) {
 			Name:   "tag1",
 		}
 
+		db := testutil.SetupDB()
+
 		mockTagRepo := new(mocks.MockTagRepository)
 		mockTagRepo.On("GetByIDAndUser", id, userID).Return(matchedEntity, nil).Once()
 
-		service := expense.NewTagService(mockTagRepo)
+		service := expense.NewTagService(db, mockTagRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
 		entity, err := service.GetTagByID(id, userID)
 
 		assert.Equal(t, matchedEntity, entity)
@@ -40,10 +43,12 @@ func TestGetTagsByIDs(t *testing.T) {
 			{Model: gorm.Model{ID: 2}, UserID: userID, Name: "tag2"},
 		}
 
+		db := testutil.SetupDB()
+
 		mockTagRepo := new(mocks.MockTagRepository)
 		mockTagRepo.On("GetByIDsAndUser", tagIDs, userID).Return(matchedList, nil).Once()
 
-		service := expense.NewTagService(mockTagRepo)
+		service := expense.NewTagService(db, mockTagRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
 		list, err := service.GetTagsByIDs(tagIDs, userID)
 
 		assert.Equal(t, matchedList, list)
@@ -60,10 +65,12 @@ func TestGetTagsByUser(t *testing.T) {
 			{Model: gorm.Model{ID: 2}, UserID: userID, Name: "tag2"},
 		}
 
+		db := testutil.SetupDB()
+
 		mockTagRepo := new(mocks.MockTagRepository)
 		mockTagRepo.On("GetByUser", userID).Return(matchedList, nil).Once()
 
-		service := expense.NewTagService(mockTagRepo)
+		service := expense.NewTagService(db, mockTagRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
 		list, err := service.GetTags(userID)
 
 		assert.Equal(t, matchedList, list)
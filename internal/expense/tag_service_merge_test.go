@@ -0,0 +1,106 @@
+package expense_test
+
+import (
+	"testing"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestMergeTag(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var id uint = 1
+		var targetID uint = 2
+		var userID uint = 11
+		tags := []expense.TagEntity{
+			{Model: gorm.Model{ID: id}, UserID: userID, Name: "taxis"},
+			{Model: gorm.Model{ID: targetID}, UserID: userID, Name: "taxi"},
+		}
+
+		db := testutil.SetupDB()
+
+		mockTagRepo := new(mocks.MockTagRepository)
+		mockTagRepo.On("GetByIDsAndUser", []uint{id, targetID}, userID).Return(tags, nil).Once()
+		mockTagRepo.On("CountExpenses", id).Return(int64(3), nil).Once()
+		mockTagRepo.On("WithTx", mock.Anything).Return(mockTagRepo).Once()
+		mockTagRepo.On("GetExpenseIDs", id).Return([]uint{7, 8}, nil).Once()
+		mockTagRepo.On("Reassign", id, targetID).Return(nil).Once()
+		mockTagRepo.On("Delete", id).Return(nil).Once()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("GetByIDs", []uint{7, 8}).Return([]expense.ExpenseEntity{
+			{Model: gorm.Model{ID: 7}, Tags: []expense.TagEntity{tags[0]}},
+			{Model: gorm.Model{ID: 8}, Tags: tags},
+		}, nil).Once()
+		mockExpenseRepo.On("GetByIDs", []uint{7, 8}).Return([]expense.ExpenseEntity{
+			{Model: gorm.Model{ID: 7}, Tags: []expense.TagEntity{tags[1]}},
+			{Model: gorm.Model{ID: 8}, Tags: []expense.TagEntity{tags[1]}},
+		}, nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.ExpenseID == 7 && r.Changes[0] == expense.FieldChange{Field: "tagIds", Before: "1", After: "2"}
+		})).Return(nil).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.ExpenseID == 8 && r.Changes[0] == expense.FieldChange{Field: "tagIds", Before: "1,2", After: "2"}
+		})).Return(nil).Once()
+
+		service := expense.NewTagService(db, mockTagRepo, mockExpenseRepo, mockRevisionRepo)
+		result, err := service.MergeTag(id, targetID, userID, expense.MergeRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &expense.MergeResult{Expenses: 3}, result)
+		mockTagRepo.AssertExpectations(t)
+		mockRevisionRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_preview", func(t *testing.T) {
+		var id uint = 1
+		var targetID uint = 2
+		var userID uint = 11
+		tags := []expense.TagEntity{
+			{Model: gorm.Model{ID: id}, UserID: userID, Name: "taxis"},
+			{Model: gorm.Model{ID: targetID}, UserID: userID, Name: "taxi"},
+		}
+
+		db := testutil.SetupDB()
+
+		mockTagRepo := new(mocks.MockTagRepository)
+		mockTagRepo.On("GetByIDsAndUser", []uint{id, targetID}, userID).Return(tags, nil).Once()
+		mockTagRepo.On("CountExpenses", id).Return(int64(3), nil).Once()
+
+		service := expense.NewTagService(db, mockTagRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
+		result, err := service.MergeTag(id, targetID, userID, expense.MergeRequest{Preview: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &expense.MergeResult{Expenses: 3, Preview: true}, result)
+		mockTagRepo.AssertNotCalled(t, "Reassign", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error_target_not_found", func(t *testing.T) {
+		var id uint = 1
+		var targetID uint = 2
+		var userID uint = 11
+		tags := []expense.TagEntity{
+			{Model: gorm.Model{ID: id}, UserID: userID, Name: "taxis"},
+		}
+
+		db := testutil.SetupDB()
+
+		mockTagRepo := new(mocks.MockTagRepository)
+		mockTagRepo.On("GetByIDsAndUser", []uint{id, targetID}, userID).Return(tags, nil).Once()
+
+		service := expense.NewTagService(db, mockTagRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
+		result, err := service.MergeTag(id, targetID, userID, expense.MergeRequest{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+	})
+}
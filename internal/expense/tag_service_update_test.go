@@ -5,6 +5,7 @@ import (
 
 	"github.com/Perajit/expense-tracker-go/internal/expense"
 	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
@@ -23,6 +24,8 @@ func TestUpdateTag(t *testing.T) {
 			Name:   *dto.Name,
 		}
 
+		db := testutil.SetupDB()
+
 		mockTagRepo := new(mocks.MockTagRepository)
 		mockTagRepo.On("GetByIDAndUser", id, userID).Return(existingEntity, nil).Once()
 		mockTagRepo.On("Update", mock.MatchedBy(func(e *expense.TagEntity) bool {
@@ -35,7 +38,7 @@ func TestUpdateTag(t *testing.T) {
 			return true
 		})).Return(nil).Once()
 
-		service := expense.NewTagService(mockTagRepo)
+		service := expense.NewTagService(db, mockTagRepo, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository))
 		err := service.UpdateTag(id, userID, dto)
 
 		assert.NoError(t, err)
@@ -0,0 +1,57 @@
+package expense
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type TrashType string
+
+const (
+	TrashTypeExpense  TrashType = "expense"
+	TrashTypeCategory TrashType = "category"
+	TrashTypeTag      TrashType = "tag"
+)
+
+// DefaultTrashRetention is how long deleted items stay restorable before they can be purged.
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+type TrashItem struct {
+	Type      TrashType
+	ID        uint
+	Name      string
+	Amount    *decimal.Decimal
+	Date      *time.Time
+	DeletedAt time.Time
+	PurgeAt   time.Time
+}
+
+type PurgeResult struct {
+	Expenses    int64
+	Categories  int64
+	Tags        int64
+	Attachments int64
+}
+
+type TrashItemResponse struct {
+	Type      TrashType        `json:"type"`
+	ID        uint             `json:"id"`
+	Name      string           `json:"name"`
+	Amount    *decimal.Decimal `json:"amount,omitempty"`
+	Date      *time.Time       `json:"date,omitempty"`
+	DeletedAt time.Time        `json:"deletedAt"`
+	PurgeAt   time.Time        `json:"purgeAt"`
+}
+
+func (TrashItemResponse) FromItem(item TrashItem) TrashItemResponse {
+	return TrashItemResponse{
+		Type:      item.Type,
+		ID:        item.ID,
+		Name:      item.Name,
+		Amount:    item.Amount,
+		Date:      item.Date,
+		DeletedAt: item.DeletedAt,
+		PurgeAt:   item.PurgeAt,
+	}
+}
@@ -0,0 +1,214 @@
+package expense
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/storage"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type TrashService interface {
+	GetTrash(authUserID uint) ([]TrashItem, error)
+	Restore(itemType TrashType, id uint, authUserID uint) error
+	Purge(now time.Time) (*PurgeResult, error)
+}
+
+type trashService struct {
+	db             *gorm.DB
+	expenseRepo    ExpenseRepository
+	revisionRepo   RevisionRepository
+	categoryRepo   CategoryRepository
+	tagRepo        TagRepository
+	attachmentRepo AttachmentRepository
+	blobStore      storage.BlobStore
+	retention      time.Duration
+}
+
+func NewTrashService(
+	db *gorm.DB,
+	expenseRepo ExpenseRepository,
+	revisionRepo RevisionRepository,
+	categoryRepo CategoryRepository,
+	tagRepo TagRepository,
+	attachmentRepo AttachmentRepository,
+	blobStore storage.BlobStore,
+	retention time.Duration,
+) TrashService {
+	return &trashService{
+		db:             db,
+		expenseRepo:    expenseRepo,
+		revisionRepo:   revisionRepo,
+		categoryRepo:   categoryRepo,
+		tagRepo:        tagRepo,
+		attachmentRepo: attachmentRepo,
+		blobStore:      blobStore,
+		retention:      retention,
+	}
+}
+
+func (s *trashService) GetTrash(authUserID uint) ([]TrashItem, error) {
+	expenses, err := s.expenseRepo.GetDeletedByUser(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.categoryRepo.GetDeletedByUser(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.tagRepo.GetDeletedByUser(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []TrashItem{}
+	for _, expense := range expenses {
+		amount := expense.Amount
+		date := time.Unix(expense.Date, 0)
+		items = append(items, s.item(TrashTypeExpense, expense.Model, expense.Note, &amount, &date))
+	}
+	for _, category := range categories {
+		items = append(items, s.item(TrashTypeCategory, category.Model, category.Name, nil, nil))
+	}
+	for _, tag := range tags {
+		items = append(items, s.item(TrashTypeTag, tag.Model, tag.Name, nil, nil))
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+
+	return items, nil
+}
+
+// Restore brings an item back; restoring an expense also restores its category when that was deleted too.
+func (s *trashService) Restore(itemType TrashType, id uint, authUserID uint) error {
+	switch itemType {
+	case TrashTypeExpense:
+		return s.restoreExpense(id, authUserID)
+	case TrashTypeCategory:
+		return s.db.Transaction(func(tx *gorm.DB) error {
+			return s.restoreCategory(s.categoryRepo.WithTx(tx), id, authUserID)
+		})
+	case TrashTypeTag:
+		return s.restoreTag(id, authUserID)
+	}
+
+	return apperror.ErrInvalidRequest
+}
+
+// Purge permanently removes everything that has been in the trash longer than the retention period.
+func (s *trashService) Purge(now time.Time) (*PurgeResult, error) {
+	before := now.Add(-s.retention)
+	result := &PurgeResult{}
+
+	var attachments []AttachmentEntity
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		attachments, err = s.attachmentRepo.WithTx(tx).GetByDeletedExpenses(before)
+		if err != nil {
+			return err
+		}
+		result.Attachments = int64(len(attachments))
+
+		// expenses go first so that categories they referenced can be purged in the same run
+		if result.Expenses, err = s.expenseRepo.WithTx(tx).Purge(before); err != nil {
+			return err
+		}
+
+		if result.Tags, err = s.tagRepo.WithTx(tx).Purge(before); err != nil {
+			return err
+		}
+
+		if result.Categories, err = s.categoryRepo.WithTx(tx).Purge(before); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// files go only once the rows are gone for good, so a rollback never leaves rows pointing at missing files;
+	// a file that fails to go is merely orphaned
+	for _, attachment := range attachments {
+		if err := deleteAttachmentBlobs(s.blobStore, []AttachmentEntity{attachment}); err != nil {
+			log.Errorf("purge: could not delete files of attachment %d: %v", attachment.ID, err)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *trashService) restoreExpense(id uint, authUserID uint) error {
+	expense, err := s.expenseRepo.GetDeletedByIDAndUser(id, authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if expense.Category.DeletedAt.Valid {
+			if err := s.restoreCategory(s.categoryRepo.WithTx(tx), expense.CategoryID, authUserID); err != nil {
+				return err
+			}
+		}
+
+		if err := s.expenseRepo.WithTx(tx).Restore(expense.ID); err != nil {
+			return err
+		}
+
+		return RecordRevision(s.revisionRepo.WithTx(tx), *expense, nil, authUserID, RevisionActionRestore)
+	})
+}
+
+func (s *trashService) restoreCategory(categoryRepo CategoryRepository, id uint, authUserID uint) error {
+	category, err := categoryRepo.GetDeletedByIDAndUser(id, authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	exists, err := categoryRepo.ExistsByName(authUserID, category.ParentID, category.Name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return apperror.ErrRecordDuplication
+	}
+
+	return categoryRepo.Restore(category.ID)
+}
+
+func (s *trashService) restoreTag(id uint, authUserID uint) error {
+	tag, err := s.tagRepo.GetDeletedByIDAndUser(id, authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	existing, err := s.tagRepo.GetByNames(authUserID, []string{tag.Name})
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		return apperror.ErrRecordDuplication
+	}
+
+	return s.tagRepo.Restore(tag.ID)
+}
+
+func (s *trashService) item(itemType TrashType, model gorm.Model, name string, amount *decimal.Decimal, date *time.Time) TrashItem {
+	return TrashItem{
+		Type:      itemType,
+		ID:        model.ID,
+		Name:      name,
+		Amount:    amount,
+		Date:      date,
+		DeletedAt: model.DeletedAt.Time,
+		PurgeAt:   model.DeletedAt.Time.Add(s.retention),
+	}
+}
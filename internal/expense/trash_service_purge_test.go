@@ -0,0 +1,124 @@
+package expense_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	storageMocks "github.com/Perajit/expense-tracker-go/internal/storage/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPurge(t *testing.T) {
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	retention := 7 * 24 * time.Hour
+	before := time.Date(2026, 3, 24, 12, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		attachments := []expense.AttachmentEntity{
+			{ID: 1, Key: "attachments/11/a", ThumbnailKey: "attachments/11/a-thumb.jpg"},
+			{ID: 2, Key: "attachments/11/b"},
+		}
+
+		db := testutil.SetupDB()
+
+		mockAttachmentRepo := new(mocks.MockAttachmentRepository)
+		mockAttachmentRepo.On("WithTx", mock.Anything).Return(mockAttachmentRepo).Once()
+		mockAttachmentRepo.On("GetByDeletedExpenses", before).Return(attachments, nil).Once()
+
+		mockBlobStore := new(storageMocks.MockBlobStore)
+		mockBlobStore.On("Delete", "attachments/11/a").Return(nil).Once()
+		mockBlobStore.On("Delete", "attachments/11/a-thumb.jpg").Return(nil).Once()
+		mockBlobStore.On("Delete", "attachments/11/b").Return(nil).Once()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Purge", before).Return(int64(4), nil).Once()
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockCategoryRepo.On("WithTx", mock.Anything).Return(mockCategoryRepo).Once()
+		mockCategoryRepo.On("Purge", before).Return(int64(1), nil).Once()
+
+		mockTagRepo := new(mocks.MockTagRepository)
+		mockTagRepo.On("WithTx", mock.Anything).Return(mockTagRepo).Once()
+		mockTagRepo.On("Purge", before).Return(int64(2), nil).Once()
+
+		service := expense.NewTrashService(db, mockExpenseRepo, new(mocks.MockRevisionRepository), mockCategoryRepo, mockTagRepo, mockAttachmentRepo, mockBlobStore, retention)
+		result, err := service.Purge(now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &expense.PurgeResult{Expenses: 4, Categories: 1, Tags: 2, Attachments: 2}, result)
+		mockBlobStore.AssertExpectations(t)
+		mockExpenseRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertExpectations(t)
+		mockTagRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_purge_expenses", func(t *testing.T) {
+		expectedErr := errors.New("db error")
+
+		db := testutil.SetupDB()
+
+		mockAttachmentRepo := new(mocks.MockAttachmentRepository)
+		mockAttachmentRepo.On("WithTx", mock.Anything).Return(mockAttachmentRepo).Once()
+		mockAttachmentRepo.On("GetByDeletedExpenses", before).Return([]expense.AttachmentEntity{{ID: 1, Key: "attachments/11/a"}}, nil).Once()
+
+		mockBlobStore := new(storageMocks.MockBlobStore)
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Purge", before).Return(int64(0), expectedErr).Once()
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+
+		mockTagRepo := new(mocks.MockTagRepository)
+
+		service := expense.NewTrashService(db, mockExpenseRepo, new(mocks.MockRevisionRepository), mockCategoryRepo, mockTagRepo, mockAttachmentRepo, mockBlobStore, retention)
+		result, err := service.Purge(now)
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedErr, err)
+		mockBlobStore.AssertNotCalled(t, "Delete", mock.Anything)
+		mockTagRepo.AssertNotCalled(t, "Purge", mock.Anything)
+		mockCategoryRepo.AssertNotCalled(t, "Purge", mock.Anything)
+	})
+	t.Run("success_delete_blob_fails", func(t *testing.T) {
+		attachments := []expense.AttachmentEntity{
+			{ID: 1, Key: "attachments/11/a"},
+			{ID: 2, Key: "attachments/11/b"},
+		}
+
+		db := testutil.SetupDB()
+
+		mockAttachmentRepo := new(mocks.MockAttachmentRepository)
+		mockAttachmentRepo.On("WithTx", mock.Anything).Return(mockAttachmentRepo).Once()
+		mockAttachmentRepo.On("GetByDeletedExpenses", before).Return(attachments, nil).Once()
+
+		mockBlobStore := new(storageMocks.MockBlobStore)
+		mockBlobStore.On("Delete", "attachments/11/a").Return(errors.New("storage error")).Once()
+		mockBlobStore.On("Delete", "attachments/11/b").Return(nil).Once()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Purge", before).Return(int64(2), nil).Once()
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockCategoryRepo.On("WithTx", mock.Anything).Return(mockCategoryRepo).Once()
+		mockCategoryRepo.On("Purge", before).Return(int64(0), nil).Once()
+
+		mockTagRepo := new(mocks.MockTagRepository)
+		mockTagRepo.On("WithTx", mock.Anything).Return(mockTagRepo).Once()
+		mockTagRepo.On("Purge", before).Return(int64(0), nil).Once()
+
+		service := expense.NewTrashService(db, mockExpenseRepo, new(mocks.MockRevisionRepository), mockCategoryRepo, mockTagRepo, mockAttachmentRepo, mockBlobStore, retention)
+		result, err := service.Purge(now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &expense.PurgeResult{Expenses: 2, Attachments: 2}, result)
+		mockBlobStore.AssertExpectations(t)
+	})
+}
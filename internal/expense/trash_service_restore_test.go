@@ -0,0 +1,111 @@
+package expense_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestRestore(t *testing.T) {
+	deletedAt := gorm.DeletedAt{Time: time.Now().Add(-time.Hour), Valid: true}
+
+	t.Run("success_expense_with_category", func(t *testing.T) {
+		var userID uint = 11
+		deletedCategory := &expense.CategoryEntity{Model: gorm.Model{ID: 2, DeletedAt: deletedAt}, UserID: userID, Name: "Travel"}
+		deletedExpense := &expense.ExpenseEntity{
+			Model:      gorm.Model{ID: 1, DeletedAt: deletedAt},
+			UserID:     userID,
+			Amount:     decimal.NewFromInt(100),
+			CategoryID: deletedCategory.ID,
+			Category:   *deletedCategory,
+		}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("GetDeletedByIDAndUser", deletedExpense.ID, userID).Return(deletedExpense, nil).Once()
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Restore", deletedExpense.ID).Return(nil).Once()
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+		mockCategoryRepo.On("WithTx", mock.Anything).Return(mockCategoryRepo).Once()
+		mockCategoryRepo.On("GetDeletedByIDAndUser", deletedCategory.ID, userID).Return(deletedCategory, nil).Once()
+		mockCategoryRepo.On("ExistsByName", userID, uint(0), deletedCategory.Name).Return(false, nil).Once()
+		mockCategoryRepo.On("Restore", deletedCategory.ID).Return(nil).Once()
+
+		mockRevisionRepo := new(mocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", &expense.ExpenseRevisionEntity{
+			ExpenseID: deletedExpense.ID,
+			ActorID:   userID,
+			Action:    expense.RevisionActionRestore,
+			Changes:   expense.FieldChanges{},
+		}).Return(nil).Once()
+
+		mockTagRepo := new(mocks.MockTagRepository)
+
+		service := expense.NewTrashService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryRepo, mockTagRepo, nil, nil, expense.DefaultTrashRetention)
+		err := service.Restore(expense.TrashTypeExpense, deletedExpense.ID, userID)
+
+		assert.NoError(t, err)
+		mockExpenseRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertExpectations(t)
+		mockRevisionRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_tag_name_taken", func(t *testing.T) {
+		var userID uint = 11
+		deletedTag := &expense.TagEntity{Model: gorm.Model{ID: 3, DeletedAt: deletedAt}, UserID: userID, Name: "trip"}
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+
+		mockTagRepo := new(mocks.MockTagRepository)
+		mockTagRepo.On("GetDeletedByIDAndUser", deletedTag.ID, userID).Return(deletedTag, nil).Once()
+		mockTagRepo.On("GetByNames", userID, []string{"trip"}).Return([]expense.TagEntity{{Model: gorm.Model{ID: 9}, UserID: userID, Name: "Trip"}}, nil).Once()
+
+		service := expense.NewTrashService(db, mockExpenseRepo, new(mocks.MockRevisionRepository), mockCategoryRepo, mockTagRepo, nil, nil, expense.DefaultTrashRetention)
+		err := service.Restore(expense.TrashTypeTag, deletedTag.ID, userID)
+
+		assert.ErrorIs(t, err, apperror.ErrRecordDuplication)
+		mockTagRepo.AssertNotCalled(t, "Restore", mock.Anything)
+	})
+
+	t.Run("error_not_found", func(t *testing.T) {
+		var userID uint = 11
+
+		db := testutil.SetupDB()
+
+		mockExpenseRepo := new(mocks.MockExpenseRepository)
+		mockExpenseRepo.On("GetDeletedByIDAndUser", uint(1), userID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		mockCategoryRepo := new(mocks.MockCategoryRepository)
+
+		mockTagRepo := new(mocks.MockTagRepository)
+
+		service := expense.NewTrashService(db, mockExpenseRepo, new(mocks.MockRevisionRepository), mockCategoryRepo, mockTagRepo, nil, nil, expense.DefaultTrashRetention)
+		err := service.Restore(expense.TrashTypeExpense, 1, userID)
+
+		assert.Equal(t, apperror.ErrNotFound, err)
+	})
+
+	t.Run("error_invalid_type", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		service := expense.NewTrashService(db, new(mocks.MockExpenseRepository), new(mocks.MockRevisionRepository), new(mocks.MockCategoryRepository), new(mocks.MockTagRepository), nil, nil, expense.DefaultTrashRetention)
+		err := service.Restore("budget", 1, 11)
+
+		assert.Equal(t, apperror.ErrInvalidRequest, err)
+	})
+}
@@ -0,0 +1,42 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	writer := &csvWriter{w: csv.NewWriter(w)}
+	if err := writer.w.Write(header); err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+func (w *csvWriter) Write(row Row) error {
+	return w.w.Write([]string{
+		strconv.FormatUint(uint64(row.ID), 10),
+		row.Date.Format(time.DateOnly),
+		row.Kind,
+		row.Amount.StringFixed(2),
+		row.Currency,
+		row.Note,
+		row.Category,
+		row.Merchant,
+		strings.Join(row.Tags, "|"),
+	})
+}
+
+func (w *csvWriter) Close() error {
+	w.w.Flush()
+
+	return w.w.Error()
+}
@@ -0,0 +1,68 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type Format string
+
+const (
+	FormatCSV   Format = "csv"
+	FormatJSONL Format = "jsonl"
+	FormatXLSX  Format = "xlsx"
+)
+
+var header = []string{"id", "date", "kind", "amount", "currency", "note", "category", "merchant", "tags"}
+
+// Row is one exported expense with its category and tags already resolved to names.
+type Row struct {
+	ID       uint
+	Date     time.Time
+	Kind     string
+	Amount   decimal.Decimal
+	Currency string
+	Note     string
+	Category string
+	Merchant string
+	Tags     []string
+}
+
+// Writer encodes rows one at a time; Close flushes whatever the format still buffers.
+type Writer interface {
+	Write(row Row) error
+	Close() error
+}
+
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatJSONL:
+		return newJSONLWriter(w), nil
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	}
+
+	return nil, fmt.Errorf("unsupported export format %q", format)
+}
+
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatJSONL:
+		return "application/x-ndjson"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+
+	return "application/octet-stream"
+}
+
+func (f Format) FileName(base string) string {
+	return fmt.Sprintf("%s.%s", base, f)
+}
@@ -0,0 +1,52 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type jsonlRow struct {
+	ID       uint            `json:"id"`
+	Date     string          `json:"date"`
+	Kind     string          `json:"kind"`
+	Amount   decimal.Decimal `json:"amount"`
+	Currency string          `json:"currency"`
+	Note     string          `json:"note"`
+	Category string          `json:"category"`
+	Merchant string          `json:"merchant"`
+	Tags     []string        `json:"tags"`
+}
+
+type jsonlWriter struct {
+	encoder *json.Encoder
+}
+
+func newJSONLWriter(w io.Writer) *jsonlWriter {
+	return &jsonlWriter{encoder: json.NewEncoder(w)}
+}
+
+func (w *jsonlWriter) Write(row Row) error {
+	tags := row.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return w.encoder.Encode(jsonlRow{
+		ID:       row.ID,
+		Date:     row.Date.Format(time.DateOnly),
+		Kind:     row.Kind,
+		Amount:   row.Amount,
+		Currency: row.Currency,
+		Note:     row.Note,
+		Category: row.Category,
+		Merchant: row.Merchant,
+		Tags:     tags,
+	})
+}
+
+func (w *jsonlWriter) Close() error {
+	return nil
+}
@@ -0,0 +1,5 @@
+package goal
+
+func GetModels() []any {
+	return []any{&GoalEntity{}, &ContributionEntity{}}
+}
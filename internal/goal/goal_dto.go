@@ -0,0 +1,87 @@
+package goal
+
+import (
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/account"
+	"github.com/shopspring/decimal"
+)
+
+type CreateGoalRequest struct {
+	Name         string          `json:"name" validate:"required,max=100"`
+	TargetAmount decimal.Decimal `json:"targetAmount" validate:"required"`
+	TargetDate   string          `json:"targetDate" validate:"required,datetime=2006-01-02"`
+	Currency     string          `json:"currency" validate:"omitempty,iso4217"`
+	AccountID    *uint           `json:"accountId"`
+}
+
+type UpdateGoalRequest struct {
+	Name         *string          `json:"name" validate:"omitempty,max=100"`
+	TargetAmount *decimal.Decimal `json:"targetAmount"`
+	TargetDate   *string          `json:"targetDate" validate:"omitempty,datetime=2006-01-02"`
+	AccountID    *uint            `json:"accountId"`
+}
+
+type CreateContributionRequest struct {
+	Date   time.Time       `json:"date" validate:"required"`
+	Amount decimal.Decimal `json:"amount" validate:"required"`
+	Note   string          `json:"note"`
+}
+
+type GoalResponse struct {
+	ID           uint                     `json:"id"`
+	Name         string                   `json:"name"`
+	TargetAmount decimal.Decimal          `json:"targetAmount"`
+	TargetDate   string                   `json:"targetDate"`
+	Currency     string                   `json:"currency"`
+	Account      *account.AccountResponse `json:"account"`
+}
+
+func (GoalResponse) FromEntity(goal GoalEntity) GoalResponse {
+	var accountResponse *account.AccountResponse
+	if goal.Account != nil {
+		response := account.AccountResponse{}.FromEntity(*goal.Account)
+		accountResponse = &response
+	}
+
+	return GoalResponse{
+		ID:           goal.ID,
+		Name:         goal.Name,
+		TargetAmount: goal.TargetAmount,
+		TargetDate:   goal.TargetDate,
+		Currency:     goal.Currency,
+		Account:      accountResponse,
+	}
+}
+
+type ContributionResponse struct {
+	ID     uint            `json:"id"`
+	Date   time.Time       `json:"date"`
+	Amount decimal.Decimal `json:"amount"`
+	Note   string          `json:"note"`
+}
+
+func (ContributionResponse) FromEntity(contribution ContributionEntity) ContributionResponse {
+	return ContributionResponse{
+		ID:     contribution.ID,
+		Date:   time.Unix(contribution.Date, 0),
+		Amount: contribution.Amount,
+		Note:   contribution.Note,
+	}
+}
+
+type ProgressResponse struct {
+	GoalID          uint             `json:"goalId"`
+	Currency        string           `json:"currency"`
+	Target          decimal.Decimal  `json:"target"`
+	Saved           decimal.Decimal  `json:"saved"`
+	Remaining       decimal.Decimal  `json:"remaining"`
+	PercentSaved    decimal.Decimal  `json:"percentSaved"`
+	TargetDate      string           `json:"targetDate"`
+	MonthsLeft      int              `json:"monthsLeft"`
+	RequiredMonthly decimal.Decimal  `json:"requiredMonthly"`
+	AverageMonthly  decimal.Decimal  `json:"averageMonthly"`
+	ProjectedDate   *string          `json:"projectedDate"`
+	OnTrack         bool             `json:"onTrack"`
+	AccountBalance  *decimal.Decimal `json:"accountBalance"`
+}
@@ -0,0 +1,37 @@
+package goal
+
+import (
+	"github.com/Perajit/expense-tracker-go/internal/account"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type GoalEntity struct {
+	gorm.Model
+	UserID       uint                   `gorm:"not null;index:idx_goals_user"`
+	User         user.UserEntity        `gorm:"foreignKey:UserID"`
+	Name         string                 `gorm:"type:varchar(100);not null"`
+	TargetAmount decimal.Decimal        `gorm:"type:decimal(15,2);not null"`
+	TargetDate   string                 `gorm:"type:char(10);not null"`
+	Currency     string                 `gorm:"type:char(3);not null"`
+	AccountID    *uint                  `gorm:"index:idx_goals_account"`
+	Account      *account.AccountEntity `gorm:"foreignKey:AccountID"`
+}
+
+func (GoalEntity) TableName() string {
+	return "goals"
+}
+
+// ContributionEntity is money put towards a goal. A negative amount is a withdrawal.
+type ContributionEntity struct {
+	gorm.Model
+	GoalID uint            `gorm:"not null;index:idx_goal_contributions_goal_date"`
+	Date   int64           `gorm:"not null;index:idx_goal_contributions_goal_date"`
+	Amount decimal.Decimal `gorm:"type:decimal(15,2);not null"`
+	Note   string          `gorm:"type:text"`
+}
+
+func (ContributionEntity) TableName() string {
+	return "goal_contributions"
+}
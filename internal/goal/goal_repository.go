@@ -0,0 +1,98 @@
+package goal
+
+import (
+	"gorm.io/gorm"
+)
+
+type GoalRepository interface {
+	WithTx(tx *gorm.DB) GoalRepository
+	GetByUser(userID uint) ([]GoalEntity, error)
+	GetByIDAndUser(id uint, userID uint) (*GoalEntity, error)
+	IsOwner(id uint, userID uint) (bool, error)
+	Create(goal *GoalEntity) error
+	Update(goal *GoalEntity) error
+	Delete(id uint) error
+	GetContributions(goalID uint) ([]ContributionEntity, error)
+	CreateContribution(contribution *ContributionEntity) error
+	DeleteContribution(id uint, goalID uint) (bool, error)
+}
+
+type goalRepository struct {
+	db *gorm.DB
+}
+
+func NewGoalRepository(db *gorm.DB) GoalRepository {
+	return &goalRepository{db: db}
+}
+
+func (r *goalRepository) WithTx(tx *gorm.DB) GoalRepository {
+	if tx == nil {
+		return r
+	}
+
+	return &goalRepository{db: tx}
+}
+
+func (r *goalRepository) GetByUser(userID uint) ([]GoalEntity, error) {
+	var goals []GoalEntity
+	if err := r.db.Preload("Account").
+		Where("user_id = ?", userID).
+		Order("target_date, id").
+		Find(&goals).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return goals, nil
+}
+
+func (r *goalRepository) GetByIDAndUser(id uint, userID uint) (*GoalEntity, error) {
+	var goal GoalEntity
+	if err := r.db.Preload("Account").
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		First(&goal).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &goal, nil
+}
+
+func (r *goalRepository) IsOwner(id uint, userID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&GoalEntity{}).Where("id = ?", id).Where("user_id = ?", userID).Count(&count).Error
+
+	return count > 0, err
+}
+
+func (r *goalRepository) Create(goal *GoalEntity) error {
+	return r.db.Omit("Account").Create(goal).Error
+}
+
+func (r *goalRepository) Update(goal *GoalEntity) error {
+	return r.db.Omit("Account").Save(goal).Error
+}
+
+func (r *goalRepository) Delete(id uint) error {
+	return r.db.Delete(&GoalEntity{}, id).Error
+}
+
+func (r *goalRepository) GetContributions(goalID uint) ([]ContributionEntity, error) {
+	var contributions []ContributionEntity
+	if err := r.db.Where("goal_id = ?", goalID).Order("date, id").Find(&contributions).Error; err != nil {
+		return nil, err
+	}
+
+	return contributions, nil
+}
+
+func (r *goalRepository) CreateContribution(contribution *ContributionEntity) error {
+	return r.db.Create(contribution).Error
+}
+
+func (r *goalRepository) DeleteContribution(id uint, goalID uint) (bool, error) {
+	result := r.db.Where("goal_id = ?", goalID).Delete(&ContributionEntity{}, id)
+
+	return result.RowsAffected > 0, result.Error
+}
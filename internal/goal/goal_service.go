@@ -0,0 +1,274 @@
+package goal
+
+import (
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/account"
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	"github.com/shopspring/decimal"
+)
+
+type GoalService interface {
+	GetGoals(authUserID uint) ([]GoalEntity, error)
+	GetGoalByID(id uint, authUserID uint) (*GoalEntity, error)
+	CreateGoal(authUserID uint, dto CreateGoalRequest) (*GoalEntity, error)
+	UpdateGoal(id uint, authUserID uint, dto UpdateGoalRequest) error
+	DeleteGoal(id uint, authUserID uint) error
+	GetContributions(id uint, authUserID uint) ([]ContributionEntity, error)
+	AddContribution(id uint, authUserID uint, dto CreateContributionRequest) (*ContributionEntity, error)
+	DeleteContribution(contributionID uint, id uint, authUserID uint) error
+	GetProgress(id uint, authUserID uint) (*ProgressResponse, error)
+}
+
+type goalService struct {
+	goalRepo       GoalRepository
+	accountService account.AccountService
+	userService    user.UserService
+}
+
+func NewGoalService(goalRepo GoalRepository, accountService account.AccountService, userService user.UserService) GoalService {
+	return &goalService{
+		goalRepo:       goalRepo,
+		accountService: accountService,
+		userService:    userService,
+	}
+}
+
+func (s *goalService) GetGoals(authUserID uint) ([]GoalEntity, error) {
+	return s.goalRepo.GetByUser(authUserID)
+}
+
+func (s *goalService) GetGoalByID(id uint, authUserID uint) (*GoalEntity, error) {
+	return s.goalRepo.GetByIDAndUser(id, authUserID)
+}
+
+// CreateGoal tracks a goal linked to an account in that account's currency.
+func (s *goalService) CreateGoal(authUserID uint, dto CreateGoalRequest) (*GoalEntity, error) {
+	if !dto.TargetAmount.IsPositive() {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	goal := &GoalEntity{
+		UserID:       authUserID,
+		Name:         dto.Name,
+		TargetAmount: dto.TargetAmount,
+		TargetDate:   dto.TargetDate,
+		Currency:     dto.Currency,
+	}
+
+	if dto.AccountID != nil {
+		linked, err := s.accountService.GetAccountByID(*dto.AccountID, authUserID)
+		if err != nil {
+			return nil, err
+		}
+		goal.AccountID = &linked.Account.ID
+		goal.Account = &linked.Account
+		goal.Currency = linked.Account.Currency
+	}
+
+	if goal.Currency == "" {
+		u, err := s.userService.GetUserByID(authUserID, authUserID)
+		if err != nil {
+			return nil, err
+		}
+		goal.Currency = u.DefaultCurrency
+	}
+
+	if err := s.goalRepo.Create(goal); err != nil {
+		return nil, err
+	}
+
+	return goal, nil
+}
+
+func (s *goalService) UpdateGoal(id uint, authUserID uint, dto UpdateGoalRequest) error {
+	goal, err := s.goalRepo.GetByIDAndUser(id, authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	if dto.Name != nil {
+		goal.Name = *dto.Name
+	}
+
+	if dto.TargetAmount != nil {
+		if !dto.TargetAmount.IsPositive() {
+			return apperror.ErrInvalidRequest
+		}
+		goal.TargetAmount = *dto.TargetAmount
+	}
+
+	if dto.TargetDate != nil {
+		goal.TargetDate = *dto.TargetDate
+	}
+
+	if dto.AccountID != nil {
+		if *dto.AccountID == 0 {
+			goal.AccountID = nil
+			goal.Account = nil
+		} else {
+			linked, err := s.accountService.GetAccountByID(*dto.AccountID, authUserID)
+			if err != nil {
+				return err
+			}
+			if linked.Account.Currency != goal.Currency {
+				return apperror.ErrInvalidRequest
+			}
+			goal.AccountID = &linked.Account.ID
+			goal.Account = &linked.Account
+		}
+	}
+
+	return s.goalRepo.Update(goal)
+}
+
+func (s *goalService) DeleteGoal(id uint, authUserID uint) error {
+	isOwner, err := s.goalRepo.IsOwner(id, authUserID)
+	if err != nil {
+		return err
+	}
+	if !isOwner {
+		return apperror.ErrUnauthorized
+	}
+
+	return s.goalRepo.Delete(id)
+}
+
+func (s *goalService) GetContributions(id uint, authUserID uint) ([]ContributionEntity, error) {
+	if err := s.checkOwner(id, authUserID); err != nil {
+		return nil, err
+	}
+
+	return s.goalRepo.GetContributions(id)
+}
+
+func (s *goalService) AddContribution(id uint, authUserID uint, dto CreateContributionRequest) (*ContributionEntity, error) {
+	if dto.Amount.IsZero() {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	if err := s.checkOwner(id, authUserID); err != nil {
+		return nil, err
+	}
+
+	contribution := &ContributionEntity{
+		GoalID: id,
+		Date:   dto.Date.Unix(),
+		Amount: dto.Amount,
+		Note:   dto.Note,
+	}
+	if err := s.goalRepo.CreateContribution(contribution); err != nil {
+		return nil, err
+	}
+
+	return contribution, nil
+}
+
+func (s *goalService) DeleteContribution(contributionID uint, id uint, authUserID uint) error {
+	if err := s.checkOwner(id, authUserID); err != nil {
+		return err
+	}
+
+	deleted, err := s.goalRepo.DeleteContribution(contributionID, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return apperror.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetProgress compares what has been saved with the target. The required monthly saving spreads what is left
+// over the whole months until the target date, and the projection extrapolates the average pace so far.
+func (s *goalService) GetProgress(id uint, authUserID uint) (*ProgressResponse, error) {
+	goal, err := s.goalRepo.GetByIDAndUser(id, authUserID)
+	if err != nil {
+		return nil, apperror.ErrNotFound
+	}
+
+	contributions, err := s.goalRepo.GetContributions(id)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := s.location(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	targetDate, err := time.ParseInLocation(dateLayout, goal.TargetDate, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	saved := decimal.Zero
+	for _, contribution := range contributions {
+		saved = saved.Add(contribution.Amount)
+	}
+	remaining := decimal.Max(goal.TargetAmount.Sub(saved), decimal.Zero)
+
+	monthsLeft := monthsUntil(today, targetDate)
+	requiredMonthly := remaining
+	if monthsLeft > 0 {
+		requiredMonthly = remaining.Div(decimal.NewFromInt(int64(monthsLeft))).RoundCeil(2)
+	}
+
+	progress := &ProgressResponse{
+		GoalID:          goal.ID,
+		Currency:        goal.Currency,
+		Target:          goal.TargetAmount,
+		Saved:           saved,
+		Remaining:       remaining,
+		PercentSaved:    saved.Div(goal.TargetAmount).Mul(decimal.NewFromInt(100)).Round(2),
+		TargetDate:      goal.TargetDate,
+		MonthsLeft:      monthsLeft,
+		RequiredMonthly: requiredMonthly,
+		AverageMonthly:  dailyRate(contributions, saved, now).Mul(decimal.NewFromInt(averageWindowDays)).Round(2),
+	}
+
+	if projected := projectCompletion(contributions, goal.TargetAmount, now); projected != nil {
+		date := projected.Format(dateLayout)
+		progress.ProjectedDate = &date
+		progress.OnTrack = date <= goal.TargetDate
+	}
+
+	if goal.AccountID != nil {
+		linked, err := s.accountService.GetAccountByID(*goal.AccountID, authUserID)
+		if err == nil {
+			progress.AccountBalance = &linked.Balance
+		}
+	}
+
+	return progress, nil
+}
+
+func (s *goalService) checkOwner(id uint, authUserID uint) error {
+	isOwner, err := s.goalRepo.IsOwner(id, authUserID)
+	if err != nil {
+		return err
+	}
+	if !isOwner {
+		return apperror.ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *goalService) location(authUserID uint) (*time.Location, error) {
+	u, err := s.userService.GetUserByID(authUserID, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		return time.UTC, nil
+	}
+
+	return loc, nil
+}
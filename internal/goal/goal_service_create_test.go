@@ -0,0 +1,107 @@
+package goal_test
+
+import (
+	"testing"
+
+	"github.com/Perajit/expense-tracker-go/internal/account"
+	accountMocks "github.com/Perajit/expense-tracker-go/internal/account/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/goal"
+	"github.com/Perajit/expense-tracker-go/internal/goal/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestCreateGoal(t *testing.T) {
+	var userID uint = 11
+
+	t.Run("success_linked_account", func(t *testing.T) {
+		var accountID uint = 4
+		savings := account.AccountEntity{Model: gorm.Model{ID: accountID}, UserID: userID, Name: "Savings", Currency: "EUR"}
+		dto := goal.CreateGoalRequest{
+			Name:         "Holiday",
+			TargetAmount: decimal.NewFromInt(2000),
+			TargetDate:   "2027-06-01",
+			AccountID:    &accountID,
+		}
+
+		mockGoalRepo := new(mocks.MockGoalRepository)
+		mockGoalRepo.On("Create", mock.MatchedBy(func(e *goal.GoalEntity) bool {
+			return e.UserID == userID && e.Name == dto.Name && *e.AccountID == accountID && e.Currency == "EUR"
+		})).Return(nil).Once()
+
+		mockAccountService := new(accountMocks.MockAccountService)
+		mockAccountService.On("GetAccountByID", accountID, userID).Return(&account.AccountBalance{Account: savings}, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+
+		service := goal.NewGoalService(mockGoalRepo, mockAccountService, mockUserService)
+		entity, err := service.CreateGoal(userID, dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Savings", entity.Account.Name)
+		mockGoalRepo.AssertExpectations(t)
+		mockUserService.AssertNotCalled(t, "GetUserByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("success_default_currency", func(t *testing.T) {
+		dto := goal.CreateGoalRequest{
+			Name:         "Laptop",
+			TargetAmount: decimal.NewFromInt(1500),
+			TargetDate:   "2027-01-01",
+		}
+
+		mockGoalRepo := new(mocks.MockGoalRepository)
+		mockGoalRepo.On("Create", mock.MatchedBy(func(e *goal.GoalEntity) bool {
+			return e.AccountID == nil && e.Currency == "THB"
+		})).Return(nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(&user.UserEntity{Model: gorm.Model{ID: userID}, DefaultCurrency: "THB"}, nil).Once()
+
+		service := goal.NewGoalService(mockGoalRepo, new(accountMocks.MockAccountService), mockUserService)
+		_, err := service.CreateGoal(userID, dto)
+
+		assert.NoError(t, err)
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_account_not_found", func(t *testing.T) {
+		var accountID uint = 9
+		dto := goal.CreateGoalRequest{
+			Name:         "Holiday",
+			TargetAmount: decimal.NewFromInt(2000),
+			TargetDate:   "2027-06-01",
+			AccountID:    &accountID,
+		}
+
+		mockGoalRepo := new(mocks.MockGoalRepository)
+
+		mockAccountService := new(accountMocks.MockAccountService)
+		mockAccountService.On("GetAccountByID", accountID, userID).Return(nil, apperror.ErrNotFound).Once()
+
+		service := goal.NewGoalService(mockGoalRepo, mockAccountService, new(userMocks.MockUserService))
+		entity, err := service.CreateGoal(userID, dto)
+
+		assert.Nil(t, entity)
+		assert.Equal(t, apperror.ErrNotFound, err)
+		mockGoalRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("error_non_positive_target", func(t *testing.T) {
+		dto := goal.CreateGoalRequest{Name: "Nothing", TargetAmount: decimal.Zero, TargetDate: "2027-01-01"}
+
+		mockGoalRepo := new(mocks.MockGoalRepository)
+
+		service := goal.NewGoalService(mockGoalRepo, new(accountMocks.MockAccountService), new(userMocks.MockUserService))
+		entity, err := service.CreateGoal(userID, dto)
+
+		assert.Nil(t, entity)
+		assert.Equal(t, apperror.ErrInvalidRequest, err)
+		mockGoalRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
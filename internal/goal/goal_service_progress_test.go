@@ -0,0 +1,128 @@
+package goal_test
+
+import (
+	"testing"
+	"time"
+
+	accountMocks "github.com/Perajit/expense-tracker-go/internal/account/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/goal"
+	"github.com/Perajit/expense-tracker-go/internal/goal/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestGetProgress(t *testing.T) {
+	var id uint = 3
+	var userID uint = 11
+	now := time.Now().UTC()
+	u := &user.UserEntity{Model: gorm.Model{ID: userID}, Timezone: "UTC", DefaultCurrency: "USD"}
+
+	t.Run("success_projected", func(t *testing.T) {
+		existing := &goal.GoalEntity{
+			Model:        gorm.Model{ID: id},
+			UserID:       userID,
+			TargetAmount: decimal.NewFromInt(1200),
+			TargetDate:   now.AddDate(0, 6, 0).Format("2006-01-02"),
+			Currency:     "USD",
+		}
+		contributions := []goal.ContributionEntity{
+			{GoalID: id, Date: now.AddDate(0, 0, -60).Unix(), Amount: decimal.NewFromInt(300)},
+			{GoalID: id, Date: now.AddDate(0, 0, -30).Unix(), Amount: decimal.NewFromInt(350)},
+			{GoalID: id, Date: now.AddDate(0, 0, -10).Unix(), Amount: decimal.NewFromInt(-50)},
+		}
+
+		mockGoalRepo := new(mocks.MockGoalRepository)
+		mockGoalRepo.On("GetByIDAndUser", id, userID).Return(existing, nil).Once()
+		mockGoalRepo.On("GetContributions", id).Return(contributions, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(u, nil).Once()
+
+		service := goal.NewGoalService(mockGoalRepo, new(accountMocks.MockAccountService), mockUserService)
+		progress, err := service.GetProgress(id, userID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "600", progress.Saved.String())
+		assert.Equal(t, "600", progress.Remaining.String())
+		assert.Equal(t, "50", progress.PercentSaved.String())
+		assert.Equal(t, 6, progress.MonthsLeft)
+		assert.Equal(t, "100", progress.RequiredMonthly.String())
+		assert.Equal(t, "300", progress.AverageMonthly.String())
+		assert.Equal(t, time.Now().UTC().AddDate(0, 0, 60).Format("2006-01-02"), *progress.ProjectedDate)
+		assert.True(t, progress.OnTrack)
+		assert.Nil(t, progress.AccountBalance)
+	})
+
+	t.Run("success_reached", func(t *testing.T) {
+		reachedAt := now.AddDate(0, 0, -5)
+		existing := &goal.GoalEntity{
+			Model:        gorm.Model{ID: id},
+			UserID:       userID,
+			TargetAmount: decimal.NewFromInt(500),
+			TargetDate:   now.AddDate(0, 0, -1).Format("2006-01-02"),
+			Currency:     "USD",
+		}
+		contributions := []goal.ContributionEntity{
+			{GoalID: id, Date: now.AddDate(0, -2, 0).Unix(), Amount: decimal.NewFromInt(400)},
+			{GoalID: id, Date: reachedAt.Unix(), Amount: decimal.NewFromInt(150)},
+		}
+
+		mockGoalRepo := new(mocks.MockGoalRepository)
+		mockGoalRepo.On("GetByIDAndUser", id, userID).Return(existing, nil).Once()
+		mockGoalRepo.On("GetContributions", id).Return(contributions, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(u, nil).Once()
+
+		service := goal.NewGoalService(mockGoalRepo, new(accountMocks.MockAccountService), mockUserService)
+		progress, err := service.GetProgress(id, userID)
+
+		assert.NoError(t, err)
+		assert.True(t, progress.Remaining.IsZero())
+		assert.Equal(t, 0, progress.MonthsLeft)
+		assert.True(t, progress.RequiredMonthly.IsZero())
+		assert.Equal(t, reachedAt.Format("2006-01-02"), *progress.ProjectedDate)
+		assert.True(t, progress.OnTrack)
+	})
+
+	t.Run("success_no_contributions", func(t *testing.T) {
+		existing := &goal.GoalEntity{
+			Model:        gorm.Model{ID: id},
+			UserID:       userID,
+			TargetAmount: decimal.NewFromInt(1000),
+			TargetDate:   now.AddDate(0, 0, 10).Format("2006-01-02"),
+			Currency:     "USD",
+		}
+
+		mockGoalRepo := new(mocks.MockGoalRepository)
+		mockGoalRepo.On("GetByIDAndUser", id, userID).Return(existing, nil).Once()
+		mockGoalRepo.On("GetContributions", id).Return([]goal.ContributionEntity{}, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(u, nil).Once()
+
+		service := goal.NewGoalService(mockGoalRepo, new(accountMocks.MockAccountService), mockUserService)
+		progress, err := service.GetProgress(id, userID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, progress.MonthsLeft)
+		assert.Equal(t, "1000", progress.RequiredMonthly.String())
+		assert.Nil(t, progress.ProjectedDate)
+		assert.False(t, progress.OnTrack)
+	})
+
+	t.Run("error_not_found", func(t *testing.T) {
+		mockGoalRepo := new(mocks.MockGoalRepository)
+		mockGoalRepo.On("GetByIDAndUser", id, userID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		service := goal.NewGoalService(mockGoalRepo, new(accountMocks.MockAccountService), new(userMocks.MockUserService))
+		progress, err := service.GetProgress(id, userID)
+
+		assert.Nil(t, progress)
+		assert.Equal(t, apperror.ErrNotFound, err)
+	})
+}
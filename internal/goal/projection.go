@@ -0,0 +1,66 @@
+package goal
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const dateLayout = "2006-01-02"
+
+// averageWindowDays is the shortest history the saving rate is averaged over,
+// so a single recent contribution doesn't project an unrealistic pace.
+const averageWindowDays = 30
+
+// monthsUntil counts the whole months from now to the target date, at least one while the date is still ahead.
+func monthsUntil(now time.Time, target time.Time) int {
+	if !target.After(now) {
+		return 0
+	}
+
+	months := (target.Year()-now.Year())*12 + int(target.Month()-now.Month())
+	if now.AddDate(0, months, 0).After(target) {
+		months--
+	}
+
+	return max(months, 1)
+}
+
+// dailyRate is the average net saving per day since the first contribution.
+func dailyRate(contributions []ContributionEntity, saved decimal.Decimal, now time.Time) decimal.Decimal {
+	if len(contributions) == 0 {
+		return decimal.Zero
+	}
+
+	days := int64(now.Sub(time.Unix(contributions[0].Date, 0)).Hours() / 24)
+
+	return saved.Div(decimal.NewFromInt(max(days, averageWindowDays)))
+}
+
+// projectCompletion returns the day the target was reached, or the day it will be at the current saving rate.
+// It returns nil when nothing is being saved. Contributions must be ordered by date.
+func projectCompletion(contributions []ContributionEntity, target decimal.Decimal, now time.Time) *time.Time {
+	saved := decimal.Zero
+	var reached *time.Time
+	for _, contribution := range contributions {
+		before := saved
+		saved = saved.Add(contribution.Amount)
+		if saved.GreaterThanOrEqual(target) && before.LessThan(target) {
+			date := time.Unix(contribution.Date, 0).In(now.Location())
+			reached = &date
+		}
+	}
+	if saved.GreaterThanOrEqual(target) {
+		return reached
+	}
+
+	rate := dailyRate(contributions, saved, now)
+	if !rate.IsPositive() {
+		return nil
+	}
+
+	days := target.Sub(saved).Div(rate).Ceil().IntPart()
+	date := now.AddDate(0, 0, int(days))
+
+	return &date
+}
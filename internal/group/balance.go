@@ -0,0 +1,75 @@
+package group
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// MemberTotalRow sums what a member paid and owes in a group, and what they repaid or were repaid.
+type MemberTotalRow struct {
+	UserID   uint
+	Username string
+	Paid     decimal.Decimal
+	Owed     decimal.Decimal
+	Sent     decimal.Decimal
+	Received decimal.Decimal
+}
+
+// Net is positive when the group owes the member and negative when the member owes the group.
+func (row MemberTotalRow) Net() decimal.Decimal {
+	return row.Paid.Sub(row.Owed).Add(row.Sent).Sub(row.Received)
+}
+
+type Transfer struct {
+	FromUserID uint            `json:"fromUserId"`
+	ToUserID   uint            `json:"toUserId"`
+	Amount     decimal.Decimal `json:"amount"`
+}
+
+// simplifyDebts settles the balances with few transfers: the member owing most pays the one owed most,
+// and so on until everyone is even. This takes at most one transfer fewer than there are members with a balance.
+func simplifyDebts(nets map[uint]decimal.Decimal) []Transfer {
+	type balance struct {
+		userID uint
+		amount decimal.Decimal
+	}
+
+	var debtors, creditors []balance
+	for userID, net := range nets {
+		if net.IsNegative() {
+			debtors = append(debtors, balance{userID, net.Neg()})
+		} else if net.IsPositive() {
+			creditors = append(creditors, balance{userID, net})
+		}
+	}
+
+	byAmount := func(balances []balance) func(i, j int) bool {
+		return func(i, j int) bool {
+			if !balances[i].amount.Equal(balances[j].amount) {
+				return balances[i].amount.GreaterThan(balances[j].amount)
+			}
+			return balances[i].userID < balances[j].userID
+		}
+	}
+
+	transfers := []Transfer{}
+	for len(debtors) > 0 && len(creditors) > 0 {
+		sort.Slice(debtors, byAmount(debtors))
+		sort.Slice(creditors, byAmount(creditors))
+
+		amount := decimal.Min(debtors[0].amount, creditors[0].amount)
+		transfers = append(transfers, Transfer{FromUserID: debtors[0].userID, ToUserID: creditors[0].userID, Amount: amount})
+
+		debtors[0].amount = debtors[0].amount.Sub(amount)
+		creditors[0].amount = creditors[0].amount.Sub(amount)
+		if !debtors[0].amount.IsPositive() {
+			debtors = debtors[1:]
+		}
+		if !creditors[0].amount.IsPositive() {
+			creditors = creditors[1:]
+		}
+	}
+
+	return transfers
+}
@@ -0,0 +1,5 @@
+package group
+
+func GetModels() []any {
+	return []any{&GroupEntity{}, &GroupMemberEntity{}, &GroupExpenseEntity{}, &GroupExpenseShareEntity{}, &SettlementEntity{}}
+}
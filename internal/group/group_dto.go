@@ -0,0 +1,52 @@
+package group
+
+import (
+	"time"
+)
+
+type CreateGroupRequest struct {
+	Name      string   `json:"name" validate:"required,max=100"`
+	Currency  string   `json:"currency" validate:"omitempty,iso4217"`
+	Usernames []string `json:"usernames"`
+}
+
+type UpdateGroupRequest struct {
+	Name *string `json:"name" validate:"omitempty,min=1,max=100"`
+}
+
+type AddMemberRequest struct {
+	Username string `json:"username" validate:"required"`
+}
+
+type MemberResponse struct {
+	UserID   uint      `json:"userId"`
+	Username string    `json:"username"`
+	JoinedAt time.Time `json:"joinedAt"`
+}
+
+type GroupResponse struct {
+	ID       uint             `json:"id"`
+	Name     string           `json:"name"`
+	Currency string           `json:"currency"`
+	OwnerID  uint             `json:"ownerId"`
+	Members  []MemberResponse `json:"members"`
+}
+
+func (GroupResponse) FromEntity(group GroupEntity) GroupResponse {
+	memberResponses := []MemberResponse{}
+	for _, member := range group.Members {
+		memberResponses = append(memberResponses, MemberResponse{
+			UserID:   member.UserID,
+			Username: member.User.Username,
+			JoinedAt: member.CreatedAt,
+		})
+	}
+
+	return GroupResponse{
+		ID:       group.ID,
+		Name:     group.Name,
+		Currency: group.Currency,
+		OwnerID:  group.OwnerID,
+		Members:  memberResponses,
+	}
+}
@@ -0,0 +1,34 @@
+package group
+
+import (
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	"gorm.io/gorm"
+)
+
+// GroupEntity is a set of users sharing costs, e.g. roommates or a trip. All of its amounts are in Currency.
+// The owner manages membership; every member can see the group and add expenses to it.
+type GroupEntity struct {
+	gorm.Model
+	OwnerID  uint                `gorm:"not null;index:idx_groups_owner"`
+	Name     string              `gorm:"type:varchar(100);not null"`
+	Currency string              `gorm:"type:char(3);not null"`
+	Members  []GroupMemberEntity `gorm:"foreignKey:GroupID"`
+}
+
+func (GroupEntity) TableName() string {
+	return "groups"
+}
+
+type GroupMemberEntity struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	GroupID   uint            `gorm:"not null;uniqueIndex:idx_group_members_group_user"`
+	UserID    uint            `gorm:"not null;uniqueIndex:idx_group_members_group_user;index:idx_group_members_user"`
+	User      user.UserEntity `gorm:"foreignKey:UserID"`
+}
+
+func (GroupMemberEntity) TableName() string {
+	return "group_members"
+}
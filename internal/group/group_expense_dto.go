@@ -0,0 +1,105 @@
+package group
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type CreateGroupExpenseRequest struct {
+	Date         time.Time            `json:"date" validate:"required"`
+	Amount       decimal.Decimal      `json:"amount" validate:"required"`
+	Note         string               `json:"note"`
+	PaidByID     uint                 `json:"paidById"`
+	SplitMethod  string               `json:"splitMethod" validate:"required,oneof=equal percentage shares exact"`
+	Participants []ParticipantRequest `json:"participants" validate:"required,min=1,dive"`
+	ExpenseID    *uint                `json:"expenseId"`
+}
+
+// ParticipantRequest names a member sharing an expense. Value is a percentage, a number of shares
+// or an exact amount depending on the split method, and is not needed for equal splits.
+type ParticipantRequest struct {
+	UserID uint            `json:"userId" validate:"required"`
+	Value  decimal.Decimal `json:"value"`
+}
+
+type CreateSettlementRequest struct {
+	Date       time.Time       `json:"date" validate:"required"`
+	FromUserID uint            `json:"fromUserId"`
+	ToUserID   uint            `json:"toUserId" validate:"required"`
+	Amount     decimal.Decimal `json:"amount" validate:"required"`
+	Note       string          `json:"note"`
+}
+
+type ShareResponse struct {
+	UserID uint            `json:"userId"`
+	Amount decimal.Decimal `json:"amount"`
+}
+
+type GroupExpenseResponse struct {
+	ID          uint            `json:"id"`
+	Date        time.Time       `json:"date"`
+	Amount      decimal.Decimal `json:"amount"`
+	Note        string          `json:"note"`
+	PaidByID    uint            `json:"paidById"`
+	CreatedByID uint            `json:"createdById"`
+	SplitMethod SplitMethod     `json:"splitMethod"`
+	ExpenseID   *uint           `json:"expenseId"`
+	Shares      []ShareResponse `json:"shares"`
+}
+
+func (GroupExpenseResponse) FromEntity(expense GroupExpenseEntity) GroupExpenseResponse {
+	shareResponses := []ShareResponse{}
+	for _, share := range expense.Shares {
+		shareResponses = append(shareResponses, ShareResponse{UserID: share.UserID, Amount: share.Amount})
+	}
+
+	return GroupExpenseResponse{
+		ID:          expense.ID,
+		Date:        time.Unix(expense.Date, 0),
+		Amount:      expense.Amount,
+		Note:        expense.Note,
+		PaidByID:    expense.PaidByID,
+		CreatedByID: expense.CreatedByID,
+		SplitMethod: expense.SplitMethod,
+		ExpenseID:   expense.ExpenseID,
+		Shares:      shareResponses,
+	}
+}
+
+type SettlementResponse struct {
+	ID          uint            `json:"id"`
+	Date        time.Time       `json:"date"`
+	FromUserID  uint            `json:"fromUserId"`
+	ToUserID    uint            `json:"toUserId"`
+	Amount      decimal.Decimal `json:"amount"`
+	Note        string          `json:"note"`
+	CreatedByID uint            `json:"createdById"`
+}
+
+func (SettlementResponse) FromEntity(settlement SettlementEntity) SettlementResponse {
+	return SettlementResponse{
+		ID:          settlement.ID,
+		Date:        time.Unix(settlement.Date, 0),
+		FromUserID:  settlement.FromUserID,
+		ToUserID:    settlement.ToUserID,
+		Amount:      settlement.Amount,
+		Note:        settlement.Note,
+		CreatedByID: settlement.CreatedByID,
+	}
+}
+
+type MemberBalance struct {
+	UserID   uint            `json:"userId"`
+	Username string          `json:"username"`
+	Paid     decimal.Decimal `json:"paid"`
+	Owed     decimal.Decimal `json:"owed"`
+	Net      decimal.Decimal `json:"net"`
+}
+
+// Balances shows where each member stands and the transfers that would settle the group.
+type Balances struct {
+	Currency  string          `json:"currency"`
+	Members   []MemberBalance `json:"members"`
+	Transfers []Transfer      `json:"transfers"`
+}
@@ -0,0 +1,61 @@
+package group
+
+import (
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type SplitMethod string
+
+const (
+	SplitMethodEqual      SplitMethod = "equal"
+	SplitMethodPercentage SplitMethod = "percentage"
+	SplitMethodShares     SplitMethod = "shares"
+	SplitMethodExact      SplitMethod = "exact"
+)
+
+// GroupExpenseEntity is paid by one member and owed by the members in Shares, whose amounts add up to Amount.
+// ExpenseID optionally links the payer's own expense for the same purchase.
+type GroupExpenseEntity struct {
+	gorm.Model
+	GroupID     uint                      `gorm:"not null;index:idx_group_expenses_group_date"`
+	Date        int64                     `gorm:"not null;index:idx_group_expenses_group_date"`
+	CreatedByID uint                      `gorm:"not null"`
+	PaidByID    uint                      `gorm:"not null"`
+	Amount      decimal.Decimal           `gorm:"type:decimal(15,2);not null"`
+	Note        string                    `gorm:"type:text"`
+	SplitMethod SplitMethod               `gorm:"type:varchar(10);not null"`
+	ExpenseID   *uint                     `gorm:"index:idx_group_expenses_expense"`
+	Shares      []GroupExpenseShareEntity `gorm:"foreignKey:GroupExpenseID"`
+}
+
+func (GroupExpenseEntity) TableName() string {
+	return "group_expenses"
+}
+
+type GroupExpenseShareEntity struct {
+	ID             uint            `gorm:"primarykey"`
+	GroupExpenseID uint            `gorm:"not null;index"`
+	UserID         uint            `gorm:"not null"`
+	Amount         decimal.Decimal `gorm:"type:decimal(15,2);not null"`
+}
+
+func (GroupExpenseShareEntity) TableName() string {
+	return "group_expense_shares"
+}
+
+// SettlementEntity records a repayment from one member to another.
+type SettlementEntity struct {
+	gorm.Model
+	GroupID     uint            `gorm:"not null;index:idx_group_settlements_group"`
+	Date        int64           `gorm:"not null"`
+	CreatedByID uint            `gorm:"not null"`
+	FromUserID  uint            `gorm:"not null"`
+	ToUserID    uint            `gorm:"not null"`
+	Amount      decimal.Decimal `gorm:"type:decimal(15,2);not null"`
+	Note        string          `gorm:"type:text"`
+}
+
+func (SettlementEntity) TableName() string {
+	return "group_settlements"
+}
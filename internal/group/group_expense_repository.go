@@ -0,0 +1,105 @@
+package group
+
+import "gorm.io/gorm"
+
+type GroupExpenseRepository interface {
+	WithTx(tx *gorm.DB) GroupExpenseRepository
+	GetByGroup(groupID uint) ([]GroupExpenseEntity, error)
+	GetByIDAndGroup(id uint, groupID uint) (*GroupExpenseEntity, error)
+	Create(expense *GroupExpenseEntity) error
+	Delete(id uint) error
+	GetSettlements(groupID uint) ([]SettlementEntity, error)
+	CreateSettlement(settlement *SettlementEntity) error
+	GetMemberTotals(groupID uint) ([]MemberTotalRow, error)
+}
+
+type groupExpenseRepository struct {
+	db *gorm.DB
+}
+
+func NewGroupExpenseRepository(db *gorm.DB) GroupExpenseRepository {
+	return &groupExpenseRepository{db: db}
+}
+
+func (r *groupExpenseRepository) WithTx(tx *gorm.DB) GroupExpenseRepository {
+	if tx == nil {
+		return r
+	}
+
+	return &groupExpenseRepository{db: tx}
+}
+
+func (r *groupExpenseRepository) GetByGroup(groupID uint) ([]GroupExpenseEntity, error) {
+	var expenses []GroupExpenseEntity
+	if err := r.db.Preload("Shares", orderByID).
+		Where("group_id = ?", groupID).
+		Order("date DESC, id DESC").
+		Find(&expenses).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return expenses, nil
+}
+
+func (r *groupExpenseRepository) GetByIDAndGroup(id uint, groupID uint) (*GroupExpenseEntity, error) {
+	var expense GroupExpenseEntity
+	if err := r.db.Preload("Shares", orderByID).
+		Where("id = ?", id).
+		Where("group_id = ?", groupID).
+		First(&expense).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &expense, nil
+}
+
+func (r *groupExpenseRepository) Create(expense *GroupExpenseEntity) error {
+	return r.db.Create(expense).Error
+}
+
+func (r *groupExpenseRepository) Delete(id uint) error {
+	return r.db.Delete(&GroupExpenseEntity{}, id).Error
+}
+
+func (r *groupExpenseRepository) GetSettlements(groupID uint) ([]SettlementEntity, error) {
+	var settlements []SettlementEntity
+	if err := r.db.Where("group_id = ?", groupID).
+		Order("date DESC, id DESC").
+		Find(&settlements).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return settlements, nil
+}
+
+func (r *groupExpenseRepository) CreateSettlement(settlement *SettlementEntity) error {
+	return r.db.Create(settlement).Error
+}
+
+// GetMemberTotals sums, for every current member, what they paid and owe across the group's expenses
+// and what they repaid and were repaid in settlements.
+func (r *groupExpenseRepository) GetMemberTotals(groupID uint) ([]MemberTotalRow, error) {
+	var rows []MemberTotalRow
+	if err := r.db.Table("group_members m").
+		Select(`m.user_id, u.username,
+			COALESCE((SELECT SUM(e.amount) FROM group_expenses e
+				WHERE e.group_id = m.group_id AND e.paid_by_id = m.user_id AND e.deleted_at IS NULL), 0) AS paid,
+			COALESCE((SELECT SUM(s.amount) FROM group_expense_shares s JOIN group_expenses e ON e.id = s.group_expense_id
+				WHERE e.group_id = m.group_id AND s.user_id = m.user_id AND e.deleted_at IS NULL), 0) AS owed,
+			COALESCE((SELECT SUM(st.amount) FROM group_settlements st
+				WHERE st.group_id = m.group_id AND st.from_user_id = m.user_id AND st.deleted_at IS NULL), 0) AS sent,
+			COALESCE((SELECT SUM(st.amount) FROM group_settlements st
+				WHERE st.group_id = m.group_id AND st.to_user_id = m.user_id AND st.deleted_at IS NULL), 0) AS received`).
+		Joins("JOIN users u ON u.id = m.user_id").
+		Where("m.group_id = ?", groupID).
+		Order("m.id").
+		Scan(&rows).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,222 @@
+package group
+
+import (
+	"sort"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/shopspring/decimal"
+)
+
+type GroupExpenseService interface {
+	GetExpenses(groupID uint, authUserID uint) ([]GroupExpenseEntity, error)
+	CreateExpense(groupID uint, authUserID uint, dto CreateGroupExpenseRequest) (*GroupExpenseEntity, error)
+	DeleteExpense(groupID uint, id uint, authUserID uint) error
+	GetBalances(groupID uint, authUserID uint) (*Balances, error)
+	GetSettlements(groupID uint, authUserID uint) ([]SettlementEntity, error)
+	SettleUp(groupID uint, authUserID uint, dto CreateSettlementRequest) (*SettlementEntity, error)
+}
+
+type groupExpenseService struct {
+	groupRepo        GroupRepository
+	groupExpenseRepo GroupExpenseRepository
+	expenseRepo      expense.ExpenseRepository
+}
+
+func NewGroupExpenseService(groupRepo GroupRepository, groupExpenseRepo GroupExpenseRepository, expenseRepo expense.ExpenseRepository) GroupExpenseService {
+	return &groupExpenseService{
+		groupRepo:        groupRepo,
+		groupExpenseRepo: groupExpenseRepo,
+		expenseRepo:      expenseRepo,
+	}
+}
+
+func (s *groupExpenseService) GetExpenses(groupID uint, authUserID uint) ([]GroupExpenseEntity, error) {
+	if err := s.checkMember(groupID, authUserID); err != nil {
+		return nil, err
+	}
+
+	return s.groupExpenseRepo.GetByGroup(groupID)
+}
+
+// CreateExpense records an expense paid by one member, the user unless given, and shared by the participants.
+// The payer and every participant must belong to the group, and only the payer can link their own expense.
+func (s *groupExpenseService) CreateExpense(groupID uint, authUserID uint, dto CreateGroupExpenseRequest) (*GroupExpenseEntity, error) {
+	if err := s.checkMember(groupID, authUserID); err != nil {
+		return nil, err
+	}
+
+	if !dto.Amount.IsPositive() {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	paidByID := dto.PaidByID
+	if paidByID == 0 {
+		paidByID = authUserID
+	}
+
+	shares, err := splitAmount(dto.Amount, SplitMethod(dto.SplitMethod), dto.Participants)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := []uint{paidByID}
+	for _, share := range shares {
+		if share.UserID != paidByID {
+			userIDs = append(userIDs, share.UserID)
+		}
+	}
+	count, err := s.groupRepo.CountMembers(groupID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	if count != int64(len(userIDs)) {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	if dto.ExpenseID != nil {
+		if paidByID != authUserID {
+			return nil, apperror.ErrForbidden
+		}
+		isOwner, err := s.expenseRepo.IsOwner(*dto.ExpenseID, authUserID)
+		if err != nil {
+			return nil, err
+		}
+		if !isOwner {
+			return nil, apperror.ErrNotFound
+		}
+	}
+
+	groupExpense := &GroupExpenseEntity{
+		GroupID:     groupID,
+		Date:        dto.Date.Unix(),
+		CreatedByID: authUserID,
+		PaidByID:    paidByID,
+		Amount:      dto.Amount,
+		Note:        dto.Note,
+		SplitMethod: SplitMethod(dto.SplitMethod),
+		ExpenseID:   dto.ExpenseID,
+		Shares:      shares,
+	}
+
+	if err := s.groupExpenseRepo.Create(groupExpense); err != nil {
+		return nil, err
+	}
+
+	return groupExpense, nil
+}
+
+// DeleteExpense is open to whoever added or paid the expense, and to the group's owner.
+func (s *groupExpenseService) DeleteExpense(groupID uint, id uint, authUserID uint) error {
+	group, err := s.groupRepo.GetByIDAndMember(groupID, authUserID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	groupExpense, err := s.groupExpenseRepo.GetByIDAndGroup(id, groupID)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+
+	if authUserID != groupExpense.CreatedByID && authUserID != groupExpense.PaidByID && authUserID != group.OwnerID {
+		return apperror.ErrForbidden
+	}
+
+	return s.groupExpenseRepo.Delete(groupExpense.ID)
+}
+
+func (s *groupExpenseService) GetBalances(groupID uint, authUserID uint) (*Balances, error) {
+	group, err := s.groupRepo.GetByIDAndMember(groupID, authUserID)
+	if err != nil {
+		return nil, apperror.ErrNotFound
+	}
+
+	totals, err := s.groupExpenseRepo.GetMemberTotals(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := &Balances{Currency: group.Currency, Members: []MemberBalance{}}
+	nets := map[uint]decimal.Decimal{}
+	for _, total := range totals {
+		net := total.Net()
+		nets[total.UserID] = net
+		balances.Members = append(balances.Members, MemberBalance{
+			UserID:   total.UserID,
+			Username: total.Username,
+			Paid:     total.Paid,
+			Owed:     total.Owed,
+			Net:      net,
+		})
+	}
+	sort.SliceStable(balances.Members, func(i, j int) bool {
+		return balances.Members[i].Net.LessThan(balances.Members[j].Net)
+	})
+	balances.Transfers = simplifyDebts(nets)
+
+	return balances, nil
+}
+
+func (s *groupExpenseService) GetSettlements(groupID uint, authUserID uint) ([]SettlementEntity, error) {
+	if err := s.checkMember(groupID, authUserID); err != nil {
+		return nil, err
+	}
+
+	return s.groupExpenseRepo.GetSettlements(groupID)
+}
+
+// SettleUp records a repayment between two members; only the one paying or the one paid can record it.
+func (s *groupExpenseService) SettleUp(groupID uint, authUserID uint, dto CreateSettlementRequest) (*SettlementEntity, error) {
+	if err := s.checkMember(groupID, authUserID); err != nil {
+		return nil, err
+	}
+
+	fromUserID := dto.FromUserID
+	if fromUserID == 0 {
+		fromUserID = authUserID
+	}
+
+	if !dto.Amount.IsPositive() || !isCents(dto.Amount) || fromUserID == dto.ToUserID {
+		return nil, apperror.ErrInvalidRequest
+	}
+	if authUserID != fromUserID && authUserID != dto.ToUserID {
+		return nil, apperror.ErrForbidden
+	}
+
+	count, err := s.groupRepo.CountMembers(groupID, []uint{fromUserID, dto.ToUserID})
+	if err != nil {
+		return nil, err
+	}
+	if count != 2 {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	settlement := &SettlementEntity{
+		GroupID:     groupID,
+		Date:        dto.Date.Unix(),
+		CreatedByID: authUserID,
+		FromUserID:  fromUserID,
+		ToUserID:    dto.ToUserID,
+		Amount:      dto.Amount,
+		Note:        dto.Note,
+	}
+
+	if err := s.groupExpenseRepo.CreateSettlement(settlement); err != nil {
+		return nil, err
+	}
+
+	return settlement, nil
+}
+
+// checkMember hides groups from anyone outside them.
+func (s *groupExpenseService) checkMember(groupID uint, authUserID uint) error {
+	isMember, err := s.groupRepo.IsMember(groupID, authUserID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return apperror.ErrNotFound
+	}
+
+	return nil
+}
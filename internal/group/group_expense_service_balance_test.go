@@ -0,0 +1,103 @@
+package group_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	expenseMocks "github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/group"
+	"github.com/Perajit/expense-tracker-go/internal/group/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestGetBalances(t *testing.T) {
+	var groupID uint = 5
+	var userID uint = 11
+
+	t.Run("success", func(t *testing.T) {
+		// Ann paid 90 for four, Ben paid 30 for four and already repaid Ann 10
+		totals := []group.MemberTotalRow{
+			{UserID: 11, Username: "ann", Paid: decimal.NewFromInt(90), Owed: decimal.NewFromInt(30), Received: decimal.NewFromInt(10)},
+			{UserID: 12, Username: "ben", Paid: decimal.NewFromInt(30), Owed: decimal.NewFromInt(30), Sent: decimal.NewFromInt(10)},
+			{UserID: 13, Username: "cat", Owed: decimal.NewFromInt(30)},
+			{UserID: 14, Username: "dan", Owed: decimal.NewFromInt(30)},
+		}
+
+		mockGroupRepo := new(mocks.MockGroupRepository)
+		mockGroupRepo.On("GetByIDAndMember", groupID, userID).Return(&group.GroupEntity{Model: gorm.Model{ID: groupID}, Currency: "EUR"}, nil).Once()
+
+		mockGroupExpenseRepo := new(mocks.MockGroupExpenseRepository)
+		mockGroupExpenseRepo.On("GetMemberTotals", groupID).Return(totals, nil).Once()
+
+		service := group.NewGroupExpenseService(mockGroupRepo, mockGroupExpenseRepo, new(expenseMocks.MockExpenseRepository))
+		balances, err := service.GetBalances(groupID, userID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "EUR", balances.Currency)
+		assert.Equal(t, []string{"-30", "-30", "10", "50"}, []string{
+			balances.Members[0].Net.String(), balances.Members[1].Net.String(),
+			balances.Members[2].Net.String(), balances.Members[3].Net.String(),
+		})
+		assert.Equal(t, []group.Transfer{
+			{FromUserID: 13, ToUserID: 11, Amount: decimal.NewFromInt(30)},
+			{FromUserID: 14, ToUserID: 11, Amount: decimal.NewFromInt(20)},
+			{FromUserID: 14, ToUserID: 12, Amount: decimal.NewFromInt(10)},
+		}, balances.Transfers)
+	})
+
+	t.Run("error_not_member", func(t *testing.T) {
+		mockGroupRepo := new(mocks.MockGroupRepository)
+		mockGroupRepo.On("GetByIDAndMember", groupID, userID).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		service := group.NewGroupExpenseService(mockGroupRepo, new(mocks.MockGroupExpenseRepository), new(expenseMocks.MockExpenseRepository))
+		balances, err := service.GetBalances(groupID, userID)
+
+		assert.Nil(t, balances)
+		assert.Equal(t, apperror.ErrNotFound, err)
+	})
+}
+
+func TestSettleUp(t *testing.T) {
+	var groupID uint = 5
+	var userID uint = 11
+
+	t.Run("success", func(t *testing.T) {
+		dto := group.CreateSettlementRequest{Date: time.Now(), ToUserID: 12, Amount: decimal.NewFromInt(25)}
+
+		mockGroupRepo := new(mocks.MockGroupRepository)
+		mockGroupRepo.On("IsMember", groupID, userID).Return(true, nil).Once()
+		mockGroupRepo.On("CountMembers", groupID, []uint{userID, 12}).Return(int64(2), nil).Once()
+
+		mockGroupExpenseRepo := new(mocks.MockGroupExpenseRepository)
+		mockGroupExpenseRepo.On("CreateSettlement", mock.MatchedBy(func(s *group.SettlementEntity) bool {
+			return s.FromUserID == userID && s.ToUserID == 12 && s.CreatedByID == userID
+		})).Return(nil).Once()
+
+		service := group.NewGroupExpenseService(mockGroupRepo, mockGroupExpenseRepo, new(expenseMocks.MockExpenseRepository))
+		settlement, err := service.SettleUp(groupID, userID, dto)
+
+		assert.NoError(t, err)
+		assert.True(t, settlement.Amount.Equal(dto.Amount))
+		mockGroupExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_third_party", func(t *testing.T) {
+		dto := group.CreateSettlementRequest{Date: time.Now(), FromUserID: 13, ToUserID: 12, Amount: decimal.NewFromInt(25)}
+
+		mockGroupRepo := new(mocks.MockGroupRepository)
+		mockGroupRepo.On("IsMember", groupID, userID).Return(true, nil).Once()
+
+		mockGroupExpenseRepo := new(mocks.MockGroupExpenseRepository)
+
+		service := group.NewGroupExpenseService(mockGroupRepo, mockGroupExpenseRepo, new(expenseMocks.MockExpenseRepository))
+		settlement, err := service.SettleUp(groupID, userID, dto)
+
+		assert.Nil(t, settlement)
+		assert.Equal(t, apperror.ErrForbidden, err)
+		mockGroupExpenseRepo.AssertNotCalled(t, "CreateSettlement", mock.Anything)
+	})
+}
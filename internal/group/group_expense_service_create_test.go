@@ -0,0 +1,156 @@
+package group_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	expenseMocks "github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/group"
+	"github.com/Perajit/expense-tracker-go/internal/group/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateGroupExpense(t *testing.T) {
+	var groupID uint = 5
+	var userID uint = 11
+
+	shareAmounts := func(e *group.GroupExpenseEntity) []string {
+		amounts := []string{}
+		for _, share := range e.Shares {
+			amounts = append(amounts, share.Amount.StringFixed(2))
+		}
+		return amounts
+	}
+
+	t.Run("success_equal", func(t *testing.T) {
+		dto := group.CreateGroupExpenseRequest{
+			Date:         time.Now(),
+			Amount:       decimal.NewFromInt(100),
+			Note:         "groceries",
+			SplitMethod:  "equal",
+			Participants: []group.ParticipantRequest{{UserID: 11}, {UserID: 12}, {UserID: 13}},
+		}
+
+		mockGroupRepo := new(mocks.MockGroupRepository)
+		mockGroupRepo.On("IsMember", groupID, userID).Return(true, nil).Once()
+		mockGroupRepo.On("CountMembers", groupID, []uint{11, 12, 13}).Return(int64(3), nil).Once()
+
+		mockGroupExpenseRepo := new(mocks.MockGroupExpenseRepository)
+		mockGroupExpenseRepo.On("Create", mock.AnythingOfType("*group.GroupExpenseEntity")).Return(nil).Once()
+
+		service := group.NewGroupExpenseService(mockGroupRepo, mockGroupExpenseRepo, new(expenseMocks.MockExpenseRepository))
+		expense, err := service.CreateExpense(groupID, userID, dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, userID, expense.PaidByID)
+		assert.Equal(t, []string{"33.34", "33.33", "33.33"}, shareAmounts(expense))
+		mockGroupExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_shares", func(t *testing.T) {
+		dto := group.CreateGroupExpenseRequest{
+			Date:        time.Now(),
+			Amount:      decimal.NewFromInt(90),
+			PaidByID:    12,
+			SplitMethod: "shares",
+			Participants: []group.ParticipantRequest{
+				{UserID: 11, Value: decimal.NewFromInt(2)},
+				{UserID: 12, Value: decimal.NewFromInt(1)},
+			},
+		}
+
+		mockGroupRepo := new(mocks.MockGroupRepository)
+		mockGroupRepo.On("IsMember", groupID, userID).Return(true, nil).Once()
+		mockGroupRepo.On("CountMembers", groupID, []uint{12, 11}).Return(int64(2), nil).Once()
+
+		mockGroupExpenseRepo := new(mocks.MockGroupExpenseRepository)
+		mockGroupExpenseRepo.On("Create", mock.AnythingOfType("*group.GroupExpenseEntity")).Return(nil).Once()
+
+		service := group.NewGroupExpenseService(mockGroupRepo, mockGroupExpenseRepo, new(expenseMocks.MockExpenseRepository))
+		expense, err := service.CreateExpense(groupID, userID, dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"60.00", "30.00"}, shareAmounts(expense))
+	})
+
+	t.Run("error_percentages", func(t *testing.T) {
+		dto := group.CreateGroupExpenseRequest{
+			Date:        time.Now(),
+			Amount:      decimal.NewFromInt(90),
+			SplitMethod: "percentage",
+			Participants: []group.ParticipantRequest{
+				{UserID: 11, Value: decimal.NewFromInt(60)},
+				{UserID: 12, Value: decimal.NewFromInt(30)},
+			},
+		}
+
+		mockGroupRepo := new(mocks.MockGroupRepository)
+		mockGroupRepo.On("IsMember", groupID, userID).Return(true, nil).Once()
+
+		mockGroupExpenseRepo := new(mocks.MockGroupExpenseRepository)
+
+		service := group.NewGroupExpenseService(mockGroupRepo, mockGroupExpenseRepo, new(expenseMocks.MockExpenseRepository))
+		expense, err := service.CreateExpense(groupID, userID, dto)
+
+		assert.Nil(t, expense)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockGroupExpenseRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("error_fraction_of_cent", func(t *testing.T) {
+		dto := group.CreateGroupExpenseRequest{
+			Date:         time.Now(),
+			Amount:       decimal.RequireFromString("10.005"),
+			SplitMethod:  "equal",
+			Participants: []group.ParticipantRequest{{UserID: 11}, {UserID: 12}},
+		}
+
+		mockGroupRepo := new(mocks.MockGroupRepository)
+		mockGroupRepo.On("IsMember", groupID, userID).Return(true, nil).Once()
+
+		mockGroupExpenseRepo := new(mocks.MockGroupExpenseRepository)
+
+		service := group.NewGroupExpenseService(mockGroupRepo, mockGroupExpenseRepo, new(expenseMocks.MockExpenseRepository))
+		expense, err := service.CreateExpense(groupID, userID, dto)
+
+		assert.Nil(t, expense)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		mockGroupExpenseRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("error_participant_not_member", func(t *testing.T) {
+		dto := group.CreateGroupExpenseRequest{
+			Date:         time.Now(),
+			Amount:       decimal.NewFromInt(20),
+			SplitMethod:  "equal",
+			Participants: []group.ParticipantRequest{{UserID: 11}, {UserID: 99}},
+		}
+
+		mockGroupRepo := new(mocks.MockGroupRepository)
+		mockGroupRepo.On("IsMember", groupID, userID).Return(true, nil).Once()
+		mockGroupRepo.On("CountMembers", groupID, []uint{11, 99}).Return(int64(1), nil).Once()
+
+		mockGroupExpenseRepo := new(mocks.MockGroupExpenseRepository)
+
+		service := group.NewGroupExpenseService(mockGroupRepo, mockGroupExpenseRepo, new(expenseMocks.MockExpenseRepository))
+		expense, err := service.CreateExpense(groupID, userID, dto)
+
+		assert.Nil(t, expense)
+		assert.Equal(t, apperror.ErrInvalidRequest, err)
+		mockGroupExpenseRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("error_not_member", func(t *testing.T) {
+		mockGroupRepo := new(mocks.MockGroupRepository)
+		mockGroupRepo.On("IsMember", groupID, userID).Return(false, nil).Once()
+
+		service := group.NewGroupExpenseService(mockGroupRepo, new(mocks.MockGroupExpenseRepository), new(expenseMocks.MockExpenseRepository))
+		expense, err := service.CreateExpense(groupID, userID, group.CreateGroupExpenseRequest{})
+
+		assert.Nil(t, expense)
+		assert.Equal(t, apperror.ErrNotFound, err)
+	})
+}
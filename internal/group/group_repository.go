@@ -0,0 +1,105 @@
+package group
+
+import "gorm.io/gorm"
+
+type GroupRepository interface {
+	WithTx(tx *gorm.DB) GroupRepository
+	GetByMember(userID uint) ([]GroupEntity, error)
+	GetByIDAndMember(id uint, userID uint) (*GroupEntity, error)
+	IsMember(id uint, userID uint) (bool, error)
+	CountMembers(id uint, userIDs []uint) (int64, error)
+	Create(group *GroupEntity) error
+	Update(group *GroupEntity) error
+	Delete(id uint) error
+	AddMember(member *GroupMemberEntity) error
+	RemoveMember(id uint, userID uint) error
+}
+
+type groupRepository struct {
+	db *gorm.DB
+}
+
+func NewGroupRepository(db *gorm.DB) GroupRepository {
+	return &groupRepository{db: db}
+}
+
+func (r *groupRepository) WithTx(tx *gorm.DB) GroupRepository {
+	if tx == nil {
+		return r
+	}
+
+	return &groupRepository{db: tx}
+}
+
+func (r *groupRepository) GetByMember(userID uint) ([]GroupEntity, error) {
+	var groups []GroupEntity
+	if err := r.db.Preload("Members", orderByID).
+		Preload("Members.User").
+		Where("id IN (SELECT group_id FROM group_members WHERE user_id = ?)", userID).
+		Order("name").
+		Find(&groups).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// GetByIDAndMember finds a group only for its members; to anyone else it does not exist.
+func (r *groupRepository) GetByIDAndMember(id uint, userID uint) (*GroupEntity, error) {
+	var group GroupEntity
+	if err := r.db.Preload("Members", orderByID).
+		Preload("Members.User").
+		Where("id = ?", id).
+		Where("id IN (SELECT group_id FROM group_members WHERE user_id = ?)", userID).
+		First(&group).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+func (r *groupRepository) IsMember(id uint, userID uint) (bool, error) {
+	count, err := r.CountMembers(id, []uint{userID})
+
+	return count > 0, err
+}
+
+// CountMembers counts how many of the given users belong to the group.
+func (r *groupRepository) CountMembers(id uint, userIDs []uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&GroupMemberEntity{}).
+		Where("group_id = ?", id).
+		Where("group_id IN (SELECT id FROM groups WHERE deleted_at IS NULL)").
+		Where("user_id IN ?", userIDs).
+		Count(&count).
+		Error
+
+	return count, err
+}
+
+func (r *groupRepository) Create(group *GroupEntity) error {
+	return r.db.Create(group).Error
+}
+
+func (r *groupRepository) Update(group *GroupEntity) error {
+	return r.db.Omit("Members").Save(group).Error
+}
+
+func (r *groupRepository) Delete(id uint) error {
+	return r.db.Delete(&GroupEntity{}, id).Error
+}
+
+func (r *groupRepository) AddMember(member *GroupMemberEntity) error {
+	return r.db.Omit("User").Create(member).Error
+}
+
+func (r *groupRepository) RemoveMember(id uint, userID uint) error {
+	return r.db.Where("group_id = ?", id).Where("user_id = ?", userID).Delete(&GroupMemberEntity{}).Error
+}
+
+// orderByID keeps preloaded rows in the order they were written.
+func orderByID(db *gorm.DB) *gorm.DB {
+	return db.Order("id")
+}
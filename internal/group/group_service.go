@@ -0,0 +1,181 @@
+package group
+
+import (
+	"slices"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	"gorm.io/gorm"
+)
+
+type GroupService interface {
+	GetGroups(authUserID uint) ([]GroupEntity, error)
+	GetGroupByID(id uint, authUserID uint) (*GroupEntity, error)
+	CreateGroup(authUserID uint, dto CreateGroupRequest) (*GroupEntity, error)
+	UpdateGroup(id uint, authUserID uint, dto UpdateGroupRequest) error
+	DeleteGroup(id uint, authUserID uint) error
+	AddMember(id uint, authUserID uint, dto AddMemberRequest) error
+	RemoveMember(id uint, userID uint, authUserID uint) error
+}
+
+type groupService struct {
+	db               *gorm.DB
+	groupRepo        GroupRepository
+	groupExpenseRepo GroupExpenseRepository
+	userService      user.UserService
+}
+
+func NewGroupService(db *gorm.DB, groupRepo GroupRepository, groupExpenseRepo GroupExpenseRepository, userService user.UserService) GroupService {
+	return &groupService{
+		db:               db,
+		groupRepo:        groupRepo,
+		groupExpenseRepo: groupExpenseRepo,
+		userService:      userService,
+	}
+}
+
+func (s *groupService) GetGroups(authUserID uint) ([]GroupEntity, error) {
+	return s.groupRepo.GetByMember(authUserID)
+}
+
+func (s *groupService) GetGroupByID(id uint, authUserID uint) (*GroupEntity, error) {
+	group, err := s.groupRepo.GetByIDAndMember(id, authUserID)
+	if err != nil {
+		return nil, apperror.ErrNotFound
+	}
+
+	return group, nil
+}
+
+// CreateGroup makes the user the owner and first member; the group's currency defaults to the owner's.
+func (s *groupService) CreateGroup(authUserID uint, dto CreateGroupRequest) (*GroupEntity, error) {
+	owner, err := s.userService.GetUserByID(authUserID, authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	users := []user.UserEntity{*owner}
+	for _, username := range dto.Usernames {
+		member, err := s.userService.GetUserByUsername(username)
+		if err != nil {
+			return nil, apperror.ErrNotFound
+		}
+		if !slices.ContainsFunc(users, func(u user.UserEntity) bool { return u.ID == member.ID }) {
+			users = append(users, *member)
+		}
+	}
+
+	group := &GroupEntity{
+		OwnerID:  authUserID,
+		Name:     dto.Name,
+		Currency: dto.Currency,
+	}
+	if group.Currency == "" {
+		group.Currency = owner.DefaultCurrency
+	}
+	for _, u := range users {
+		group.Members = append(group.Members, GroupMemberEntity{UserID: u.ID})
+	}
+
+	if err := s.groupRepo.Create(group); err != nil {
+		return nil, err
+	}
+
+	for i := range group.Members {
+		group.Members[i].User = users[i]
+	}
+
+	return group, nil
+}
+
+func (s *groupService) UpdateGroup(id uint, authUserID uint, dto UpdateGroupRequest) error {
+	group, err := s.ownedGroup(id, authUserID)
+	if err != nil {
+		return err
+	}
+
+	if dto.Name != nil {
+		group.Name = *dto.Name
+	}
+
+	return s.groupRepo.Update(group)
+}
+
+// DeleteGroup is only possible once everyone is settled up, so no debt disappears with the group.
+func (s *groupService) DeleteGroup(id uint, authUserID uint) error {
+	if _, err := s.ownedGroup(id, authUserID); err != nil {
+		return err
+	}
+
+	totals, err := s.groupExpenseRepo.GetMemberTotals(id)
+	if err != nil {
+		return err
+	}
+	for _, total := range totals {
+		if !total.Net().IsZero() {
+			return apperror.ErrConflict
+		}
+	}
+
+	return s.groupRepo.Delete(id)
+}
+
+func (s *groupService) AddMember(id uint, authUserID uint, dto AddMemberRequest) error {
+	group, err := s.ownedGroup(id, authUserID)
+	if err != nil {
+		return err
+	}
+
+	member, err := s.userService.GetUserByUsername(dto.Username)
+	if err != nil {
+		return apperror.ErrNotFound
+	}
+	if slices.ContainsFunc(group.Members, func(m GroupMemberEntity) bool { return m.UserID == member.ID }) {
+		return apperror.ErrRecordDuplication
+	}
+
+	return s.groupRepo.AddMember(&GroupMemberEntity{GroupID: group.ID, UserID: member.ID})
+}
+
+// RemoveMember lets the owner remove anyone but themselves and any other member leave.
+// A member can only go once their balance is settled.
+func (s *groupService) RemoveMember(id uint, userID uint, authUserID uint) error {
+	group, err := s.GetGroupByID(id, authUserID)
+	if err != nil {
+		return err
+	}
+
+	if userID != authUserID && group.OwnerID != authUserID {
+		return apperror.ErrForbidden
+	}
+	if userID == group.OwnerID {
+		return apperror.ErrInvalidRequest
+	}
+
+	totals, err := s.groupExpenseRepo.GetMemberTotals(id)
+	if err != nil {
+		return err
+	}
+	index := slices.IndexFunc(totals, func(total MemberTotalRow) bool { return total.UserID == userID })
+	if index < 0 {
+		return apperror.ErrNotFound
+	}
+	if !totals[index].Net().IsZero() {
+		return apperror.ErrConflict
+	}
+
+	return s.groupRepo.RemoveMember(id, userID)
+}
+
+// ownedGroup finds a group the user belongs to and fails with ErrForbidden unless they also own it.
+func (s *groupService) ownedGroup(id uint, authUserID uint) (*GroupEntity, error) {
+	group, err := s.GetGroupByID(id, authUserID)
+	if err != nil {
+		return nil, err
+	}
+	if group.OwnerID != authUserID {
+		return nil, apperror.ErrForbidden
+	}
+
+	return group, nil
+}
@@ -0,0 +1,109 @@
+package group_test
+
+import (
+	"testing"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/group"
+	"github.com/Perajit/expense-tracker-go/internal/group/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestAddMember(t *testing.T) {
+	var groupID uint = 5
+	var ownerID uint = 11
+	existing := &group.GroupEntity{Model: gorm.Model{ID: groupID}, OwnerID: ownerID, Members: []group.GroupMemberEntity{{GroupID: groupID, UserID: ownerID}}}
+
+	t.Run("success", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockGroupRepo := new(mocks.MockGroupRepository)
+		mockGroupRepo.On("GetByIDAndMember", groupID, ownerID).Return(existing, nil).Once()
+		mockGroupRepo.On("AddMember", &group.GroupMemberEntity{GroupID: groupID, UserID: 12}).Return(nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByUsername", "ben").Return(&user.UserEntity{Model: gorm.Model{ID: 12}, Username: "ben"}, nil).Once()
+
+		service := group.NewGroupService(db, mockGroupRepo, new(mocks.MockGroupExpenseRepository), mockUserService)
+		err := service.AddMember(groupID, ownerID, group.AddMemberRequest{Username: "ben"})
+
+		assert.NoError(t, err)
+		mockGroupRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_not_owner", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockGroupRepo := new(mocks.MockGroupRepository)
+		mockGroupRepo.On("GetByIDAndMember", groupID, uint(12)).Return(existing, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+
+		service := group.NewGroupService(db, mockGroupRepo, new(mocks.MockGroupExpenseRepository), mockUserService)
+		err := service.AddMember(groupID, 12, group.AddMemberRequest{Username: "cat"})
+
+		assert.Equal(t, apperror.ErrForbidden, err)
+		mockGroupRepo.AssertNotCalled(t, "AddMember", mock.Anything)
+	})
+}
+
+func TestRemoveMember(t *testing.T) {
+	var groupID uint = 5
+	var ownerID uint = 11
+	existing := &group.GroupEntity{Model: gorm.Model{ID: groupID}, OwnerID: ownerID}
+
+	t.Run("success_leave", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockGroupRepo := new(mocks.MockGroupRepository)
+		mockGroupRepo.On("GetByIDAndMember", groupID, uint(12)).Return(existing, nil).Once()
+		mockGroupRepo.On("RemoveMember", groupID, uint(12)).Return(nil).Once()
+
+		mockGroupExpenseRepo := new(mocks.MockGroupExpenseRepository)
+		mockGroupExpenseRepo.On("GetMemberTotals", groupID).Return([]group.MemberTotalRow{
+			{UserID: 12, Paid: decimal.NewFromInt(20), Owed: decimal.NewFromInt(30), Sent: decimal.NewFromInt(10)},
+		}, nil).Once()
+
+		service := group.NewGroupService(db, mockGroupRepo, mockGroupExpenseRepo, new(userMocks.MockUserService))
+		err := service.RemoveMember(groupID, 12, 12)
+
+		assert.NoError(t, err)
+		mockGroupRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_unsettled", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockGroupRepo := new(mocks.MockGroupRepository)
+		mockGroupRepo.On("GetByIDAndMember", groupID, ownerID).Return(existing, nil).Once()
+
+		mockGroupExpenseRepo := new(mocks.MockGroupExpenseRepository)
+		mockGroupExpenseRepo.On("GetMemberTotals", groupID).Return([]group.MemberTotalRow{
+			{UserID: 12, Owed: decimal.NewFromInt(30)},
+		}, nil).Once()
+
+		service := group.NewGroupService(db, mockGroupRepo, mockGroupExpenseRepo, new(userMocks.MockUserService))
+		err := service.RemoveMember(groupID, 12, ownerID)
+
+		assert.Equal(t, apperror.ErrConflict, err)
+		mockGroupRepo.AssertNotCalled(t, "RemoveMember", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error_other_member", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		mockGroupRepo := new(mocks.MockGroupRepository)
+		mockGroupRepo.On("GetByIDAndMember", groupID, uint(12)).Return(existing, nil).Once()
+
+		service := group.NewGroupService(db, mockGroupRepo, new(mocks.MockGroupExpenseRepository), new(userMocks.MockUserService))
+		err := service.RemoveMember(groupID, 13, 12)
+
+		assert.Equal(t, apperror.ErrForbidden, err)
+	})
+}
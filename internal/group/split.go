@@ -0,0 +1,90 @@
+package group
+
+import (
+	"fmt"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/shopspring/decimal"
+)
+
+var hundred = decimal.NewFromInt(100)
+
+// splitAmount works out what each participant owes. Value is ignored for equal splits, is a percentage or
+// a number of shares for those methods, and the amount itself for exact splits. Amounts are rounded down to
+// the cent and the cents left over go one each to the first participants, so the shares always add up to amount.
+// The amount, like exact shares, must be in whole cents for that to work out.
+func splitAmount(amount decimal.Decimal, method SplitMethod, participants []ParticipantRequest) ([]GroupExpenseShareEntity, error) {
+	if len(participants) == 0 || !isCents(amount) {
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	seen := map[uint]bool{}
+	total := decimal.Zero
+	for _, participant := range participants {
+		if seen[participant.UserID] {
+			return nil, apperror.ErrInvalidRequest
+		}
+		seen[participant.UserID] = true
+
+		if method != SplitMethodEqual && participant.Value.IsNegative() {
+			return nil, apperror.ErrInvalidRequest
+		}
+		if method == SplitMethodExact && !isCents(participant.Value) {
+			return nil, apperror.ErrInvalidRequest
+		}
+		total = total.Add(participant.Value)
+	}
+
+	weights := make([]decimal.Decimal, len(participants))
+	switch method {
+	case SplitMethodEqual:
+		for i := range weights {
+			weights[i] = decimal.NewFromInt(1)
+		}
+		total = decimal.NewFromInt(int64(len(participants)))
+	case SplitMethodPercentage:
+		if !total.Equal(hundred) {
+			return nil, fmt.Errorf("%w: percentages add up to %s, not 100", apperror.ErrInvalidRequest, total)
+		}
+		fallthrough
+	case SplitMethodShares:
+		if !total.IsPositive() {
+			return nil, apperror.ErrInvalidRequest
+		}
+		for i, participant := range participants {
+			weights[i] = participant.Value
+		}
+	case SplitMethodExact:
+		if !total.Equal(amount) {
+			return nil, fmt.Errorf("%w: amounts add up to %s, not %s", apperror.ErrInvalidRequest, total, amount)
+		}
+		shares := make([]GroupExpenseShareEntity, len(participants))
+		for i, participant := range participants {
+			shares[i] = GroupExpenseShareEntity{UserID: participant.UserID, Amount: participant.Value}
+		}
+		return shares, nil
+	default:
+		return nil, apperror.ErrInvalidRequest
+	}
+
+	shares := make([]GroupExpenseShareEntity, len(participants))
+	left := amount
+	for i, participant := range participants {
+		share := amount.Mul(weights[i]).Div(total).RoundFloor(2)
+		shares[i] = GroupExpenseShareEntity{UserID: participant.UserID, Amount: share}
+		left = left.Sub(share)
+	}
+
+	cent := decimal.New(1, -2)
+	for i := 0; left.IsPositive(); i = (i + 1) % len(shares) {
+		shares[i].Amount = shares[i].Amount.Add(cent)
+		left = left.Sub(cent)
+	}
+
+	return shares, nil
+}
+
+// isCents tells whether the amount has no more than two decimal places.
+func isCents(amount decimal.Decimal) bool {
+	return amount.Equal(amount.Truncate(2))
+}
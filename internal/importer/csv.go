@@ -0,0 +1,214 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/currency"
+	"github.com/shopspring/decimal"
+)
+
+// Mapping tells the CSV parser which header holds which expense field.
+type Mapping struct {
+	Delimiter        string
+	DateColumn       string
+	DateFormat       string
+	AmountColumn     string
+	DecimalSeparator string
+	CurrencyColumn   string
+	Currency         string
+	NoteColumn       string
+	CategoryColumn   string
+	DefaultCategory  string
+	TagsColumn       string
+	TagSeparator     string
+}
+
+var dateTokens = strings.NewReplacer("YYYY", "2006", "YY", "06", "MM", "01", "DD", "02")
+
+// ParseCSV reads a CSV with a header row. Rows that cannot be read are reported in the batch instead of failing the file.
+func ParseCSV(r io.Reader, mapping Mapping) (*Batch, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if mapping.Delimiter != "" {
+		delimiter, _ := utf8.DecodeRuneInString(mapping.Delimiter)
+		reader.Comma = delimiter
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: could not read header: %v", apperror.ErrInvalidRequest, err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[normalizeColumn(name)] = i
+	}
+
+	lookup := func(name string, required bool) (int, error) {
+		if name == "" {
+			if required {
+				return -1, fmt.Errorf("%w: missing column mapping", apperror.ErrInvalidRequest)
+			}
+			return -1, nil
+		}
+		index, ok := columns[normalizeColumn(name)]
+		if !ok {
+			return -1, fmt.Errorf("%w: column %q not found", apperror.ErrInvalidRequest, name)
+		}
+		return index, nil
+	}
+
+	dateIndex, err := lookup(mapping.DateColumn, true)
+	if err != nil {
+		return nil, err
+	}
+	amountIndex, err := lookup(mapping.AmountColumn, true)
+	if err != nil {
+		return nil, err
+	}
+	currencyIndex, err := lookup(mapping.CurrencyColumn, false)
+	if err != nil {
+		return nil, err
+	}
+	noteIndex, err := lookup(mapping.NoteColumn, false)
+	if err != nil {
+		return nil, err
+	}
+	categoryIndex, err := lookup(mapping.CategoryColumn, false)
+	if err != nil {
+		return nil, err
+	}
+	tagsIndex, err := lookup(mapping.TagsColumn, false)
+	if err != nil {
+		return nil, err
+	}
+
+	dateFormat := mapping.DateFormat
+	if dateFormat == "" {
+		dateFormat = "YYYY-MM-DD"
+	}
+	layout := dateTokens.Replace(dateFormat)
+
+	tagSeparator := mapping.TagSeparator
+	if tagSeparator == "" {
+		tagSeparator = "|"
+	}
+
+	batch := &Batch{}
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				batch.addError(parseErr.StartLine, "", parseErr.Err.Error())
+				continue
+			}
+			return nil, err
+		}
+
+		if isBlank(fields) {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		field := func(index int) string {
+			if index < 0 || index >= len(fields) {
+				return ""
+			}
+			return strings.TrimSpace(fields[index])
+		}
+
+		record := Record{
+			Line:     line,
+			Note:     field(noteIndex),
+			Category: field(categoryIndex),
+		}
+		valid := true
+
+		date, err := time.ParseInLocation(layout, field(dateIndex), time.UTC)
+		if err != nil {
+			batch.addError(line, "date", fmt.Sprintf("cannot parse %q with format %q", field(dateIndex), dateFormat))
+			valid = false
+		}
+		record.Date = date
+
+		amount, err := parseAmount(field(amountIndex), mapping.DecimalSeparator)
+		if err != nil {
+			batch.addError(line, "amount", fmt.Sprintf("cannot parse %q", field(amountIndex)))
+			valid = false
+		} else if !amount.IsPositive() {
+			batch.addError(line, "amount", "amount must be positive")
+			valid = false
+		}
+		record.Amount = amount
+
+		if code := field(currencyIndex); code != "" {
+			normalized, ok := currency.Normalize(code)
+			if !ok {
+				batch.addError(line, "currency", fmt.Sprintf("unknown currency %q", code))
+				valid = false
+			}
+			record.Currency = normalized
+		} else {
+			record.Currency = mapping.Currency
+		}
+
+		if record.Category == "" {
+			record.Category = mapping.DefaultCategory
+		}
+
+		for _, tag := range strings.Split(field(tagsIndex), tagSeparator) {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				record.Tags = append(record.Tags, tag)
+			}
+		}
+
+		if valid {
+			batch.Records = append(batch.Records, record)
+		}
+	}
+
+	return batch, nil
+}
+
+// parseAmount accepts either separator style, e.g. "1,234.50" or "1.234,50", dropping thousands separators and spaces.
+func parseAmount(value string, decimalSeparator string) (decimal.Decimal, error) {
+	thousandsSeparator := ","
+	if decimalSeparator == "," {
+		thousandsSeparator = "."
+	}
+
+	value = strings.NewReplacer(thousandsSeparator, "", " ", "", "\u00a0", "").Replace(value)
+	if decimalSeparator == "," {
+		value = strings.Replace(value, ",", ".", 1)
+	}
+
+	return decimal.NewFromString(value)
+}
+
+func normalizeColumn(name string) string {
+	return strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+}
+
+func isBlank(fields []string) bool {
+	for _, field := range fields {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,125 @@
+package importer
+
+import (
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/shopspring/decimal"
+)
+
+type ImportCSVRequest struct {
+	Delimiter        string `json:"delimiter" form:"delimiter" validate:"omitempty,len=1"`
+	DateColumn       string `json:"dateColumn" form:"dateColumn" validate:"required"`
+	DateFormat       string `json:"dateFormat" form:"dateFormat"`
+	AmountColumn     string `json:"amountColumn" form:"amountColumn" validate:"required"`
+	DecimalSeparator string `json:"decimalSeparator" form:"decimalSeparator" validate:"omitempty,oneof=. ,"`
+	CurrencyColumn   string `json:"currencyColumn" form:"currencyColumn"`
+	Currency         string `json:"currency" form:"currency" validate:"omitempty,iso4217"`
+	NoteColumn       string `json:"noteColumn" form:"noteColumn"`
+	CategoryColumn   string `json:"categoryColumn" form:"categoryColumn"`
+	DefaultCategory  string `json:"defaultCategory" form:"defaultCategory"`
+	TagsColumn       string `json:"tagsColumn" form:"tagsColumn"`
+	TagSeparator     string `json:"tagSeparator" form:"tagSeparator"`
+	DryRun           bool   `json:"dryRun" form:"dryRun"`
+	CreateMissing    bool   `json:"createMissing" form:"createMissing"`
+}
+
+func (r ImportCSVRequest) ToMapping() Mapping {
+	return Mapping{
+		Delimiter:        r.Delimiter,
+		DateColumn:       r.DateColumn,
+		DateFormat:       r.DateFormat,
+		AmountColumn:     r.AmountColumn,
+		DecimalSeparator: r.DecimalSeparator,
+		CurrencyColumn:   r.CurrencyColumn,
+		Currency:         r.Currency,
+		NoteColumn:       r.NoteColumn,
+		CategoryColumn:   r.CategoryColumn,
+		DefaultCategory:  r.DefaultCategory,
+		TagsColumn:       r.TagsColumn,
+		TagSeparator:     r.TagSeparator,
+	}
+}
+
+func (r ImportCSVRequest) ToOptions() Options {
+	return Options{
+		DryRun:        r.DryRun,
+		CreateMissing: r.CreateMissing,
+	}
+}
+
+type ImportStatementRequest struct {
+	// Format is "ofx", "qfx" or "qif"; without it the file name and content decide.
+	Format           string `json:"format" form:"format" validate:"omitempty,oneof=ofx qfx qif"`
+	Currency         string `json:"currency" form:"currency" validate:"omitempty,iso4217"`
+	DateFormat       string `json:"dateFormat" form:"dateFormat"`
+	DecimalSeparator string `json:"decimalSeparator" form:"decimalSeparator" validate:"omitempty,oneof=. ,"`
+	DefaultCategory  string `json:"defaultCategory" form:"defaultCategory"`
+	DryRun           bool   `json:"dryRun" form:"dryRun"`
+	CreateMissing    bool   `json:"createMissing" form:"createMissing"`
+}
+
+func (r ImportStatementRequest) ToStatementOptions() StatementOptions {
+	return StatementOptions{
+		Currency:         r.Currency,
+		DateFormat:       r.DateFormat,
+		DecimalSeparator: r.DecimalSeparator,
+	}
+}
+
+func (r ImportStatementRequest) ToOptions() Options {
+	return Options{
+		DryRun:          r.DryRun,
+		CreateMissing:   r.CreateMissing,
+		DefaultCategory: r.DefaultCategory,
+	}
+}
+
+type PreviewRow struct {
+	Line     int             `json:"line"`
+	Date     time.Time       `json:"date"`
+	Kind     expense.Kind    `json:"kind"`
+	Amount   decimal.Decimal `json:"amount"`
+	Currency string          `json:"currency"`
+	Note     string          `json:"note"`
+	Category string          `json:"category"`
+	Tags     []string        `json:"tags"`
+	// RuleIDs lists the rules that filed a row which came without a category.
+	RuleIDs    []uint `json:"ruleIds,omitempty"`
+	CategoryID uint   `json:"categoryId,omitempty"`
+	MerchantID uint   `json:"merchantId,omitempty"`
+}
+
+func (PreviewRow) FromRecord(record Record) PreviewRow {
+	tags := record.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	kind := record.Kind
+	if kind == "" {
+		kind = expense.KindExpense
+	}
+
+	return PreviewRow{
+		Line:     record.Line,
+		Date:     record.Date,
+		Kind:     kind,
+		Amount:   record.Amount,
+		Currency: record.Currency,
+		Note:     record.Note,
+		Category: record.Category,
+		Tags:     tags,
+	}
+}
+
+type ImportResponse struct {
+	DryRun        bool         `json:"dryRun"`
+	Total         int          `json:"total"`
+	Imported      int          `json:"imported"`
+	Skipped       int          `json:"skipped"`
+	Errors        []RowError   `json:"errors"`
+	NewCategories []string     `json:"newCategories"`
+	NewTags       []string     `json:"newTags"`
+	Preview       []PreviewRow `json:"preview,omitempty"`
+}
@@ -0,0 +1,119 @@
+package importer
+
+import (
+	"errors"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/util"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type ImportHandler struct {
+	importService ImportService
+	validate      *validator.Validate
+}
+
+func NewImportHandler(importService ImportService, validate *validator.Validate) *ImportHandler {
+	return &ImportHandler{
+		importService: importService,
+		validate:      validate,
+	}
+}
+
+func (h *ImportHandler) RegisterRoutes(app *fiber.App, authMiddleware fiber.Handler) {
+	group := app.Group("/expenses/import", authMiddleware)
+	group.Post("/", h.ImportCSV)
+	group.Post("/statement", h.ImportStatement)
+}
+
+func (h *ImportHandler) ImportCSV(c *fiber.Ctx) error {
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractDto[ImportCSVRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	fileHeader, errFile := c.FormFile("file")
+	if errFile != nil {
+		log.Error(errFile)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	file, errOpen := fileHeader.Open()
+	if errOpen != nil {
+		log.Error(errOpen)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+	defer file.Close()
+
+	result, err := h.importService.ImportCSV(authUserID, file, dto)
+	if err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			if result != nil {
+				return c.Status(fiber.StatusUnprocessableEntity).JSON(result)
+			}
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	if dto.DryRun {
+		return c.Status(fiber.StatusOK).JSON(result)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(result)
+}
+
+func (h *ImportHandler) ImportStatement(c *fiber.Ctx) error {
+	authUserID, errUserID := util.GetAuthUserID(c)
+	if errUserID != nil {
+		log.Error(errUserID)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": apperror.ErrUnauthorized.Error()})
+	}
+
+	dto, errDTO := util.ExtractDto[ImportStatementRequest](c, h.validate)
+	if errDTO != nil {
+		log.Error(errDTO)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	fileHeader, errFile := c.FormFile("file")
+	if errFile != nil {
+		log.Error(errFile)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": apperror.ErrInvalidRequest.Error()})
+	}
+
+	file, errOpen := fileHeader.Open()
+	if errOpen != nil {
+		log.Error(errOpen)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+	defer file.Close()
+
+	result, err := h.importService.ImportStatement(authUserID, file, fileHeader.Filename, dto)
+	if err != nil {
+		log.Error(err)
+		if errors.Is(err, apperror.ErrInvalidRequest) {
+			if result != nil {
+				return c.Status(fiber.StatusUnprocessableEntity).JSON(result)
+			}
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": apperror.ErrDefault.Error()})
+	}
+
+	if dto.DryRun {
+		return c.Status(fiber.StatusOK).JSON(result)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(result)
+}
@@ -0,0 +1,402 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	"gorm.io/gorm"
+)
+
+const previewLimit = 100
+
+type Options struct {
+	DryRun        bool
+	CreateMissing bool
+	// DefaultCategory files rows that come without a category and match no merchant or rule.
+	DefaultCategory string
+}
+
+type ImportService interface {
+	ImportCSV(authUserID uint, r io.Reader, dto ImportCSVRequest) (*ImportResponse, error)
+	ImportStatement(authUserID uint, r io.Reader, fileName string, dto ImportStatementRequest) (*ImportResponse, error)
+	Import(authUserID uint, batch *Batch, options Options) (*ImportResponse, error)
+}
+
+type importService struct {
+	db           *gorm.DB
+	expenseRepo  expense.ExpenseRepository
+	revisionRepo expense.RevisionRepository
+	categoryRepo expense.CategoryRepository
+	tagRepo      expense.TagRepository
+	ruleRepo     expense.RuleRepository
+	merchantRepo expense.MerchantRepository
+	userService  user.UserService
+}
+
+func NewImportService(
+	db *gorm.DB,
+	expenseRepo expense.ExpenseRepository,
+	revisionRepo expense.RevisionRepository,
+	categoryRepo expense.CategoryRepository,
+	tagRepo expense.TagRepository,
+	ruleRepo expense.RuleRepository,
+	merchantRepo expense.MerchantRepository,
+	userService user.UserService,
+) ImportService {
+	return &importService{
+		db:           db,
+		expenseRepo:  expenseRepo,
+		revisionRepo: revisionRepo,
+		categoryRepo: categoryRepo,
+		tagRepo:      tagRepo,
+		ruleRepo:     ruleRepo,
+		merchantRepo: merchantRepo,
+		userService:  userService,
+	}
+}
+
+func (s *importService) ImportCSV(authUserID uint, r io.Reader, dto ImportCSVRequest) (*ImportResponse, error) {
+	batch, err := ParseCSV(r, dto.ToMapping())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Import(authUserID, batch, dto.ToOptions())
+}
+
+func (s *importService) ImportStatement(authUserID uint, r io.Reader, fileName string, dto ImportStatementRequest) (*ImportResponse, error) {
+	batch, err := ParseStatement(r, fileName, dto.Format, dto.ToStatementOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Import(authUserID, batch, dto.ToOptions())
+}
+
+// Import resolves category and tag names and, unless it is a dry run, inserts every record in one transaction.
+// Nothing is written when any row has an error. Records imported before, going by their external ID, are skipped.
+func (s *importService) Import(authUserID uint, batch *Batch, options Options) (*ImportResponse, error) {
+	records, err := s.newRecords(authUserID, batch.Records)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ImportResponse{
+		DryRun:        options.DryRun,
+		Total:         len(batch.Records) + countLines(batch.Errors),
+		Skipped:       len(batch.Records) - len(records),
+		Errors:        append([]RowError{}, batch.Errors...),
+		NewCategories: []string{},
+		NewTags:       []string{},
+	}
+
+	rules, err := s.ruleRepo.GetEnabledByUser(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// rules judge weekdays in the user's timezone, as they do for expenses created one by one
+	loc := time.UTC
+	if len(rules) > 0 {
+		u, err := s.userService.GetUserByID(authUserID, authUserID)
+		if err != nil {
+			return nil, err
+		}
+		if userLoc, err := time.LoadLocation(u.Timezone); err == nil {
+			loc = userLoc
+		}
+	}
+
+	merchants, err := s.merchantRepo.GetByUser(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// rows without a category take their merchant's defaults, or are left to the user's rules and then the default category
+	ruled := map[int]*expense.RuleSubject{}
+	ruleIDs := map[int][]uint{}
+	merchantIDs := map[int]uint{}
+	categoryNames := newNameSet()
+	tagNames := newNameSet()
+	for i, record := range records {
+		merchant := expense.MatchMerchant(merchants, record.Note)
+		if merchant != nil {
+			merchantIDs[record.Line] = merchant.ID
+		}
+
+		if record.Category == "" && merchant != nil && merchant.DefaultCategoryID != nil {
+			ruled[record.Line] = &expense.RuleSubject{Note: record.Note, CategoryID: *merchant.DefaultCategoryID, Tags: merchant.DefaultTags}
+		} else if record.Category == "" {
+			subject := &expense.RuleSubject{Date: record.Date.In(loc), Amount: record.Amount, Note: record.Note, Tags: []expense.TagEntity{}}
+			matched := expense.ApplyRules(rules, subject)
+			switch {
+			case subject.CategoryID != 0:
+				ruled[record.Line] = subject
+				ruleIDs[record.Line] = matched
+			case options.DefaultCategory != "":
+				records[i].Category = options.DefaultCategory
+				categoryNames.add(options.DefaultCategory)
+			default:
+				result.Errors = append(result.Errors, RowError{Line: record.Line, Field: "category", Message: "category is required"})
+				continue
+			}
+		} else {
+			categoryNames.add(record.Category)
+		}
+		for _, tag := range record.Tags {
+			tagNames.add(tag)
+		}
+	}
+
+	categories, err := s.categoryRepo.GetByNames(authUserID, categoryNames.names)
+	if err != nil {
+		return nil, err
+	}
+
+	categoriesByName := map[string]expense.CategoryEntity{}
+	for _, category := range categories {
+		key := nameKey(category.Name)
+		// a user's own category wins over a default one with the same name, and a top-level one over a nested one
+		if existing, ok := categoriesByName[key]; ok && categoryRank(existing, authUserID) >= categoryRank(category, authUserID) {
+			continue
+		}
+		categoriesByName[key] = category
+	}
+
+	tags, err := s.tagRepo.GetByNames(authUserID, tagNames.names)
+	if err != nil {
+		return nil, err
+	}
+
+	tagsByName := map[string]expense.TagEntity{}
+	for _, tag := range tags {
+		tagsByName[nameKey(tag.Name)] = tag
+	}
+
+	missingCategories := categoryNames.missing(func(key string) bool { _, ok := categoriesByName[key]; return ok })
+	missingTags := tagNames.missing(func(key string) bool { _, ok := tagsByName[key]; return ok })
+
+	if options.CreateMissing {
+		result.NewCategories = append(result.NewCategories, missingCategories...)
+		result.NewTags = append(result.NewTags, missingTags...)
+	} else {
+		for _, record := range records {
+			// rows filed by a merchant or a rule have no category name to look up, but their tags still need one
+			if _, ok := ruled[record.Line]; !ok && record.Category != "" && slices.Contains(missingCategories, categoryNames.display(record.Category)) {
+				result.Errors = append(result.Errors, RowError{
+					Line:    record.Line,
+					Field:   "category",
+					Message: fmt.Sprintf("category %q not found", record.Category),
+				})
+			}
+			for _, tag := range record.Tags {
+				if slices.Contains(missingTags, tagNames.display(tag)) {
+					result.Errors = append(result.Errors, RowError{
+						Line:    record.Line,
+						Field:   "tags",
+						Message: fmt.Sprintf("tag %q not found", tag),
+					})
+				}
+			}
+		}
+	}
+
+	slices.SortStableFunc(result.Errors, func(a RowError, b RowError) int {
+		return a.Line - b.Line
+	})
+
+	if options.DryRun {
+		for _, record := range records[:min(len(records), previewLimit)] {
+			row := PreviewRow{}.FromRecord(record)
+			row.MerchantID = merchantIDs[record.Line]
+			if subject, ok := ruled[record.Line]; ok {
+				row.Note, row.CategoryID, row.RuleIDs = subject.Note, subject.CategoryID, ruleIDs[record.Line]
+				for _, tag := range subject.Tags {
+					row.Tags = append(row.Tags, tag.Name)
+				}
+			}
+			result.Preview = append(result.Preview, row)
+		}
+		return result, nil
+	}
+
+	if len(result.Errors) > 0 {
+		return result, apperror.ErrInvalidRequest
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		categoryRepo := s.categoryRepo.WithTx(tx)
+		tagRepo := s.tagRepo.WithTx(tx)
+		expenseRepo := s.expenseRepo.WithTx(tx)
+		revisionRepo := s.revisionRepo.WithTx(tx)
+
+		for _, name := range result.NewCategories {
+			category := &expense.CategoryEntity{UserID: authUserID, Name: name}
+			if err := categoryRepo.Create(category); err != nil {
+				return err
+			}
+			categoriesByName[nameKey(name)] = *category
+		}
+
+		for _, name := range result.NewTags {
+			tag := &expense.TagEntity{UserID: authUserID, Name: name}
+			if err := tagRepo.Create(tag); err != nil {
+				return err
+			}
+			tagsByName[nameKey(name)] = *tag
+		}
+
+		for _, record := range records {
+			expenseTags := []expense.TagEntity{}
+			for _, name := range record.Tags {
+				tag := tagsByName[nameKey(name)]
+				if !slices.ContainsFunc(expenseTags, func(t expense.TagEntity) bool { return t.ID == tag.ID }) {
+					expenseTags = append(expenseTags, tag)
+				}
+			}
+
+			note, categoryID := record.Note, categoriesByName[nameKey(record.Category)].ID
+			if subject, ok := ruled[record.Line]; ok {
+				note, categoryID = subject.Note, subject.CategoryID
+				for _, tag := range subject.Tags {
+					if !slices.ContainsFunc(expenseTags, func(t expense.TagEntity) bool { return t.ID == tag.ID }) {
+						expenseTags = append(expenseTags, tag)
+					}
+				}
+			}
+
+			entity := &expense.ExpenseEntity{
+				UserID:     authUserID,
+				Date:       record.Date.Unix(),
+				Kind:       record.Kind,
+				Amount:     record.Amount,
+				Currency:   record.Currency,
+				Note:       note,
+				CategoryID: categoryID,
+				Tags:       expenseTags,
+			}
+			if record.ExternalID != "" {
+				entity.ExternalID = &record.ExternalID
+			}
+			if merchantID, ok := merchantIDs[record.Line]; ok {
+				entity.MerchantID = &merchantID
+			}
+			if err := expenseRepo.Create(entity); err != nil {
+				return err
+			}
+			if err := expense.RecordRevision(revisionRepo, *entity, nil, authUserID, expense.RevisionActionCreate); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.Imported = len(records)
+
+	return result, nil
+}
+
+// newRecords drops records whose external ID was imported before or already appeared earlier in the batch.
+func (s *importService) newRecords(authUserID uint, records []Record) ([]Record, error) {
+	externalIDs := []string{}
+	for _, record := range records {
+		if record.ExternalID != "" {
+			externalIDs = append(externalIDs, record.ExternalID)
+		}
+	}
+	if len(externalIDs) == 0 {
+		return records, nil
+	}
+
+	existing, err := s.expenseRepo.GetExternalIDs(authUserID, externalIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	for _, externalID := range existing {
+		seen[externalID] = true
+	}
+
+	fresh := []Record{}
+	for _, record := range records {
+		if record.ExternalID != "" {
+			if seen[record.ExternalID] {
+				continue
+			}
+			seen[record.ExternalID] = true
+		}
+		fresh = append(fresh, record)
+	}
+
+	return fresh, nil
+}
+
+// nameSet keeps the first spelling seen for each case-insensitive name.
+type nameSet struct {
+	names []string
+	byKey map[string]string
+}
+
+func newNameSet() *nameSet {
+	return &nameSet{names: []string{}, byKey: map[string]string{}}
+}
+
+func (s *nameSet) add(name string) {
+	key := nameKey(name)
+	if _, ok := s.byKey[key]; ok {
+		return
+	}
+	s.byKey[key] = name
+	s.names = append(s.names, name)
+}
+
+func (s *nameSet) display(name string) string {
+	return s.byKey[nameKey(name)]
+}
+
+func (s *nameSet) missing(exists func(key string) bool) []string {
+	missing := []string{}
+	for _, name := range s.names {
+		if !exists(nameKey(name)) {
+			missing = append(missing, name)
+		}
+	}
+
+	return missing
+}
+
+func nameKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func countLines(errs []RowError) int {
+	lines := map[int]bool{}
+	for _, err := range errs {
+		lines[err.Line] = true
+	}
+
+	return len(lines)
+}
+
+func categoryRank(category expense.CategoryEntity, authUserID uint) int {
+	rank := 0
+	if category.UserID == authUserID {
+		rank += 2
+	}
+	if category.ParentID == 0 {
+		rank++
+	}
+
+	return rank
+}
@@ -0,0 +1,288 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	expenseMocks "github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/importer"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestImportCSV(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var userID uint = 11
+		csv := "Date;Amount;Memo;Category;Tags\n" +
+			"31/01/2025;1.234,50;rent;Housing;home\n" +
+			"01/02/2025;12,00;lunch;food;work|Home\n"
+		dto := importer.ImportCSVRequest{
+			Delimiter:        ";",
+			DateColumn:       "date",
+			DateFormat:       "DD/MM/YYYY",
+			AmountColumn:     "amount",
+			DecimalSeparator: ",",
+			NoteColumn:       "memo",
+			CategoryColumn:   "category",
+			TagsColumn:       "tags",
+			CreateMissing:    true,
+		}
+		categories := []expense.CategoryEntity{
+			{Model: gorm.Model{ID: 1}, UserID: 0, Name: "Food", IsDefault: true},
+			{Model: gorm.Model{ID: 2}, UserID: userID, Name: "Housing"},
+		}
+		tags := []expense.TagEntity{
+			{Model: gorm.Model{ID: 3}, UserID: userID, Name: "Home"},
+		}
+		created := []*expense.ExpenseEntity{}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByNames", userID, []string{"Housing", "food"}).Return(categories, nil).Once()
+		mockCategoryRepo.On("WithTx", mock.Anything).Return(mockCategoryRepo).Once()
+
+		mockTagRepo := new(expenseMocks.MockTagRepository)
+		mockTagRepo.On("GetByNames", userID, []string{"home", "work"}).Return(tags, nil).Once()
+		mockTagRepo.On("WithTx", mock.Anything).Return(mockTagRepo).Once()
+		mockTagRepo.On("Create", mock.MatchedBy(func(tag *expense.TagEntity) bool {
+			return tag.UserID == userID && tag.Name == "work"
+		})).Run(func(args mock.Arguments) {
+			args.Get(0).(*expense.TagEntity).ID = 4
+		}).Return(nil).Once()
+
+		mockRuleRepo := new(expenseMocks.MockRuleRepository)
+		mockRuleRepo.On("GetEnabledByUser", userID).Return([]expense.RuleEntity{}, nil).Once()
+
+		mockMerchantRepo := new(expenseMocks.MockMerchantRepository)
+		mockMerchantRepo.On("GetByUser", userID).Return([]expense.MerchantEntity{}, nil).Once()
+
+		mockExpenseRepo := new(expenseMocks.MockExpenseRepository)
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+			created = append(created, args.Get(0).(*expense.ExpenseEntity))
+		}).Return(nil).Twice()
+
+		mockRevisionRepo := new(expenseMocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.ActorID == userID && r.Action == expense.RevisionActionCreate
+		})).Return(nil).Twice()
+
+		service := importer.NewImportService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryRepo, mockTagRepo, mockRuleRepo, mockMerchantRepo, new(userMocks.MockUserService))
+		result, err := service.ImportCSV(userID, strings.NewReader(csv), dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Total)
+		assert.Equal(t, 2, result.Imported)
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, []string{}, result.NewCategories)
+		assert.Equal(t, []string{"work"}, result.NewTags)
+		if assert.Len(t, created, 2) {
+			assert.True(t, decimal.RequireFromString("1234.50").Equal(created[0].Amount))
+			assert.Equal(t, uint(2), created[0].CategoryID)
+			assert.Equal(t, []expense.TagEntity{tags[0]}, created[0].Tags)
+			assert.Equal(t, uint(1), created[1].CategoryID)
+			assert.Equal(t, []uint{4, 3}, []uint{created[1].Tags[0].ID, created[1].Tags[1].ID})
+		}
+		mockCategoryRepo.AssertExpectations(t)
+		mockTagRepo.AssertExpectations(t)
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_dry_run", func(t *testing.T) {
+		var userID uint = 11
+		csv := "date,amount,category\n" +
+			"2025-01-31,10.00,Food\n" +
+			"2025-13-01,10.00,Food\n" +
+			"2025-02-01,abc,Food\n" +
+			"2025-02-02,5.00,Travel\n"
+		dto := importer.ImportCSVRequest{
+			DateColumn:     "date",
+			AmountColumn:   "amount",
+			CategoryColumn: "category",
+			DryRun:         true,
+		}
+		categories := []expense.CategoryEntity{
+			{Model: gorm.Model{ID: 1}, UserID: userID, Name: "Food"},
+		}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByNames", userID, []string{"Food", "Travel"}).Return(categories, nil).Once()
+
+		mockTagRepo := new(expenseMocks.MockTagRepository)
+		mockTagRepo.On("GetByNames", userID, []string{}).Return([]expense.TagEntity{}, nil).Once()
+
+		mockRuleRepo := new(expenseMocks.MockRuleRepository)
+		mockRuleRepo.On("GetEnabledByUser", userID).Return([]expense.RuleEntity{}, nil).Once()
+
+		mockMerchantRepo := new(expenseMocks.MockMerchantRepository)
+		mockMerchantRepo.On("GetByUser", userID).Return([]expense.MerchantEntity{}, nil).Once()
+
+		mockExpenseRepo := new(expenseMocks.MockExpenseRepository)
+
+		service := importer.NewImportService(db, mockExpenseRepo, new(expenseMocks.MockRevisionRepository), mockCategoryRepo, mockTagRepo, mockRuleRepo, mockMerchantRepo, new(userMocks.MockUserService))
+		result, err := service.ImportCSV(userID, strings.NewReader(csv), dto)
+
+		assert.NoError(t, err)
+		assert.True(t, result.DryRun)
+		assert.Equal(t, 4, result.Total)
+		assert.Equal(t, 0, result.Imported)
+		assert.Equal(t, []importer.RowError{
+			{Line: 3, Field: "date", Message: `cannot parse "2025-13-01" with format "YYYY-MM-DD"`},
+			{Line: 4, Field: "amount", Message: `cannot parse "abc"`},
+			{Line: 5, Field: "category", Message: `category "Travel" not found`},
+		}, result.Errors)
+		assert.Len(t, result.Preview, 2)
+		mockExpenseRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("success_rule_in_user_timezone", func(t *testing.T) {
+		var userID uint = 11
+		var categoryID uint = 5
+		// midnight UTC on Friday is still Thursday evening in New York
+		csv := "date,amount,note\n" +
+			"2025-01-31,4.50,Coffee\n"
+		dto := importer.ImportCSVRequest{
+			DateColumn:   "date",
+			AmountColumn: "amount",
+			NoteColumn:   "note",
+			DryRun:       true,
+		}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByNames", userID, []string{}).Return([]expense.CategoryEntity{}, nil).Once()
+
+		mockTagRepo := new(expenseMocks.MockTagRepository)
+		mockTagRepo.On("GetByNames", userID, []string{}).Return([]expense.TagEntity{}, nil).Once()
+
+		mockRuleRepo := new(expenseMocks.MockRuleRepository)
+		mockRuleRepo.On("GetEnabledByUser", userID).Return([]expense.RuleEntity{
+			{Model: gorm.Model{ID: 3}, UserID: userID, Enabled: true, ByDay: "TH", SetCategoryID: &categoryID},
+		}, nil).Once()
+
+		mockMerchantRepo := new(expenseMocks.MockMerchantRepository)
+		mockMerchantRepo.On("GetByUser", userID).Return([]expense.MerchantEntity{}, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(&user.UserEntity{Timezone: "America/New_York"}, nil).Once()
+
+		service := importer.NewImportService(db, new(expenseMocks.MockExpenseRepository), new(expenseMocks.MockRevisionRepository), mockCategoryRepo, mockTagRepo, mockRuleRepo, mockMerchantRepo, mockUserService)
+		result, err := service.ImportCSV(userID, strings.NewReader(csv), dto)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Errors)
+		assert.Len(t, result.Preview, 1)
+		assert.Equal(t, categoryID, result.Preview[0].CategoryID)
+		assert.Equal(t, []uint{3}, result.Preview[0].RuleIDs)
+	})
+
+	t.Run("error_row_errors", func(t *testing.T) {
+		var userID uint = 11
+		csv := "date,amount,category\n" +
+			"2025-01-31,-10.00,Food\n"
+		dto := importer.ImportCSVRequest{
+			DateColumn:     "date",
+			AmountColumn:   "amount",
+			CategoryColumn: "category",
+		}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByNames", userID, []string{}).Return([]expense.CategoryEntity{}, nil).Once()
+
+		mockTagRepo := new(expenseMocks.MockTagRepository)
+		mockTagRepo.On("GetByNames", userID, []string{}).Return([]expense.TagEntity{}, nil).Once()
+
+		mockRuleRepo := new(expenseMocks.MockRuleRepository)
+		mockRuleRepo.On("GetEnabledByUser", userID).Return([]expense.RuleEntity{}, nil).Once()
+
+		mockMerchantRepo := new(expenseMocks.MockMerchantRepository)
+		mockMerchantRepo.On("GetByUser", userID).Return([]expense.MerchantEntity{}, nil).Once()
+
+		mockExpenseRepo := new(expenseMocks.MockExpenseRepository)
+
+		service := importer.NewImportService(db, mockExpenseRepo, new(expenseMocks.MockRevisionRepository), mockCategoryRepo, mockTagRepo, mockRuleRepo, mockMerchantRepo, new(userMocks.MockUserService))
+		result, err := service.ImportCSV(userID, strings.NewReader(csv), dto)
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		assert.Equal(t, []importer.RowError{
+			{Line: 2, Field: "amount", Message: "amount must be positive"},
+		}, result.Errors)
+		mockExpenseRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("error_unknown_tag_on_uncategorized_row", func(t *testing.T) {
+		var userID uint = 11
+		var categoryID uint = 2
+		csv := "date,amount,note,tags\n" +
+			"2025-01-31,4.50,Starbucks,snacks\n"
+		dto := importer.ImportCSVRequest{
+			DateColumn:   "date",
+			AmountColumn: "amount",
+			NoteColumn:   "note",
+			TagsColumn:   "tags",
+		}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByNames", userID, []string{}).Return([]expense.CategoryEntity{}, nil).Once()
+
+		mockTagRepo := new(expenseMocks.MockTagRepository)
+		mockTagRepo.On("GetByNames", userID, []string{"snacks"}).Return([]expense.TagEntity{}, nil).Once()
+
+		mockRuleRepo := new(expenseMocks.MockRuleRepository)
+		mockRuleRepo.On("GetEnabledByUser", userID).Return([]expense.RuleEntity{}, nil).Once()
+
+		mockMerchantRepo := new(expenseMocks.MockMerchantRepository)
+		mockMerchantRepo.On("GetByUser", userID).Return([]expense.MerchantEntity{
+			{Model: gorm.Model{ID: 4}, UserID: userID, Name: "Starbucks", DefaultCategoryID: &categoryID},
+		}, nil).Once()
+
+		mockExpenseRepo := new(expenseMocks.MockExpenseRepository)
+
+		service := importer.NewImportService(db, mockExpenseRepo, new(expenseMocks.MockRevisionRepository), mockCategoryRepo, mockTagRepo, mockRuleRepo, mockMerchantRepo, new(userMocks.MockUserService))
+		result, err := service.ImportCSV(userID, strings.NewReader(csv), dto)
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+		assert.Equal(t, []importer.RowError{
+			{Line: 2, Field: "tags", Message: `tag "snacks" not found`},
+		}, result.Errors)
+		mockExpenseRepo.AssertNotCalled(t, "Create", mock.Anything)
+		mockTagRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("error_missing_column", func(t *testing.T) {
+		dto := importer.ImportCSVRequest{
+			DateColumn:   "date",
+			AmountColumn: "total",
+		}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
+		mockTagRepo := new(expenseMocks.MockTagRepository)
+		mockExpenseRepo := new(expenseMocks.MockExpenseRepository)
+		mockRuleRepo := new(expenseMocks.MockRuleRepository)
+		mockMerchantRepo := new(expenseMocks.MockMerchantRepository)
+
+		service := importer.NewImportService(db, mockExpenseRepo, new(expenseMocks.MockRevisionRepository), mockCategoryRepo, mockTagRepo, mockRuleRepo, mockMerchantRepo, new(userMocks.MockUserService))
+		result, err := service.ImportCSV(11, strings.NewReader("date,amount\n"), dto)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+	})
+}
@@ -0,0 +1,232 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	expenseMocks "github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/importer"
+	"github.com/Perajit/expense-tracker-go/internal/testutil"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+const ofxSGML = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+CHARSET:1252
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>THB
+<BANKACCTFROM><BANKID>004<ACCTID>1234567890<ACCTTYPE>CHECKING</BANKACCTFROM>
+<BANKTRANLIST>
+<DTSTART>20250101<DTEND>20250131
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20250105120000.000[+7:ICT]
+<TRNAMT>-250.00
+<FITID>TX1001
+<NAME>GRAB TAXI
+<MEMO>Trip to airport
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20250125
+<TRNAMT>45000.00
+<FITID>TX1002
+<NAME>ACME &amp; CO SALARY
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20250128
+<TRNAMT>-89.00
+<FITID>TX1003
+<NAME>7-ELEVEN
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`
+
+func TestImportStatement(t *testing.T) {
+	t.Run("success_ofx_sgml", func(t *testing.T) {
+		var userID uint = 11
+		dto := importer.ImportStatementRequest{DefaultCategory: "Bank"}
+		categories := []expense.CategoryEntity{{Model: gorm.Model{ID: 2}, UserID: userID, Name: "Bank"}}
+		created := []*expense.ExpenseEntity{}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByNames", userID, []string{"Bank"}).Return(categories, nil).Once()
+		mockCategoryRepo.On("WithTx", mock.Anything).Return(mockCategoryRepo).Once()
+
+		mockTagRepo := new(expenseMocks.MockTagRepository)
+		mockTagRepo.On("GetByNames", userID, []string{}).Return([]expense.TagEntity{}, nil).Once()
+		mockTagRepo.On("WithTx", mock.Anything).Return(mockTagRepo).Once()
+
+		mockRuleRepo := new(expenseMocks.MockRuleRepository)
+		mockRuleRepo.On("GetEnabledByUser", userID).Return([]expense.RuleEntity{}, nil).Once()
+
+		mockMerchantRepo := new(expenseMocks.MockMerchantRepository)
+		mockMerchantRepo.On("GetByUser", userID).Return([]expense.MerchantEntity{}, nil).Once()
+
+		mockExpenseRepo := new(expenseMocks.MockExpenseRepository)
+		mockExpenseRepo.On("GetExternalIDs", userID, []string{"ofx:1234567890:TX1001", "ofx:1234567890:TX1002", "ofx:1234567890:TX1003"}).
+			Return([]string{"ofx:1234567890:TX1003"}, nil).Once()
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+			created = append(created, args.Get(0).(*expense.ExpenseEntity))
+		}).Return(nil).Twice()
+
+		mockRevisionRepo := new(expenseMocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.ActorID == userID && r.Action == expense.RevisionActionCreate
+		})).Return(nil).Twice()
+
+		service := importer.NewImportService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryRepo, mockTagRepo, mockRuleRepo, mockMerchantRepo, new(userMocks.MockUserService))
+		result, err := service.ImportStatement(userID, strings.NewReader(ofxSGML), "statement.qfx", dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, result.Total)
+		assert.Equal(t, 2, result.Imported)
+		assert.Equal(t, 1, result.Skipped)
+		if assert.Len(t, created, 2) {
+			assert.Equal(t, time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC).Unix(), created[0].Date)
+			assert.Equal(t, expense.KindExpense, created[0].Kind)
+			assert.True(t, decimal.NewFromInt(250).Equal(created[0].Amount))
+			assert.Equal(t, "THB", created[0].Currency)
+			assert.Equal(t, "GRAB TAXI - Trip to airport", created[0].Note)
+			assert.Equal(t, uint(2), created[0].CategoryID)
+			assert.Equal(t, "ofx:1234567890:TX1001", *created[0].ExternalID)
+			assert.Equal(t, expense.KindIncome, created[1].Kind)
+			assert.Equal(t, "ACME & CO SALARY", created[1].Note)
+		}
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("success_ofx_xml_dry_run", func(t *testing.T) {
+		var userID uint = 11
+		ofx := `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220"?>
+<OFX><CREDITCARDMSGSRSV1><CCSTMTTRNRS><CCSTMTRS><CURDEF>EUR</CURDEF>
+<CCACCTFROM><ACCTID>4000</ACCTID></CCACCTFROM>
+<BANKTRANLIST><STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20250203</DTPOSTED><TRNAMT>-12,50</TRNAMT>
+<FITID>A1</FITID><PAYEE><NAME>Café Müller</NAME></PAYEE></STMTTRN>
+<STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>2025</DTPOSTED><TRNAMT>-3.00</TRNAMT><FITID>A2</FITID></STMTTRN>
+</BANKTRANLIST></CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1></OFX>`
+		dto := importer.ImportStatementRequest{DefaultCategory: "Food", DryRun: true}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByNames", userID, []string{"Food"}).
+			Return([]expense.CategoryEntity{{Model: gorm.Model{ID: 1}, UserID: userID, Name: "Food"}}, nil).Once()
+
+		mockTagRepo := new(expenseMocks.MockTagRepository)
+		mockTagRepo.On("GetByNames", userID, []string{}).Return([]expense.TagEntity{}, nil).Once()
+
+		mockRuleRepo := new(expenseMocks.MockRuleRepository)
+		mockRuleRepo.On("GetEnabledByUser", userID).Return([]expense.RuleEntity{}, nil).Once()
+
+		mockMerchantRepo := new(expenseMocks.MockMerchantRepository)
+		mockMerchantRepo.On("GetByUser", userID).Return([]expense.MerchantEntity{}, nil).Once()
+
+		mockExpenseRepo := new(expenseMocks.MockExpenseRepository)
+		mockExpenseRepo.On("GetExternalIDs", userID, []string{"ofx:4000:A1"}).Return([]string{}, nil).Once()
+
+		service := importer.NewImportService(db, mockExpenseRepo, new(expenseMocks.MockRevisionRepository), mockCategoryRepo, mockTagRepo, mockRuleRepo, mockMerchantRepo, new(userMocks.MockUserService))
+		result, err := service.ImportStatement(userID, strings.NewReader(ofx), "export", dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Total)
+		assert.Equal(t, []importer.RowError{{Line: 7, Field: "date", Message: `cannot parse "2025"`}}, result.Errors)
+		if assert.Len(t, result.Preview, 1) {
+			assert.Equal(t, "Café Müller", result.Preview[0].Note)
+			assert.Equal(t, "EUR", result.Preview[0].Currency)
+			assert.True(t, decimal.RequireFromString("12.50").Equal(result.Preview[0].Amount))
+		}
+		mockExpenseRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("success_qif", func(t *testing.T) {
+		var userID uint = 11
+		qif := "!Type:Bank\n" +
+			"D31/01'25\nT-1,234.50\nPLandlord\nLHousing:Rent\n^\n" +
+			"D02/02/2025\nT-4.00\nPCoffee\nLFood/Work\n^\n" +
+			"D02/02/2025\nT-4.00\nPCoffee\nLFood/Work\n^\n" +
+			"D03/02/2025\nT500.00\nPTransfer\nL[Savings]\n^\n"
+		dto := importer.ImportStatementRequest{Currency: "GBP", DateFormat: "DD/MM/YYYY", DefaultCategory: "Other"}
+		categories := []expense.CategoryEntity{
+			{Model: gorm.Model{ID: 1}, UserID: userID, Name: "Rent"},
+			{Model: gorm.Model{ID: 2}, UserID: userID, Name: "Food"},
+			{Model: gorm.Model{ID: 3}, UserID: userID, Name: "Other"},
+		}
+		tags := []expense.TagEntity{{Model: gorm.Model{ID: 4}, UserID: userID, Name: "work"}}
+		created := []*expense.ExpenseEntity{}
+
+		db := testutil.SetupDB()
+
+		mockCategoryRepo := new(expenseMocks.MockCategoryRepository)
+		mockCategoryRepo.On("GetByNames", userID, []string{"Rent", "Food", "Other"}).Return(categories, nil).Once()
+		mockCategoryRepo.On("WithTx", mock.Anything).Return(mockCategoryRepo).Once()
+
+		mockTagRepo := new(expenseMocks.MockTagRepository)
+		mockTagRepo.On("GetByNames", userID, []string{"Work"}).Return(tags, nil).Once()
+		mockTagRepo.On("WithTx", mock.Anything).Return(mockTagRepo).Once()
+
+		mockRuleRepo := new(expenseMocks.MockRuleRepository)
+		mockRuleRepo.On("GetEnabledByUser", userID).Return([]expense.RuleEntity{}, nil).Once()
+
+		mockMerchantRepo := new(expenseMocks.MockMerchantRepository)
+		mockMerchantRepo.On("GetByUser", userID).Return([]expense.MerchantEntity{}, nil).Once()
+
+		mockExpenseRepo := new(expenseMocks.MockExpenseRepository)
+		mockExpenseRepo.On("GetExternalIDs", userID, mock.MatchedBy(func(ids []string) bool { return len(ids) == 4 })).
+			Return([]string{}, nil).Once()
+		mockExpenseRepo.On("WithTx", mock.Anything).Return(mockExpenseRepo).Once()
+		mockExpenseRepo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+			created = append(created, args.Get(0).(*expense.ExpenseEntity))
+		}).Return(nil).Times(4)
+
+		mockRevisionRepo := new(expenseMocks.MockRevisionRepository)
+		mockRevisionRepo.On("WithTx", mock.Anything).Return(mockRevisionRepo).Once()
+		mockRevisionRepo.On("Create", mock.MatchedBy(func(r *expense.ExpenseRevisionEntity) bool {
+			return r.ActorID == userID && r.Action == expense.RevisionActionCreate
+		})).Return(nil).Times(4)
+
+		service := importer.NewImportService(db, mockExpenseRepo, mockRevisionRepo, mockCategoryRepo, mockTagRepo, mockRuleRepo, mockMerchantRepo, new(userMocks.MockUserService))
+		result, err := service.ImportStatement(userID, strings.NewReader(qif), "money.qif", dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, result.Imported)
+		if assert.Len(t, created, 4) {
+			assert.Equal(t, time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC).Unix(), created[0].Date)
+			assert.True(t, decimal.RequireFromString("1234.50").Equal(created[0].Amount))
+			assert.Equal(t, "GBP", created[0].Currency)
+			assert.Equal(t, uint(1), created[0].CategoryID)
+			assert.Equal(t, []expense.TagEntity{tags[0]}, created[1].Tags)
+			assert.NotEqual(t, *created[1].ExternalID, *created[2].ExternalID)
+			assert.Equal(t, expense.KindIncome, created[3].Kind)
+			assert.Equal(t, uint(3), created[3].CategoryID)
+		}
+	})
+
+	t.Run("error_unknown_format", func(t *testing.T) {
+		db := testutil.SetupDB()
+
+		service := importer.NewImportService(db, new(expenseMocks.MockExpenseRepository), new(expenseMocks.MockRevisionRepository), new(expenseMocks.MockCategoryRepository), new(expenseMocks.MockTagRepository), new(expenseMocks.MockRuleRepository), new(expenseMocks.MockMerchantRepository), new(userMocks.MockUserService))
+		result, err := service.ImportStatement(11, strings.NewReader("date,amount\n"), "statement.txt", importer.ImportStatementRequest{})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperror.ErrInvalidRequest)
+	})
+}
@@ -0,0 +1,156 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/currency"
+)
+
+// ParseOFX reads OFX 1.x (SGML, where leaf elements are not closed) and OFX 2.x (XML) statements, and QFX
+// which is OFX under another name. Only the STMTTRN transactions of bank and credit card statements are read.
+func ParseOFX(r io.Reader, options StatementOptions) (*Batch, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	text := decodeText(data)
+	start := strings.Index(strings.ToUpper(text), "<OFX>")
+	if start < 0 {
+		return nil, fmt.Errorf("%w: not an OFX file", apperror.ErrInvalidRequest)
+	}
+
+	batch := &Batch{}
+	ids := fingerprints{}
+	statementCurrency, accountID := "", ""
+	var transaction map[string]string
+	transactionLine := 0
+
+	line, counted := 1, 0
+	lineAt := func(pos int) int {
+		line += strings.Count(text[counted:pos], "\n")
+		counted = pos
+		return line
+	}
+
+	finish := func() {
+		if transaction != nil {
+			batch.addOFXTransaction(transactionLine, transaction, statementCurrency, accountID, options, ids)
+			transaction = nil
+		}
+	}
+
+	for pos := start; pos < len(text); {
+		open := strings.IndexByte(text[pos:], '<')
+		if open < 0 {
+			break
+		}
+		open += pos
+
+		closing := strings.IndexByte(text[open:], '>')
+		if closing < 0 {
+			break
+		}
+		closing += open
+
+		tag := strings.ToUpper(strings.TrimSpace(text[open+1 : closing]))
+		end := strings.IndexByte(text[closing+1:], '<')
+		if end < 0 {
+			end = len(text)
+		} else {
+			end += closing + 1
+		}
+		value := strings.TrimSpace(html.UnescapeString(text[closing+1 : end]))
+		pos = end
+
+		switch {
+		case strings.HasPrefix(tag, "?"), strings.HasPrefix(tag, "!"):
+		case tag == "STMTTRN":
+			finish()
+			transaction = map[string]string{}
+			transactionLine = lineAt(open)
+		case tag == "/STMTTRN", tag == "/BANKTRANLIST":
+			finish()
+		case strings.HasPrefix(tag, "/"):
+		case transaction != nil:
+			// the first NAME wins over the one nested in a PAYEE aggregate
+			if _, ok := transaction[tag]; !ok {
+				transaction[tag] = value
+			}
+		case tag == "CURDEF":
+			statementCurrency = value
+		case tag == "ACCTID":
+			accountID = value
+		}
+	}
+	finish()
+
+	return batch, nil
+}
+
+func (b *Batch) addOFXTransaction(line int, transaction map[string]string, statementCurrency string, accountID string, options StatementOptions, ids fingerprints) {
+	record := Record{
+		Line: line,
+		Note: joinNote(transaction["NAME"], transaction["MEMO"]),
+	}
+	valid := true
+
+	date, err := parseOFXDate(transaction["DTPOSTED"])
+	if err != nil {
+		b.addError(line, "date", fmt.Sprintf("cannot parse %q", transaction["DTPOSTED"]))
+		valid = false
+	}
+	record.Date = date
+
+	// the spec wants a period, but some banks write the amount with a decimal comma
+	decimalSeparator := "."
+	if amount := transaction["TRNAMT"]; strings.Contains(amount, ",") && !strings.Contains(amount, ".") {
+		decimalSeparator = ","
+	}
+	amount, err := parseAmount(transaction["TRNAMT"], decimalSeparator)
+	if err != nil {
+		b.addError(line, "amount", fmt.Sprintf("cannot parse %q", transaction["TRNAMT"]))
+		valid = false
+	} else if amount.IsZero() {
+		b.addError(line, "amount", "amount must not be zero")
+		valid = false
+	}
+	record.Amount, record.Kind = signedAmount(amount)
+
+	record.Currency = options.Currency
+	if statementCurrency != "" {
+		normalized, ok := currency.Normalize(statementCurrency)
+		if !ok {
+			b.addError(line, "currency", fmt.Sprintf("unknown currency %q", statementCurrency))
+			valid = false
+		}
+		record.Currency = normalized
+	}
+
+	if !valid {
+		return
+	}
+
+	if fitID := transaction["FITID"]; fitID != "" {
+		record.ExternalID = externalID(FormatOFX, accountID, fitID)
+	} else {
+		record.ExternalID = ids.next(FormatOFX, record, accountID)
+	}
+	b.Records = append(b.Records, record)
+}
+
+// parseOFXDate reads the day of an OFX datetime such as "20250131", "20250131120000" or
+// "20250131120000.000[-5:EST]". The time is dropped, like it is for CSV dates.
+func parseOFXDate(value string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, errors.New("date too short")
+	}
+
+	return time.ParseInLocation("20060102", value[:8], time.UTC)
+}
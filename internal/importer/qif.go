@@ -0,0 +1,206 @@
+package importer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var qifAccountTypes = []string{"bank", "cash", "ccard", "oth a", "oth l"}
+
+// qifTransaction holds the fields of one QIF transaction until its "^" terminator.
+type qifTransaction struct {
+	line     int
+	date     string
+	amount   string
+	payee    string
+	memo     string
+	category string
+	number   string
+}
+
+// ParseQIF reads the bank, cash and credit card sections of a QIF file. "L" categories become the record's
+// category, with "Parent:Child" read as Child and a "/class" suffix as a tag; transfers ("[Account]") get none.
+// QIF has no transaction IDs, so the external ID is made up from the content.
+func ParseQIF(r io.Reader, options StatementOptions) (*Batch, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	dateFormat := options.DateFormat
+	if dateFormat == "" {
+		dateFormat = "MM/DD/YYYY"
+	}
+
+	batch := &Batch{}
+	ids := fingerprints{}
+	supported, inAccount := true, false
+	var transaction *qifTransaction
+
+	finish := func() {
+		if transaction != nil {
+			batch.addQIFTransaction(*transaction, dateFormat, options, ids)
+			transaction = nil
+		}
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(decodeText(data)))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		if text[0] == '!' {
+			finish()
+			header := strings.ToLower(text)
+			switch {
+			case strings.HasPrefix(header, "!type:"):
+				supported = slices.Contains(qifAccountTypes, strings.TrimSpace(header[len("!type:"):]))
+				if !supported {
+					batch.addError(line, "", fmt.Sprintf("unsupported section %q", text))
+				}
+			case header == "!account":
+				inAccount = true
+			}
+			continue
+		}
+
+		code, value := text[0], strings.TrimSpace(text[1:])
+		if inAccount || !supported {
+			if code == '^' {
+				inAccount = false
+			}
+			continue
+		}
+
+		if code == '^' {
+			finish()
+			continue
+		}
+
+		if transaction == nil {
+			transaction = &qifTransaction{line: line}
+		}
+		switch code {
+		case 'D':
+			transaction.date = value
+		case 'T':
+			transaction.amount = value
+		case 'U':
+			if transaction.amount == "" {
+				transaction.amount = value
+			}
+		case 'P':
+			transaction.payee = value
+		case 'M':
+			transaction.memo = value
+		case 'L':
+			transaction.category = value
+		case 'N':
+			transaction.number = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	// be lenient with a missing terminator on the last transaction
+	finish()
+
+	return batch, nil
+}
+
+func (b *Batch) addQIFTransaction(transaction qifTransaction, dateFormat string, options StatementOptions, ids fingerprints) {
+	line := transaction.line
+	record := Record{
+		Line:     line,
+		Currency: options.Currency,
+		Note:     joinNote(transaction.payee, transaction.memo),
+	}
+	valid := true
+
+	date, err := parseQIFDate(transaction.date, dateFormat)
+	if err != nil {
+		b.addError(line, "date", fmt.Sprintf("cannot parse %q with format %q", transaction.date, dateFormat))
+		valid = false
+	}
+	record.Date = date
+
+	amount, err := parseAmount(transaction.amount, options.DecimalSeparator)
+	if err != nil {
+		b.addError(line, "amount", fmt.Sprintf("cannot parse %q", transaction.amount))
+		valid = false
+	} else if amount.IsZero() {
+		b.addError(line, "amount", "amount must not be zero")
+		valid = false
+	}
+	record.Amount, record.Kind = signedAmount(amount)
+
+	category, class, _ := strings.Cut(transaction.category, "/")
+	if !strings.HasPrefix(category, "[") {
+		record.Category = strings.TrimSpace(category[strings.LastIndex(category, ":")+1:])
+	}
+	if class = strings.TrimSpace(class); class != "" {
+		record.Tags = []string{class}
+	}
+
+	if !valid {
+		return
+	}
+
+	record.ExternalID = ids.next(FormatQIF, record, transaction.number)
+	b.Records = append(b.Records, record)
+}
+
+// parseQIFDate reads dates like "01/31/2025", "1/31'25" or "31.01.25", taking the order of day, month and year
+// from the format. Two-digit years after an apostrophe are in the 2000s, as Quicken writes them.
+func parseQIFDate(value string, format string) (time.Time, error) {
+	parts := strings.FieldsFunc(value, func(r rune) bool { return !unicode.IsDigit(r) })
+	if len(parts) != 3 {
+		return time.Time{}, errors.New("expected day, month and year")
+	}
+
+	format = strings.ToUpper(format)
+	order := []string{"DD", "MM", "YY"}
+	for _, token := range order {
+		if !strings.Contains(format, token) {
+			return time.Time{}, errors.New("date format needs DD, MM and YYYY")
+		}
+	}
+	slices.SortFunc(order, func(a string, b string) int {
+		return strings.Index(format, a) - strings.Index(format, b)
+	})
+
+	numbers := map[string]int{}
+	for i, token := range order {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return time.Time{}, err
+		}
+		numbers[token] = n
+	}
+
+	year := numbers["YY"]
+	if len(parts[slices.Index(order, "YY")]) <= 2 {
+		switch {
+		case strings.Contains(value, "'"), year < 70:
+			year += 2000
+		default:
+			year += 1900
+		}
+	}
+
+	date := time.Date(year, time.Month(numbers["MM"]), numbers["DD"], 0, 0, 0, 0, time.UTC)
+	if date.Month() != time.Month(numbers["MM"]) || date.Day() != numbers["DD"] {
+		return time.Time{}, errors.New("no such day")
+	}
+
+	return date, nil
+}
@@ -0,0 +1,38 @@
+package importer
+
+import (
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/shopspring/decimal"
+)
+
+// Record is one parsed input row, independent of the source format.
+type Record struct {
+	Line     int
+	Date     time.Time
+	Kind     expense.Kind
+	Amount   decimal.Decimal
+	Currency string
+	Note     string
+	Category string
+	Tags     []string
+	// ExternalID is set by formats that identify their transactions, e.g. from an OFX FITID.
+	ExternalID string
+}
+
+type RowError struct {
+	Line    int    `json:"line"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Batch is what a parser hands to the import pipeline: the rows it could read and the ones it could not.
+type Batch struct {
+	Records []Record
+	Errors  []RowError
+}
+
+func (b *Batch) addError(line int, field string, message string) {
+	b.Errors = append(b.Errors, RowError{Line: line, Field: field, Message: message})
+}
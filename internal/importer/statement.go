@@ -0,0 +1,125 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	FormatOFX = "ofx"
+	FormatQIF = "qif"
+)
+
+// StatementOptions fill in what a bank statement leaves out.
+type StatementOptions struct {
+	// Currency is used when the file names none, which QIF never does.
+	Currency string
+	// DateFormat gives the order of DD, MM and YYYY in QIF dates, "MM/DD/YYYY" by default.
+	DateFormat       string
+	DecimalSeparator string
+}
+
+// ParseStatement reads an OFX, QFX or QIF file. Without a format it goes by the file extension, then by the content.
+func ParseStatement(r io.Reader, fileName string, format string, options StatementOptions) (*Batch, error) {
+	reader := bufio.NewReader(r)
+	if format == "" {
+		head, _ := reader.Peek(4096)
+		format = detectFormat(fileName, head)
+	}
+
+	switch format {
+	case FormatOFX, "qfx":
+		return ParseOFX(reader, options)
+	case FormatQIF:
+		return ParseQIF(reader, options)
+	}
+
+	return nil, fmt.Errorf("%w: unrecognized statement format", apperror.ErrInvalidRequest)
+}
+
+func detectFormat(fileName string, head []byte) string {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".ofx", ".qfx":
+		return FormatOFX
+	case ".qif":
+		return FormatQIF
+	}
+
+	upper := bytes.ToUpper(head)
+	switch {
+	case bytes.Contains(upper, []byte("OFXHEADER")), bytes.Contains(upper, []byte("<OFX>")):
+		return FormatOFX
+	case bytes.HasPrefix(bytes.TrimSpace(bytes.TrimPrefix(upper, []byte("\ufeff"))), []byte("!")):
+		return FormatQIF
+	}
+
+	return ""
+}
+
+// signedAmount turns a statement amount into a positive amount and its kind: debits are expenses, credits income.
+func signedAmount(amount decimal.Decimal) (decimal.Decimal, expense.Kind) {
+	if amount.IsNegative() {
+		return amount.Neg(), expense.KindExpense
+	}
+
+	return amount, expense.KindIncome
+}
+
+func joinNote(payee string, memo string) string {
+	if memo == "" || strings.EqualFold(memo, payee) {
+		return payee
+	}
+	if payee == "" {
+		return memo
+	}
+
+	return payee + " - " + memo
+}
+
+// externalID joins the parts into an ID for ExpenseEntity.ExternalID, hashing it when it would not fit the column.
+func externalID(parts ...string) string {
+	id := strings.Join(parts, ":")
+	if len(id) <= 255 {
+		return id
+	}
+
+	return fmt.Sprintf("%s:%x", parts[0], sha256.Sum256([]byte(id)))
+}
+
+// fingerprints makes up IDs from transaction content for statements that have none; identical transactions
+// are told apart by how often they occurred before, so the same file always gives the same IDs.
+type fingerprints map[string]int
+
+func (f fingerprints) next(format string, record Record, extra ...string) string {
+	content := strings.Join(append([]string{record.Date.Format("2006-01-02"), string(record.Kind), record.Amount.String(), record.Note}, extra...), "|")
+	sum := sha256.Sum256([]byte(content))
+	key := fmt.Sprintf("%x", sum[:12])
+	f[key]++
+
+	return externalID(format, key, fmt.Sprint(f[key]))
+}
+
+// decodeText reads the file as UTF-8, falling back to Latin-1 which older statements use.
+func decodeText(data []byte) string {
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+	if utf8.Valid(data) {
+		return string(data)
+	}
+
+	runes := make([]rune, len(data))
+	for i, b := range data {
+		runes[i] = rune(b)
+	}
+
+	return string(runes)
+}
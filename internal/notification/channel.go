@@ -0,0 +1,47 @@
+package notification
+
+import (
+	"os"
+	"strconv"
+)
+
+// Recipient is where a user's notifications go outside the in-app inbox.
+// An empty address means the user has not turned that channel on.
+type Recipient struct {
+	UserID        uint
+	Email         string
+	WebhookURL    string
+	WebhookSecret string
+}
+
+// Channel delivers a notification that has already been stored in the recipient's inbox.
+type Channel interface {
+	Name() string
+	Send(recipient Recipient, notification NotificationEntity) error
+}
+
+// NewChannelsFromEnv returns the channels delivery can use besides the inbox: webhooks always,
+// and email when SMTP_HOST is set (with SMTP_PORT, SMTP_USERNAME, SMTP_PASSWORD and SMTP_FROM).
+func NewChannelsFromEnv() ([]Channel, error) {
+	channels := []Channel{NewWebhookChannel(nil)}
+
+	if host := os.Getenv("SMTP_HOST"); host != "" {
+		port := 587
+		if value := os.Getenv("SMTP_PORT"); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, err
+			}
+			port = parsed
+		}
+		channels = append(channels, NewEmailChannel(SMTPConfig{
+			Host:     host,
+			Port:     port,
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     os.Getenv("SMTP_FROM"),
+		}))
+	}
+
+	return channels, nil
+}
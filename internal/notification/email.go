@@ -0,0 +1,73 @@
+package notification
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+type emailChannel struct {
+	config SMTPConfig
+}
+
+// NewEmailChannel sends notifications as plain-text mail through the configured SMTP server.
+// It authenticates only when a username is set.
+func NewEmailChannel(config SMTPConfig) Channel {
+	return &emailChannel{config: config}
+}
+
+func (c *emailChannel) Name() string {
+	return "email"
+}
+
+func (c *emailChannel) Send(recipient Recipient, notification NotificationEntity) error {
+	if recipient.Email == "" {
+		return nil
+	}
+
+	var auth smtp.Auth
+	if c.config.Username != "" {
+		auth = smtp.PlainAuth("", c.config.Username, c.config.Password, c.config.Host)
+	}
+
+	addr := c.config.Host + ":" + strconv.Itoa(c.config.Port)
+
+	return smtp.SendMail(addr, auth, c.config.From, []string{recipient.Email}, c.message(recipient, notification))
+}
+
+func (c *emailChannel) message(recipient Recipient, notification NotificationEntity) []byte {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", c.config.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", headerValue(recipient.Email))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", headerValue(notification.Title)))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(notification.Body, "\n", "\r\n"))
+	msg.WriteString("\r\n")
+
+	return msg.Bytes()
+}
+
+// headerValue keeps user-named things like budget names from breaking out of the header line.
+func headerValue(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\r' || r == '\n' {
+			return ' '
+		}
+		return r
+	}, value)
+}
@@ -0,0 +1,124 @@
+package notification_test
+
+import (
+	"bufio"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Perajit/expense-tracker-go/internal/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+type smtpMessage struct {
+	auth string
+	from string
+	to   []string
+	data string
+}
+
+// fakeSMTP stands in for a mail server, accepting one message and handing it back on the channel.
+func fakeSMTP(t *testing.T) (string, int, <-chan smtpMessage) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	messages := make(chan smtpMessage, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+		var message smtpMessage
+
+		reply("220 localhost ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+			switch command {
+			case "EHLO", "HELO":
+				reply("250-localhost")
+				reply("250 AUTH PLAIN")
+			case "AUTH":
+				message.auth = line
+				reply("235 2.7.0 Authentication successful")
+			case "MAIL":
+				message.from = line
+				reply("250 OK")
+			case "RCPT":
+				message.to = append(message.to, line)
+				reply("250 OK")
+			case "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				var data strings.Builder
+				for {
+					dataLine, err := reader.ReadString('\n')
+					if err != nil || dataLine == ".\r\n" {
+						break
+					}
+					data.WriteString(dataLine)
+				}
+				message.data = data.String()
+				reply("250 OK")
+				messages <- message
+			case "QUIT":
+				reply("221 Bye")
+				return
+			default:
+				reply("502 Command not implemented")
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	portNumber, _ := strconv.Atoi(port)
+
+	return host, portNumber, messages
+}
+
+func TestEmailChannel(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		host, port, messages := fakeSMTP(t)
+
+		channel := notification.NewEmailChannel(notification.SMTPConfig{
+			Host:     host,
+			Port:     port,
+			Username: "alerts",
+			Password: "secret",
+			From:     "alerts@example.com",
+		})
+		err := channel.Send(notification.Recipient{UserID: 11, Email: "ann@example.com"}, notification.NotificationEntity{
+			Title: "Food budget reached 80%\r\nBcc: mallory@example.com",
+			Body:  "You have spent 400.00 of 500.00 USD (80%) in 2026-10.\n.\nThat's all.",
+		})
+
+		assert.NoError(t, err)
+		message := <-messages
+		assert.True(t, strings.HasPrefix(message.auth, "AUTH PLAIN "))
+		assert.Equal(t, "MAIL FROM:<alerts@example.com>", strings.Split(message.from, " BODY")[0])
+		assert.Equal(t, []string{"RCPT TO:<ann@example.com>"}, message.to)
+		assert.Contains(t, message.data, "To: ann@example.com\r\n")
+		assert.Contains(t, message.data, "Subject: Food budget reached 80%  Bcc: mallory@example.com\r\n")
+		assert.NotContains(t, message.data, "\r\nBcc:")
+		assert.Contains(t, message.data, "\r\n\r\nYou have spent 400.00 of 500.00 USD (80%) in 2026-10.\r\n..\r\nThat's all.\r\n")
+	})
+
+	t.Run("success_no_email", func(t *testing.T) {
+		channel := notification.NewEmailChannel(notification.SMTPConfig{Host: "127.0.0.1", Port: 1})
+		err := channel.Send(notification.Recipient{UserID: 11}, notification.NotificationEntity{Title: "Hello"})
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,5 @@
+package notification
+
+func GetModels() []any {
+	return []any{&NotificationEntity{}, &SettingsEntity{}}
+}
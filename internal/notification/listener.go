@@ -0,0 +1,24 @@
+package notification
+
+import (
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type expenseListener struct {
+	notificationService NotificationService
+}
+
+// NewExpenseListener evaluates the triggers of a saved expense in the background,
+// so slow delivery never holds up the request that saved it.
+func NewExpenseListener(notificationService NotificationService) expense.ExpenseListener {
+	return &expenseListener{notificationService: notificationService}
+}
+
+func (l *expenseListener) ExpenseSaved(saved expense.ExpenseEntity) {
+	go func() {
+		if err := l.notificationService.EvaluateExpense(saved); err != nil {
+			log.Error(err)
+		}
+	}()
+}
@@ -0,0 +1,71 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type GetNotificationsRequest struct {
+	Unread bool `query:"unread"`
+	Limit  int  `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type UpdateSettingsRequest struct {
+	LargeExpenseAmount *decimal.Decimal `json:"largeExpenseAmount"`
+	BudgetThresholds   *[]int           `json:"budgetThresholds" validate:"omitempty,max=5,dive,min=1,max=1000"`
+	UpcomingDays       *int             `json:"upcomingDays" validate:"omitempty,min=0,max=14"`
+	EmailEnabled       *bool            `json:"emailEnabled"`
+	WebhookURL         *string          `json:"webhookUrl" validate:"omitempty,https_url"`
+	WebhookSecret      *string          `json:"webhookSecret" validate:"omitempty,max=100"`
+}
+
+type NotificationResponse struct {
+	ID        uint             `json:"id"`
+	Type      NotificationType `json:"type"`
+	Title     string           `json:"title"`
+	Body      string           `json:"body"`
+	CreatedAt time.Time        `json:"createdAt"`
+	ReadAt    *time.Time       `json:"readAt"`
+}
+
+func (NotificationResponse) FromEntity(notification NotificationEntity) NotificationResponse {
+	return NotificationResponse{
+		ID:        notification.ID,
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		CreatedAt: notification.CreatedAt,
+		ReadAt:    notification.ReadAt,
+	}
+}
+
+type NotificationPage struct {
+	Items  []NotificationResponse `json:"items"`
+	Unread int64                  `json:"unread"`
+}
+
+type SettingsResponse struct {
+	LargeExpenseAmount *decimal.Decimal `json:"largeExpenseAmount"`
+	BudgetThresholds   []int            `json:"budgetThresholds"`
+	UpcomingDays       int              `json:"upcomingDays"`
+	EmailEnabled       bool             `json:"emailEnabled"`
+	WebhookURL         string           `json:"webhookUrl"`
+	HasWebhookSecret   bool             `json:"hasWebhookSecret"`
+}
+
+func (SettingsResponse) FromEntity(settings SettingsEntity) SettingsResponse {
+	var largeExpenseAmount *decimal.Decimal
+	if settings.LargeExpenseAmount.Valid {
+		largeExpenseAmount = &settings.LargeExpenseAmount.Decimal
+	}
+
+	return SettingsResponse{
+		LargeExpenseAmount: largeExpenseAmount,
+		BudgetThresholds:   settings.Thresholds(),
+		UpcomingDays:       settings.UpcomingDays,
+		EmailEnabled:       settings.EmailEnabled,
+		WebhookURL:         settings.WebhookURL,
+		HasWebhookSecret:   settings.WebhookSecret != "",
+	}
+}
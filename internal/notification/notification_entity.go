@@ -0,0 +1,71 @@
+package notification
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type NotificationType string
+
+const (
+	TypeBudgetThreshold   NotificationType = "budget_threshold"
+	TypeLargeExpense      NotificationType = "large_expense"
+	TypeUpcomingRecurring NotificationType = "upcoming_recurring"
+)
+
+// NotificationEntity is an entry in the user's in-app inbox. Key identifies what it is about,
+// so the same event is never notified twice.
+type NotificationEntity struct {
+	gorm.Model
+	UserID uint             `gorm:"not null;uniqueIndex:idx_notifications_user_key;index:idx_notifications_user_read"`
+	User   user.UserEntity  `gorm:"foreignKey:UserID"`
+	Type   NotificationType `gorm:"type:varchar(30);not null"`
+	Key    string           `gorm:"type:varchar(100);not null;uniqueIndex:idx_notifications_user_key"`
+	Title  string           `gorm:"type:varchar(200);not null"`
+	Body   string           `gorm:"type:text"`
+	ReadAt *time.Time       `gorm:"index:idx_notifications_user_read"`
+}
+
+func (NotificationEntity) TableName() string {
+	return "notifications"
+}
+
+// SettingsEntity holds a user's triggers and where notifications are delivered besides the inbox.
+type SettingsEntity struct {
+	UserID             uint                `gorm:"primaryKey"`
+	User               user.UserEntity     `gorm:"foreignKey:UserID"`
+	LargeExpenseAmount decimal.NullDecimal `gorm:"type:decimal(15,2)"`
+	BudgetThresholds   string              `gorm:"type:varchar(50);not null;default:'80,100'"`
+	UpcomingDays       int                 `gorm:"not null;default:3"`
+	EmailEnabled       bool                `gorm:"not null;default:false"`
+	WebhookURL         string              `gorm:"type:text"`
+	WebhookSecret      string              `gorm:"type:varchar(100)"`
+	UpdatedAt          time.Time
+}
+
+func (SettingsEntity) TableName() string {
+	return "notification_settings"
+}
+
+func DefaultSettings(userID uint) SettingsEntity {
+	return SettingsEntity{UserID: userID, BudgetThresholds: "80,100", UpcomingDays: 3}
+}
+
+// Thresholds returns the budget usage percentages to notify at, lowest first.
+func (s SettingsEntity) Thresholds() []int {
+	thresholds := []int{}
+	for _, value := range strings.Split(s.BudgetThresholds, ",") {
+		if threshold, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && threshold > 0 {
+			thresholds = append(thresholds, threshold)
+		}
+	}
+	slices.Sort(thresholds)
+
+	return slices.Compact(thresholds)
+}
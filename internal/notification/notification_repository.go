@@ -0,0 +1,103 @@
+package notification
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationRepository interface {
+	WithTx(tx *gorm.DB) NotificationRepository
+	GetByUser(userID uint, unreadOnly bool, limit int) ([]NotificationEntity, error)
+	CountUnread(userID uint) (int64, error)
+	Create(notification *NotificationEntity) (bool, error)
+	MarkRead(id uint, userID uint, at time.Time) (bool, error)
+	MarkAllRead(userID uint, at time.Time) (int64, error)
+	GetSettings(userID uint) (*SettingsEntity, error)
+	SaveSettings(settings *SettingsEntity) error
+}
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+func (r *notificationRepository) WithTx(tx *gorm.DB) NotificationRepository {
+	if tx == nil {
+		return r
+	}
+
+	return &notificationRepository{db: tx}
+}
+
+func (r *notificationRepository) GetByUser(userID uint, unreadOnly bool, limit int) ([]NotificationEntity, error) {
+	db := r.db.Where("user_id = ?", userID)
+	if unreadOnly {
+		db = db.Where("read_at IS NULL")
+	}
+
+	var notifications []NotificationEntity
+	if err := db.Order("created_at DESC, id DESC").Limit(limit).Find(&notifications).Error; err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (r *notificationRepository) CountUnread(userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&NotificationEntity{}).Where("user_id = ?", userID).Where("read_at IS NULL").Count(&count).Error
+
+	return count, err
+}
+
+// Create reports false when the user was already notified under the same key.
+func (r *notificationRepository) Create(notification *NotificationEntity) (bool, error) {
+	result := r.db.Omit("User").Clauses(clause.OnConflict{DoNothing: true}).Create(notification)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *notificationRepository) MarkRead(id uint, userID uint, at time.Time) (bool, error) {
+	var count int64
+	if err := r.db.Model(&NotificationEntity{}).Where("id = ?", id).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	if count == 0 {
+		return false, nil
+	}
+
+	err := r.db.Model(&NotificationEntity{}).
+		Where("id = ?", id).
+		Where("read_at IS NULL").
+		Update("read_at", at).
+		Error
+
+	return true, err
+}
+
+func (r *notificationRepository) MarkAllRead(userID uint, at time.Time) (int64, error) {
+	result := r.db.Model(&NotificationEntity{}).
+		Where("user_id = ?", userID).
+		Where("read_at IS NULL").
+		Update("read_at", at)
+
+	return result.RowsAffected, result.Error
+}
+
+func (r *notificationRepository) GetSettings(userID uint) (*SettingsEntity, error) {
+	var settings SettingsEntity
+	if err := r.db.Where("user_id = ?", userID).First(&settings).Error; err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+func (r *notificationRepository) SaveSettings(settings *SettingsEntity) error {
+	return r.db.Omit("User").Clauses(clause.OnConflict{UpdateAll: true}).Create(settings).Error
+}
@@ -0,0 +1,351 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/budget"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultNotificationLimit = 50
+	maxUpcomingDays          = 14
+)
+
+type NotificationService interface {
+	GetNotifications(authUserID uint, dto GetNotificationsRequest) ([]NotificationEntity, error)
+	CountUnread(authUserID uint) (int64, error)
+	MarkRead(id uint, authUserID uint) error
+	MarkAllRead(authUserID uint) (int64, error)
+	GetSettings(authUserID uint) (*SettingsEntity, error)
+	UpdateSettings(authUserID uint, dto UpdateSettingsRequest) (*SettingsEntity, error)
+	Notify(notification *NotificationEntity) (bool, error)
+	EvaluateExpense(saved expense.ExpenseEntity) error
+	NotifyUpcoming(now time.Time) (int, error)
+}
+
+type notificationService struct {
+	notificationRepo NotificationRepository
+	expenseRepo      expense.ExpenseRepository
+	recurringService expense.RecurringExpenseService
+	budgetService    budget.BudgetService
+	userService      user.UserService
+	channels         []Channel
+}
+
+func NewNotificationService(
+	notificationRepo NotificationRepository,
+	expenseRepo expense.ExpenseRepository,
+	recurringService expense.RecurringExpenseService,
+	budgetService budget.BudgetService,
+	userService user.UserService,
+	channels []Channel,
+) NotificationService {
+	return &notificationService{
+		notificationRepo: notificationRepo,
+		expenseRepo:      expenseRepo,
+		recurringService: recurringService,
+		budgetService:    budgetService,
+		userService:      userService,
+		channels:         channels,
+	}
+}
+
+func (s *notificationService) GetNotifications(authUserID uint, dto GetNotificationsRequest) ([]NotificationEntity, error) {
+	limit := dto.Limit
+	if limit == 0 {
+		limit = defaultNotificationLimit
+	}
+
+	return s.notificationRepo.GetByUser(authUserID, dto.Unread, limit)
+}
+
+func (s *notificationService) CountUnread(authUserID uint) (int64, error) {
+	return s.notificationRepo.CountUnread(authUserID)
+}
+
+func (s *notificationService) MarkRead(id uint, authUserID uint) error {
+	found, err := s.notificationRepo.MarkRead(id, authUserID, time.Now())
+	if err != nil {
+		return err
+	}
+	if !found {
+		return apperror.ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *notificationService) MarkAllRead(authUserID uint) (int64, error) {
+	return s.notificationRepo.MarkAllRead(authUserID, time.Now())
+}
+
+func (s *notificationService) GetSettings(authUserID uint) (*SettingsEntity, error) {
+	return s.settings(authUserID)
+}
+
+func (s *notificationService) UpdateSettings(authUserID uint, dto UpdateSettingsRequest) (*SettingsEntity, error) {
+	settings, err := s.settings(authUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if dto.LargeExpenseAmount != nil {
+		if dto.LargeExpenseAmount.IsNegative() {
+			return nil, apperror.ErrInvalidRequest
+		}
+		// zero turns the alert off
+		settings.LargeExpenseAmount = decimal.NullDecimal{Decimal: *dto.LargeExpenseAmount, Valid: dto.LargeExpenseAmount.IsPositive()}
+	}
+
+	if dto.BudgetThresholds != nil {
+		values := []string{}
+		for _, threshold := range *dto.BudgetThresholds {
+			values = append(values, strconv.Itoa(threshold))
+		}
+		settings.BudgetThresholds = strings.Join(values, ",")
+	}
+
+	if dto.UpcomingDays != nil {
+		settings.UpcomingDays = *dto.UpcomingDays
+	}
+
+	if dto.EmailEnabled != nil {
+		settings.EmailEnabled = *dto.EmailEnabled
+	}
+
+	if dto.WebhookURL != nil {
+		if *dto.WebhookURL != "" && !ValidWebhookURL(*dto.WebhookURL) {
+			return nil, apperror.ErrInvalidRequest
+		}
+		settings.WebhookURL = *dto.WebhookURL
+	}
+
+	if dto.WebhookSecret != nil {
+		settings.WebhookSecret = *dto.WebhookSecret
+	}
+
+	if err := s.notificationRepo.SaveSettings(settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// Notify stores the notification in the user's inbox and passes it on to the user's other channels.
+// It reports false, without delivering anything, when the user was already notified under the same key.
+func (s *notificationService) Notify(notification *NotificationEntity) (bool, error) {
+	created, err := s.notificationRepo.Create(notification)
+	if err != nil || !created {
+		return false, err
+	}
+
+	settings, err := s.settings(notification.UserID)
+	if err != nil {
+		return true, err
+	}
+
+	recipient := Recipient{
+		UserID:        notification.UserID,
+		WebhookURL:    settings.WebhookURL,
+		WebhookSecret: settings.WebhookSecret,
+	}
+	if settings.EmailEnabled {
+		u, err := s.userService.GetUserByID(notification.UserID, notification.UserID)
+		if err != nil {
+			return true, err
+		}
+		recipient.Email = u.Email
+	}
+
+	var errs []error
+	for _, channel := range s.channels {
+		if err := channel.Send(recipient, *notification); err != nil {
+			errs = append(errs, fmt.Errorf("%s delivery of notification %d: %w", channel.Name(), notification.ID, err))
+		}
+	}
+
+	return true, errors.Join(errs...)
+}
+
+// EvaluateExpense checks the triggers a saved expense can set off: the user's large expense alert
+// and every budget threshold crossed in the expense's month.
+func (s *notificationService) EvaluateExpense(saved expense.ExpenseEntity) error {
+	if saved.Kind == expense.KindIncome {
+		return nil
+	}
+
+	u, err := s.userService.GetUserByID(saved.UserID, saved.UserID)
+	if err != nil {
+		return err
+	}
+
+	settings, err := s.settings(saved.UserID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	if settings.LargeExpenseAmount.Valid {
+		errs = append(errs, s.checkLargeExpense(saved, u, settings.LargeExpenseAmount.Decimal))
+	}
+	errs = append(errs, s.checkBudgets(saved, u, settings.Thresholds()))
+
+	return errors.Join(errs...)
+}
+
+// NotifyUpcoming warns about recurring charges due within each user's chosen number of days.
+func (s *notificationService) NotifyUpcoming(now time.Time) (int, error) {
+	today := expense.TruncateToDate(now)
+	occurrences, err := s.recurringService.GetUpcoming(today, today.AddDate(0, 0, maxUpcomingDays))
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	var errs []error
+	settingsByUser := map[uint]*SettingsEntity{}
+	for _, occurrence := range occurrences {
+		recurring := occurrence.Recurring
+
+		settings, ok := settingsByUser[recurring.UserID]
+		if !ok {
+			settings, err = s.settings(recurring.UserID)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			settingsByUser[recurring.UserID] = settings
+		}
+		if occurrence.Date.After(today.AddDate(0, 0, settings.UpcomingDays)) {
+			continue
+		}
+
+		description := recurring.Note
+		if description == "" {
+			description = "A recurring expense"
+		}
+		date := occurrence.Date.Format("2006-01-02")
+
+		created, err := s.Notify(&NotificationEntity{
+			UserID: recurring.UserID,
+			Type:   TypeUpcomingRecurring,
+			Key:    fmt.Sprintf("recurring:%d:%s", recurring.ID, date),
+			Title:  fmt.Sprintf("Upcoming charge of %s %s on %s", recurring.Amount.StringFixed(2), recurring.Currency, date),
+			Body:   fmt.Sprintf("%s of %s %s is due on %s.", description, recurring.Amount.StringFixed(2), recurring.Currency, date),
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if created {
+			sent++
+		}
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+func (s *notificationService) checkLargeExpense(saved expense.ExpenseEntity, u *user.UserEntity, limit decimal.Decimal) error {
+	amount := saved.Amount
+	if saved.Currency != u.DefaultCurrency {
+		converted, err := s.expenseRepo.ConvertAmounts([]uint{saved.ID}, u.DefaultCurrency)
+		if err != nil {
+			return err
+		}
+		var ok bool
+		if amount, ok = converted[saved.ID]; !ok {
+			return nil
+		}
+	}
+	if amount.LessThan(limit) {
+		return nil
+	}
+
+	description := ""
+	if saved.Note != "" {
+		description = fmt.Sprintf(" (%s)", saved.Note)
+	}
+
+	_, err := s.Notify(&NotificationEntity{
+		UserID: saved.UserID,
+		Type:   TypeLargeExpense,
+		Key:    fmt.Sprintf("expense:%d:large", saved.ID),
+		Title:  fmt.Sprintf("Large expense of %s %s", saved.Amount.StringFixed(2), saved.Currency),
+		Body: fmt.Sprintf("An expense of %s %s%s is above your alert amount of %s %s.",
+			saved.Amount.StringFixed(2), saved.Currency, description, limit.StringFixed(2), u.DefaultCurrency),
+	})
+
+	return err
+}
+
+// checkBudgets notifies the highest threshold each budget has reached, once per budget, month and threshold.
+func (s *notificationService) checkBudgets(saved expense.ExpenseEntity, u *user.UserEntity, thresholds []int) error {
+	if len(thresholds) == 0 {
+		return nil
+	}
+
+	loc, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	month := time.Unix(saved.Date, 0).In(loc).Format("2006-01")
+
+	budgets, err := s.budgetService.GetBudgets(saved.UserID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, b := range budgets {
+		if month < b.StartMonth {
+			continue
+		}
+
+		progress, err := s.budgetService.GetProgress(b.ID, saved.UserID, budget.GetProgressRequest{Month: month})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		reached := 0
+		for _, threshold := range thresholds {
+			if progress.PercentUsed.GreaterThanOrEqual(decimal.NewFromInt(int64(threshold))) {
+				reached = threshold
+			}
+		}
+		if reached == 0 {
+			continue
+		}
+
+		if _, err := s.Notify(&NotificationEntity{
+			UserID: saved.UserID,
+			Type:   TypeBudgetThreshold,
+			Key:    fmt.Sprintf("budget:%d:%s:%d", b.ID, month, reached),
+			Title:  fmt.Sprintf("%s budget reached %d%% for %s", b.Name, reached, month),
+			Body: fmt.Sprintf("You have spent %s of %s %s (%s%%) in %s.",
+				progress.Spent.StringFixed(2), progress.Available.StringFixed(2), progress.Currency, progress.PercentUsed.StringFixed(0), month),
+		}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *notificationService) settings(userID uint) (*SettingsEntity, error) {
+	settings, err := s.notificationRepo.GetSettings(userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		defaults := DefaultSettings(userID)
+		return &defaults, nil
+	}
+
+	return settings, err
+}
@@ -0,0 +1,119 @@
+package notification_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/budget"
+	budgetMocks "github.com/Perajit/expense-tracker-go/internal/budget/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	expenseMocks "github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/notification"
+	"github.com/Perajit/expense-tracker-go/internal/notification/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestEvaluateExpense(t *testing.T) {
+	var userID uint = 11
+	date := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	u := &user.UserEntity{Model: gorm.Model{ID: userID}, Email: "ann@example.com", Timezone: "UTC", DefaultCurrency: "USD"}
+	settings := &notification.SettingsEntity{
+		UserID:             userID,
+		LargeExpenseAmount: decimal.NewNullDecimal(decimal.NewFromInt(500)),
+		BudgetThresholds:   "80,100",
+		WebhookURL:         "https://hooks.example.com/ann",
+	}
+	saved := expense.ExpenseEntity{
+		Model:    gorm.Model{ID: 9},
+		UserID:   userID,
+		Date:     date.Unix(),
+		Kind:     expense.KindExpense,
+		Amount:   decimal.NewFromInt(600),
+		Currency: "USD",
+		Note:     "New phone",
+	}
+	budgets := []budget.BudgetEntity{
+		{Model: gorm.Model{ID: 4}, UserID: userID, Name: "Gadgets", Amount: decimal.NewFromInt(700), StartMonth: "2026-01"},
+		{Model: gorm.Model{ID: 5}, UserID: userID, Name: "Food", Amount: decimal.NewFromInt(500), StartMonth: "2026-01"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockNotificationRepo := new(mocks.MockNotificationRepository)
+		mockNotificationRepo.On("GetSettings", userID).Return(settings, nil).Times(3)
+		mockNotificationRepo.On("Create", mock.MatchedBy(func(n *notification.NotificationEntity) bool {
+			return n.Type == notification.TypeLargeExpense && n.Key == "expense:9:large"
+		})).Return(true, nil).Once()
+		mockNotificationRepo.On("Create", mock.MatchedBy(func(n *notification.NotificationEntity) bool {
+			return n.Type == notification.TypeBudgetThreshold && n.Key == "budget:4:2026-10:80" &&
+				n.Title == "Gadgets budget reached 80% for 2026-10"
+		})).Return(true, nil).Once()
+
+		mockBudgetService := new(budgetMocks.MockBudgetService)
+		mockBudgetService.On("GetBudgets", userID).Return(budgets, nil).Once()
+		mockBudgetService.On("GetProgress", uint(4), userID, budget.GetProgressRequest{Month: "2026-10"}).
+			Return(&budget.ProgressResponse{Currency: "USD", Available: decimal.NewFromInt(700), Spent: decimal.NewFromInt(650), PercentUsed: decimal.RequireFromString("92.86")}, nil).Once()
+		mockBudgetService.On("GetProgress", uint(5), userID, budget.GetProgressRequest{Month: "2026-10"}).
+			Return(&budget.ProgressResponse{Currency: "USD", Available: decimal.NewFromInt(500), Spent: decimal.NewFromInt(120), PercentUsed: decimal.NewFromInt(24)}, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(u, nil).Once()
+
+		mockChannel := new(mocks.MockChannel)
+		mockChannel.On("Send", notification.Recipient{UserID: userID, WebhookURL: settings.WebhookURL}, mock.Anything).Return(nil).Twice()
+
+		service := notification.NewNotificationService(mockNotificationRepo, new(expenseMocks.MockExpenseRepository), new(expenseMocks.MockRecurringExpenseService), mockBudgetService, mockUserService, []notification.Channel{mockChannel})
+		err := service.EvaluateExpense(saved)
+
+		assert.NoError(t, err)
+		mockNotificationRepo.AssertExpectations(t)
+		mockChannel.AssertExpectations(t)
+	})
+
+	t.Run("success_already_notified", func(t *testing.T) {
+		small := saved
+		small.Amount = decimal.NewFromInt(50)
+
+		mockNotificationRepo := new(mocks.MockNotificationRepository)
+		mockNotificationRepo.On("GetSettings", userID).Return(settings, nil).Once()
+		mockNotificationRepo.On("Create", mock.MatchedBy(func(n *notification.NotificationEntity) bool {
+			return n.Key == "budget:4:2026-10:100"
+		})).Return(false, nil).Once()
+
+		mockBudgetService := new(budgetMocks.MockBudgetService)
+		mockBudgetService.On("GetBudgets", userID).Return(budgets[:1], nil).Once()
+		mockBudgetService.On("GetProgress", uint(4), userID, budget.GetProgressRequest{Month: "2026-10"}).
+			Return(&budget.ProgressResponse{Currency: "USD", Available: decimal.NewFromInt(700), Spent: decimal.NewFromInt(700), PercentUsed: decimal.NewFromInt(100)}, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(u, nil).Once()
+
+		mockChannel := new(mocks.MockChannel)
+
+		service := notification.NewNotificationService(mockNotificationRepo, new(expenseMocks.MockExpenseRepository), new(expenseMocks.MockRecurringExpenseService), mockBudgetService, mockUserService, []notification.Channel{mockChannel})
+		err := service.EvaluateExpense(small)
+
+		assert.NoError(t, err)
+		mockNotificationRepo.AssertExpectations(t)
+		mockChannel.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("success_income", func(t *testing.T) {
+		income := saved
+		income.Kind = expense.KindIncome
+
+		mockNotificationRepo := new(mocks.MockNotificationRepository)
+		mockBudgetService := new(budgetMocks.MockBudgetService)
+
+		service := notification.NewNotificationService(mockNotificationRepo, new(expenseMocks.MockExpenseRepository), new(expenseMocks.MockRecurringExpenseService), mockBudgetService, new(userMocks.MockUserService), nil)
+		err := service.EvaluateExpense(income)
+
+		assert.NoError(t, err)
+		mockNotificationRepo.AssertNotCalled(t, "Create", mock.Anything)
+		mockBudgetService.AssertNotCalled(t, "GetBudgets", mock.Anything)
+	})
+}
@@ -0,0 +1,51 @@
+package notification_test
+
+import (
+	"testing"
+	"time"
+
+	budgetMocks "github.com/Perajit/expense-tracker-go/internal/budget/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/expense"
+	expenseMocks "github.com/Perajit/expense-tracker-go/internal/expense/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/notification"
+	"github.com/Perajit/expense-tracker-go/internal/notification/mocks"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestNotifyUpcoming(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var userID uint = 11
+		now := time.Date(2026, 10, 18, 7, 30, 0, 0, time.UTC)
+		today := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+		rent := expense.RecurringExpenseEntity{Model: gorm.Model{ID: 2}, UserID: userID, Amount: decimal.NewFromInt(900), Currency: "EUR", Note: "Rent"}
+		gym := expense.RecurringExpenseEntity{Model: gorm.Model{ID: 3}, UserID: userID, Amount: decimal.NewFromInt(30), Currency: "EUR"}
+
+		mockRecurringService := new(expenseMocks.MockRecurringExpenseService)
+		mockRecurringService.On("GetUpcoming", today, today.AddDate(0, 0, 14)).Return([]expense.UpcomingOccurrence{
+			{Recurring: rent, Date: today.AddDate(0, 0, 2)},
+			{Recurring: gym, Date: today.AddDate(0, 0, 10)},
+		}, nil).Once()
+
+		mockNotificationRepo := new(mocks.MockNotificationRepository)
+		mockNotificationRepo.On("GetSettings", userID).Return(nil, gorm.ErrRecordNotFound).Twice()
+		mockNotificationRepo.On("Create", mock.MatchedBy(func(n *notification.NotificationEntity) bool {
+			return n.UserID == userID && n.Type == notification.TypeUpcomingRecurring && n.Key == "recurring:2:2026-10-20" &&
+				n.Body == "Rent of 900.00 EUR is due on 2026-10-20."
+		})).Return(true, nil).Once()
+
+		mockChannel := new(mocks.MockChannel)
+		mockChannel.On("Send", notification.Recipient{UserID: userID}, mock.Anything).Return(nil).Once()
+
+		service := notification.NewNotificationService(mockNotificationRepo, new(expenseMocks.MockExpenseRepository), mockRecurringService, new(budgetMocks.MockBudgetService), new(userMocks.MockUserService), []notification.Channel{mockChannel})
+		sent, err := service.NotifyUpcoming(now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, sent)
+		mockNotificationRepo.AssertExpectations(t)
+		mockChannel.AssertExpectations(t)
+	})
+}
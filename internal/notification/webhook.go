@@ -0,0 +1,165 @@
+package notification
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	webhookTimeout      = 10 * time.Second
+	webhookMaxRedirects = 5
+)
+
+// ErrWebhookDestination is returned for a webhook that is not https or leads to a private, loopback or link-local address.
+var ErrWebhookDestination = errors.New("webhook destination not allowed")
+
+// nonPublicPrefixes are ranges that netip does not already flag as private, loopback or link-local.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+}
+
+type webhookChannel struct {
+	client *http.Client
+}
+
+// NewWebhookChannel posts notifications as JSON to the recipient's URL. When the recipient has a secret,
+// the body's HMAC-SHA256 is sent in the X-Signature header so the receiver can verify it.
+// Without a client of its own it only ever connects to public addresses, see newWebhookClient.
+func NewWebhookChannel(client *http.Client) Channel {
+	if client == nil {
+		client = newWebhookClient()
+	}
+
+	return &webhookChannel{client: client}
+}
+
+// newWebhookClient checks every address it dials, after DNS resolution, so a host name that later resolves
+// to an internal service is refused as well. Redirects must stay on https and go through the same check.
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{Timeout: webhookTimeout, Control: dialPublicOnly}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would be dialed instead of the destination, leaving the destination unchecked
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   webhookTimeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if req.URL.Scheme != "https" {
+				return ErrWebhookDestination
+			}
+			if len(via) >= webhookMaxRedirects {
+				return fmt.Errorf("webhook redirected more than %d times", webhookMaxRedirects)
+			}
+			return nil
+		},
+	}
+}
+
+func dialPublicOnly(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !isPublicAddr(ip) {
+		return fmt.Errorf("%w: %s", ErrWebhookDestination, ip)
+	}
+
+	return nil
+}
+
+func isPublicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidWebhookURL accepts an https URL whose host is not plainly internal: localhost or a non-public address.
+// Host names are checked again when dialed.
+func ValidWebhookURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return false
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+
+	if ip, err := netip.ParseAddr(host); err == nil {
+		return isPublicAddr(ip)
+	}
+
+	return true
+}
+
+func (c *webhookChannel) Name() string {
+	return "webhook"
+}
+
+func (c *webhookChannel) Send(recipient Recipient, notification NotificationEntity) error {
+	if recipient.WebhookURL == "" {
+		return nil
+	}
+	if !ValidWebhookURL(recipient.WebhookURL) {
+		return ErrWebhookDestination
+	}
+
+	body, err := json.Marshal(NotificationResponse{}.FromEntity(notification))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, recipient.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if recipient.WebhookSecret != "" {
+		mac := hmac.New(sha256.New, []byte(recipient.WebhookSecret))
+		mac.Write(body)
+		req.Header.Set("X-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package notification_test
+
+import (
+	"testing"
+
+	"github.com/Perajit/expense-tracker-go/internal/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSend(t *testing.T) {
+	t.Run("error_destination_not_allowed", func(t *testing.T) {
+		urls := []string{
+			"http://hooks.example.com/ann",
+			"https://127.0.0.1:8443/hook",
+			"https://localhost/hook",
+			"https://10.0.0.5/hook",
+			"https://169.254.169.254/latest/meta-data",
+			"https://[::1]/hook",
+			"https://[::ffff:192.168.1.1]/hook",
+		}
+
+		channel := notification.NewWebhookChannel(nil)
+		for _, url := range urls {
+			err := channel.Send(notification.Recipient{UserID: 11, WebhookURL: url}, notification.NotificationEntity{Title: "hello"})
+
+			assert.ErrorIs(t, err, notification.ErrWebhookDestination, url)
+		}
+	})
+}
+
+func TestValidWebhookURL(t *testing.T) {
+	assert.True(t, notification.ValidWebhookURL("https://hooks.example.com/ann"))
+	assert.True(t, notification.ValidWebhookURL("https://8.8.8.8/hook"))
+	assert.False(t, notification.ValidWebhookURL("http://hooks.example.com/ann"))
+	assert.False(t, notification.ValidWebhookURL("https://192.168.0.1/hook"))
+	assert.False(t, notification.ValidWebhookURL("https://100.64.0.1/hook"))
+	assert.False(t, notification.ValidWebhookURL("https://api.localhost/hook"))
+}
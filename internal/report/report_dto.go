@@ -0,0 +1,112 @@
+package report
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+type GetSummaryRequest struct {
+	From     string `query:"from" validate:"required,datetime=2006-01-02"`
+	To       string `query:"to" validate:"required,datetime=2006-01-02"`
+	Period   string `query:"period" validate:"omitempty,oneof=day week month year"`
+	Timezone string `query:"tz" validate:"omitempty,timezone"`
+	Currency string `query:"currency" validate:"omitempty,iso4217"`
+}
+
+type GetCashFlowRequest struct {
+	From     string `query:"from" validate:"required,datetime=2006-01-02"`
+	To       string `query:"to" validate:"required,datetime=2006-01-02"`
+	Period   string `query:"period" validate:"omitempty,oneof=day week month year"`
+	Timezone string `query:"tz" validate:"omitempty,timezone"`
+	Currency string `query:"currency" validate:"omitempty,iso4217"`
+}
+
+type TotalRow struct {
+	Total       decimal.Decimal `json:"total"`
+	Count       int64           `json:"count"`
+	Unconverted int64           `json:"unconverted"`
+}
+
+// CurrencyTotalRow shows what was spent in one currency next to its converted total.
+type CurrencyTotalRow struct {
+	Currency string          `json:"currency"`
+	Original decimal.Decimal `json:"original"`
+	Total    decimal.Decimal `json:"total"`
+	Count    int64           `json:"count"`
+}
+
+// CategoryTotalRow totals a category together with everything below it.
+type CategoryTotalRow struct {
+	CategoryID uint            `json:"categoryId"`
+	ParentID   uint            `json:"parentId"`
+	Name       string          `json:"name"`
+	Total      decimal.Decimal `json:"total"`
+	Count      int64           `json:"count"`
+}
+
+type TagTotalRow struct {
+	TagID uint            `json:"tagId"`
+	Name  string          `json:"name"`
+	Total decimal.Decimal `json:"total"`
+	Count int64           `json:"count"`
+}
+
+type MerchantTotalRow struct {
+	MerchantID uint            `json:"merchantId"`
+	Name       string          `json:"name"`
+	Total      decimal.Decimal `json:"total"`
+	Count      int64           `json:"count"`
+}
+
+type PeriodTotalRow struct {
+	Period string          `json:"period"`
+	Total  decimal.Decimal `json:"total"`
+	Count  int64           `json:"count"`
+}
+
+type CategoryPeriodTotalRow struct {
+	Period     string          `json:"period"`
+	CategoryID uint            `json:"categoryId"`
+	ParentID   uint            `json:"parentId"`
+	Name       string          `json:"name"`
+	Total      decimal.Decimal `json:"total"`
+	Count      int64           `json:"count"`
+}
+
+type SummaryResponse struct {
+	From            string                   `json:"from"`
+	To              string                   `json:"to"`
+	Timezone        string                   `json:"timezone"`
+	Currency        string                   `json:"currency"`
+	Period          Period                   `json:"period"`
+	Total           decimal.Decimal          `json:"total"`
+	Count           int64                    `json:"count"`
+	Unconverted     int64                    `json:"unconverted"`
+	ByCurrency      []CurrencyTotalRow       `json:"byCurrency"`
+	ByCategory      []CategoryTotalRow       `json:"byCategory"`
+	ByTag           []TagTotalRow            `json:"byTag"`
+	ByMerchant      []MerchantTotalRow       `json:"byMerchant"`
+	ByPeriod        []PeriodTotalRow         `json:"byPeriod"`
+	ByCategoryMonth []CategoryPeriodTotalRow `json:"byCategoryMonth"`
+}
+
+type CashFlowRow struct {
+	Period      string          `json:"period"`
+	Income      decimal.Decimal `json:"income"`
+	Expense     decimal.Decimal `json:"expense"`
+	Net         decimal.Decimal `json:"net"`
+	Count       int64           `json:"count"`
+	Unconverted int64           `json:"unconverted"`
+}
+
+type CashFlowResponse struct {
+	From        string          `json:"from"`
+	To          string          `json:"to"`
+	Timezone    string          `json:"timezone"`
+	Currency    string          `json:"currency"`
+	Period      Period          `json:"period"`
+	Income      decimal.Decimal `json:"income"`
+	Expense     decimal.Decimal `json:"expense"`
+	Net         decimal.Decimal `json:"net"`
+	Unconverted int64           `json:"unconverted"`
+	ByPeriod    []CashFlowRow   `json:"byPeriod"`
+}
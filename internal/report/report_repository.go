@@ -0,0 +1,252 @@
+package report
+
+import (
+	"fmt"
+
+	"github.com/Perajit/expense-tracker-go/internal/currency"
+	"gorm.io/gorm"
+)
+
+type Period string
+
+const (
+	PeriodDay   Period = "day"
+	PeriodWeek  Period = "week"
+	PeriodMonth Period = "month"
+	PeriodYear  Period = "year"
+)
+
+// Kinds mirror expense.Kind; spending reports only look at expenses.
+const (
+	KindExpense = "expense"
+	KindIncome  = "income"
+)
+
+// lineAmount and lineCategory take a split's values over its expense's.
+const (
+	lineAmount   = "COALESCE(s.amount, e.amount)"
+	lineCategory = "COALESCE(s.category_id, e.category_id)"
+)
+
+type ReportFilter struct {
+	UserID      uint
+	From        int64
+	To          int64
+	Timezone    string
+	Currency    string
+	Kind        string
+	CategoryIDs []uint
+	TagIDs      []uint
+}
+
+type ReportRepository interface {
+	Total(filter ReportFilter) (*TotalRow, error)
+	SumByCurrency(filter ReportFilter) ([]CurrencyTotalRow, error)
+	SumByCategory(filter ReportFilter) ([]CategoryTotalRow, error)
+	SumByTag(filter ReportFilter) ([]TagTotalRow, error)
+	SumByMerchant(filter ReportFilter) ([]MerchantTotalRow, error)
+	SumByPeriod(filter ReportFilter, period Period) ([]PeriodTotalRow, error)
+	SumByCategoryAndPeriod(filter ReportFilter, period Period) ([]CategoryPeriodTotalRow, error)
+	CashFlowByPeriod(filter ReportFilter, period Period) ([]CashFlowRow, error)
+}
+
+type reportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) ReportRepository {
+	return &reportRepository{db: db}
+}
+
+func (r *reportRepository) Total(filter ReportFilter) (*TotalRow, error) {
+	var row TotalRow
+	if err := r.db.Table("(?) AS l", r.lines(filter)).
+		Select("COALESCE(SUM(l.amount), 0) AS total, COUNT(DISTINCT l.expense_id) AS count, " +
+			"COUNT(DISTINCT l.expense_id) FILTER (WHERE l.amount IS NULL) AS unconverted").
+		Scan(&row).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func (r *reportRepository) SumByCurrency(filter ReportFilter) ([]CurrencyTotalRow, error) {
+	var rows []CurrencyTotalRow
+	if err := r.db.Table("(?) AS l", r.lines(filter)).
+		Select("l.currency, SUM(l.original_amount) AS original, SUM(l.amount) AS total, COUNT(DISTINCT l.expense_id) AS count").
+		Group("l.currency").
+		Order("l.currency").
+		Scan(&rows).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// SumByCategory rolls spending up the category tree, so a parent's total includes all of its children.
+func (r *reportRepository) SumByCategory(filter ReportFilter) ([]CategoryTotalRow, error) {
+	var rows []CategoryTotalRow
+	if err := r.db.Table("(?) AS l", r.lines(filter)).
+		Select("c.id AS category_id, c.parent_id, c.name, SUM(l.amount) AS total, COUNT(DISTINCT l.expense_id) AS count").
+		Joins("JOIN (?) AS ct ON ct.descendant_id = l.category_id", r.categoryTree(filter.UserID)).
+		Joins("JOIN expense_categories c ON c.id = ct.ancestor_id").
+		Group("c.id, c.parent_id, c.name").
+		Order("total DESC").
+		Scan(&rows).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (r *reportRepository) SumByTag(filter ReportFilter) ([]TagTotalRow, error) {
+	var rows []TagTotalRow
+	if err := r.db.Table("(?) AS l", r.lines(filter)).
+		Select("t.id AS tag_id, t.name, SUM(l.amount) AS total, COUNT(DISTINCT l.expense_id) AS count").
+		Joins("JOIN LATERAL (SELECT tag_entity_id FROM expenses_tags WHERE expense_entity_id = l.expense_id " +
+			"UNION SELECT tag_entity_id FROM expense_splits_tags WHERE expense_split_entity_id = l.split_id) AS lt ON true").
+		Joins("JOIN expense_tags t ON t.id = lt.tag_entity_id AND t.deleted_at IS NULL").
+		Group("t.id, t.name").
+		Order("total DESC").
+		Scan(&rows).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// SumByMerchant leaves out expenses without a merchant.
+func (r *reportRepository) SumByMerchant(filter ReportFilter) ([]MerchantTotalRow, error) {
+	var rows []MerchantTotalRow
+	if err := r.db.Table("(?) AS l", r.lines(filter)).
+		Select("m.id AS merchant_id, m.name, SUM(l.amount) AS total, COUNT(DISTINCT l.expense_id) AS count").
+		Joins("JOIN merchants m ON m.id = l.merchant_id AND m.deleted_at IS NULL").
+		Group("m.id, m.name").
+		Order("total DESC").
+		Scan(&rows).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (r *reportRepository) SumByPeriod(filter ReportFilter, period Period) ([]PeriodTotalRow, error) {
+	var rows []PeriodTotalRow
+	bucket := BucketExpr(period, "l.date")
+	if err := r.db.Table("(?) AS l", r.lines(filter)).
+		Select(fmt.Sprintf("%s AS period, SUM(l.amount) AS total, COUNT(DISTINCT l.expense_id) AS count", bucket), filter.Timezone).
+		Group("period").
+		Order("period").
+		Scan(&rows).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (r *reportRepository) SumByCategoryAndPeriod(filter ReportFilter, period Period) ([]CategoryPeriodTotalRow, error) {
+	var rows []CategoryPeriodTotalRow
+	bucket := BucketExpr(period, "l.date")
+	if err := r.db.Table("(?) AS l", r.lines(filter)).
+		Select(fmt.Sprintf("%s AS period, c.id AS category_id, c.parent_id, c.name, SUM(l.amount) AS total, COUNT(DISTINCT l.expense_id) AS count", bucket), filter.Timezone).
+		Joins("JOIN (?) AS ct ON ct.descendant_id = l.category_id", r.categoryTree(filter.UserID)).
+		Joins("JOIN expense_categories c ON c.id = ct.ancestor_id").
+		Group("period, c.id, c.parent_id, c.name").
+		Order("period, total DESC").
+		Scan(&rows).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// CashFlowByPeriod splits every period into income and expense regardless of filter.Kind.
+func (r *reportRepository) CashFlowByPeriod(filter ReportFilter, period Period) ([]CashFlowRow, error) {
+	filter.Kind = ""
+
+	var rows []CashFlowRow
+	bucket := BucketExpr(period, "l.date")
+	if err := r.db.Table("(?) AS l", r.lines(filter)).
+		Select(fmt.Sprintf("%s AS period, "+
+			"COALESCE(SUM(l.amount) FILTER (WHERE l.kind = '%s'), 0) AS income, "+
+			"COALESCE(SUM(l.amount) FILTER (WHERE l.kind = '%s'), 0) AS expense, "+
+			"COUNT(DISTINCT l.expense_id) AS count, "+
+			"COUNT(DISTINCT l.expense_id) FILTER (WHERE l.amount IS NULL) AS unconverted", bucket, KindIncome, KindExpense), filter.Timezone).
+		Group("period").
+		Order("period").
+		Scan(&rows).
+		Error; err != nil {
+		return nil, err
+	}
+
+	for i := range rows {
+		rows[i].Net = rows[i].Income.Sub(rows[i].Expense)
+	}
+
+	return rows, nil
+}
+
+// lines selects one row per amount to be aggregated, so every report shares the same filtering.
+// A split expense gives one row per split, filed under the split's category; a line carries its expense's tags and its split's.
+// With a currency set, amount is converted into it and is NULL when no rate is known.
+func (r *reportRepository) lines(filter ReportFilter) *gorm.DB {
+	amount, args := lineAmount, []any{}
+	if filter.Currency != "" {
+		amount = fmt.Sprintf("ROUND(%s * %s, 2)", lineAmount, currency.RateExpr("e.currency", "e.date"))
+		args = currency.RateArgs(filter.Currency)
+	}
+
+	db := r.db.Session(&gorm.Session{NewDB: true}).
+		Table("expenses e").
+		Select(fmt.Sprintf("e.id AS expense_id, s.id AS split_id, e.date, e.kind, %s AS category_id, e.merchant_id, e.currency, %s AS original_amount, %s AS amount",
+			lineCategory, lineAmount, amount), args...).
+		Joins("LEFT JOIN expense_splits s ON s.expense_id = e.id").
+		Where("e.deleted_at IS NULL").
+		Where("e.user_id = ?", filter.UserID).
+		Where("e.date BETWEEN ? AND ?", filter.From, filter.To)
+
+	if filter.Kind != "" {
+		db = db.Where("e.kind = ?", filter.Kind)
+	}
+
+	if len(filter.CategoryIDs) > 0 {
+		db = db.Where(lineCategory+" IN (SELECT ct.descendant_id FROM (?) AS ct WHERE ct.ancestor_id IN ?)",
+			r.categoryTree(filter.UserID), filter.CategoryIDs)
+	}
+
+	if len(filter.TagIDs) > 0 {
+		db = db.Where("(e.id IN (SELECT expense_entity_id FROM expenses_tags WHERE tag_entity_id IN ?) "+
+			"OR s.id IN (SELECT expense_split_entity_id FROM expense_splits_tags WHERE tag_entity_id IN ?))", filter.TagIDs, filter.TagIDs)
+	}
+
+	return db
+}
+
+// categoryTree pairs every category the user can see with itself and each category below it.
+func (r *reportRepository) categoryTree(userID uint) *gorm.DB {
+	return r.db.Session(&gorm.Session{NewDB: true}).Raw(`WITH RECURSIVE tree AS (
+			SELECT id AS ancestor_id, id AS descendant_id FROM expense_categories WHERE user_id IN (?, 0)
+			UNION
+			SELECT tree.ancestor_id, c.id FROM tree JOIN expense_categories c ON c.parent_id = tree.descendant_id WHERE c.user_id IN (?, 0)
+		) SELECT ancestor_id, descendant_id FROM tree`, userID, userID)
+}
+
+// BucketExpr labels a unix timestamp column with the period it falls in; the timezone is its only argument.
+func BucketExpr(period Period, column string) string {
+	format := "YYYY-MM-DD"
+	switch period {
+	case PeriodMonth:
+		format = "YYYY-MM"
+	case PeriodYear:
+		format = "YYYY"
+	}
+
+	return fmt.Sprintf("to_char(date_trunc('%s', to_timestamp(%s) AT TIME ZONE ?), '%s')", period, column, format)
+}
@@ -0,0 +1,196 @@
+package report
+
+import (
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+)
+
+type ReportService interface {
+	GetSummary(authUserID uint, dto GetSummaryRequest) (*SummaryResponse, error)
+	GetCashFlow(authUserID uint, dto GetCashFlowRequest) (*CashFlowResponse, error)
+}
+
+type reportService struct {
+	reportRepo  ReportRepository
+	userService user.UserService
+}
+
+func NewReportService(reportRepo ReportRepository, userService user.UserService) ReportService {
+	return &reportService{
+		reportRepo:  reportRepo,
+		userService: userService,
+	}
+}
+
+func (s *reportService) GetSummary(authUserID uint, dto GetSummaryRequest) (*SummaryResponse, error) {
+	loc, currency, err := s.resolveSettings(authUserID, dto.Timezone, dto.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err := ParseDateRange(dto.From, dto.To, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	period := PeriodMonth
+	if dto.Period != "" {
+		period = Period(dto.Period)
+	}
+
+	filter := ReportFilter{
+		UserID:   authUserID,
+		From:     from.Unix(),
+		To:       to.Unix(),
+		Timezone: loc.String(),
+		Currency: currency,
+		Kind:     KindExpense,
+	}
+
+	total, err := s.reportRepo.Total(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	byCurrency, err := s.reportRepo.SumByCurrency(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	byCategory, err := s.reportRepo.SumByCategory(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	byTag, err := s.reportRepo.SumByTag(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	byMerchant, err := s.reportRepo.SumByMerchant(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	byPeriod, err := s.reportRepo.SumByPeriod(filter, period)
+	if err != nil {
+		return nil, err
+	}
+
+	byCategoryMonth, err := s.reportRepo.SumByCategoryAndPeriod(filter, PeriodMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SummaryResponse{
+		From:            dto.From,
+		To:              dto.To,
+		Timezone:        loc.String(),
+		Currency:        currency,
+		Period:          period,
+		Total:           total.Total,
+		Count:           total.Count,
+		Unconverted:     total.Unconverted,
+		ByCurrency:      byCurrency,
+		ByCategory:      byCategory,
+		ByTag:           byTag,
+		ByMerchant:      byMerchant,
+		ByPeriod:        byPeriod,
+		ByCategoryMonth: byCategoryMonth,
+	}, nil
+}
+
+func (s *reportService) GetCashFlow(authUserID uint, dto GetCashFlowRequest) (*CashFlowResponse, error) {
+	loc, currency, err := s.resolveSettings(authUserID, dto.Timezone, dto.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err := ParseDateRange(dto.From, dto.To, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	period := PeriodMonth
+	if dto.Period != "" {
+		period = Period(dto.Period)
+	}
+
+	filter := ReportFilter{
+		UserID:   authUserID,
+		From:     from.Unix(),
+		To:       to.Unix(),
+		Timezone: loc.String(),
+		Currency: currency,
+	}
+
+	rows, err := s.reportRepo.CashFlowByPeriod(filter, period)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CashFlowResponse{
+		From:     dto.From,
+		To:       dto.To,
+		Timezone: loc.String(),
+		Currency: currency,
+		Period:   period,
+		ByPeriod: rows,
+	}
+	for _, row := range rows {
+		response.Income = response.Income.Add(row.Income)
+		response.Expense = response.Expense.Add(row.Expense)
+		response.Unconverted += row.Unconverted
+	}
+	response.Net = response.Income.Sub(response.Expense)
+
+	return response, nil
+}
+
+// resolveSettings prefers the requested timezone and currency and falls back to the ones stored on the user.
+func (s *reportService) resolveSettings(authUserID uint, timezone string, currency string) (*time.Location, string, error) {
+	if timezone == "" || currency == "" {
+		u, err := s.userService.GetUserByID(authUserID, authUserID)
+		if err != nil {
+			return nil, "", err
+		}
+		if timezone == "" {
+			timezone = u.Timezone
+		}
+		if currency == "" {
+			currency = u.DefaultCurrency
+		}
+	}
+
+	if timezone == "" {
+		return time.UTC, currency, nil
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, "", apperror.ErrInvalidRequest
+	}
+
+	return loc, currency, nil
+}
+
+// ParseDateRange turns inclusive calendar dates into the first and last second they cover in loc.
+func ParseDateRange(fromStr string, toStr string, loc *time.Location) (time.Time, time.Time, error) {
+	from, err := time.ParseInLocation(time.DateOnly, fromStr, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, apperror.ErrInvalidRequest
+	}
+
+	to, err := time.ParseInLocation(time.DateOnly, toStr, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, apperror.ErrInvalidRequest
+	}
+
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, apperror.ErrInvalidRequest
+	}
+
+	return from, to.AddDate(0, 0, 1).Add(-time.Second), nil
+}
@@ -0,0 +1,71 @@
+package report_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/report"
+	reportMocks "github.com/Perajit/expense-tracker-go/internal/report/mocks"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCashFlow(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var userID uint = 11
+		dto := report.GetCashFlowRequest{From: "2026-01-01", To: "2026-02-28", Timezone: "UTC", Currency: "USD"}
+		filter := report.ReportFilter{
+			UserID:   userID,
+			From:     time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Unix(),
+			To:       time.Date(2026, 2, 28, 23, 59, 59, 0, time.UTC).Unix(),
+			Timezone: "UTC",
+			Currency: "USD",
+		}
+		rows := []report.CashFlowRow{
+			{Period: "2026-01", Income: decimal.NewFromInt(3000), Expense: decimal.NewFromInt(1200), Net: decimal.NewFromInt(1800), Count: 8},
+			{Period: "2026-02", Income: decimal.Zero, Expense: decimal.RequireFromString("450.50"), Net: decimal.RequireFromString("-450.50"), Count: 4, Unconverted: 1},
+		}
+
+		mockReportRepo := new(reportMocks.MockReportRepository)
+		mockReportRepo.On("CashFlowByPeriod", filter, report.PeriodMonth).Return(rows, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+
+		service := report.NewReportService(mockReportRepo, mockUserService)
+		cashFlow, err := service.GetCashFlow(userID, dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, report.PeriodMonth, cashFlow.Period)
+		assert.True(t, decimal.NewFromInt(3000).Equal(cashFlow.Income))
+		assert.True(t, decimal.RequireFromString("1650.50").Equal(cashFlow.Expense))
+		assert.True(t, decimal.RequireFromString("1349.50").Equal(cashFlow.Net))
+		assert.Equal(t, int64(1), cashFlow.Unconverted)
+		assert.Equal(t, rows, cashFlow.ByPeriod)
+		mockReportRepo.AssertExpectations(t)
+	})
+
+	t.Run("error_repository", func(t *testing.T) {
+		var userID uint = 11
+		expectedErr := errors.New("db error")
+		dto := report.GetCashFlowRequest{From: "2026-01-01", To: "2026-01-31", Period: "week", Timezone: "UTC", Currency: "USD"}
+
+		mockReportRepo := new(reportMocks.MockReportRepository)
+		mockReportRepo.On("CashFlowByPeriod", report.ReportFilter{
+			UserID:   userID,
+			From:     time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Unix(),
+			To:       time.Date(2026, 1, 31, 23, 59, 59, 0, time.UTC).Unix(),
+			Timezone: "UTC",
+			Currency: "USD",
+		}, report.PeriodWeek).Return(nil, expectedErr).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+
+		service := report.NewReportService(mockReportRepo, mockUserService)
+		cashFlow, err := service.GetCashFlow(userID, dto)
+
+		assert.Nil(t, cashFlow)
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -0,0 +1,107 @@
+package report_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Perajit/expense-tracker-go/internal/apperror"
+	"github.com/Perajit/expense-tracker-go/internal/report"
+	reportMocks "github.com/Perajit/expense-tracker-go/internal/report/mocks"
+	"github.com/Perajit/expense-tracker-go/internal/user"
+	userMocks "github.com/Perajit/expense-tracker-go/internal/user/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestGetSummary(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var userID uint = 11
+		loc, _ := time.LoadLocation("Asia/Bangkok")
+		dto := report.GetSummaryRequest{From: "2026-01-01", To: "2026-01-31", Period: "week"}
+		filter := report.ReportFilter{
+			UserID:   userID,
+			From:     time.Date(2026, 1, 1, 0, 0, 0, 0, loc).Unix(),
+			To:       time.Date(2026, 1, 31, 23, 59, 59, 0, loc).Unix(),
+			Timezone: "Asia/Bangkok",
+			Currency: "THB",
+			Kind:     report.KindExpense,
+		}
+		total := &report.TotalRow{Total: decimal.RequireFromString("150.25"), Count: 3, Unconverted: 1}
+		byCurrency := []report.CurrencyTotalRow{
+			{Currency: "THB", Original: decimal.RequireFromString("114.25"), Total: decimal.RequireFromString("114.25"), Count: 2},
+			{Currency: "USD", Original: decimal.NewFromInt(1), Total: decimal.NewFromInt(36), Count: 1},
+		}
+		byCategory := []report.CategoryTotalRow{
+			{CategoryID: 2, Name: "cat2", Total: decimal.RequireFromString("100.25"), Count: 2},
+			{CategoryID: 5, Name: "cat5", Total: decimal.NewFromInt(50), Count: 1},
+		}
+		byTag := []report.TagTotalRow{
+			{TagID: 3, Name: "tag3", Total: decimal.NewFromInt(50), Count: 1},
+		}
+		byMerchant := []report.MerchantTotalRow{
+			{MerchantID: 4, Name: "Starbucks", Total: decimal.NewFromInt(50), Count: 1},
+		}
+		byPeriod := []report.PeriodTotalRow{
+			{Period: "2025-12-29", Total: decimal.RequireFromString("100.25"), Count: 2},
+			{Period: "2026-01-05", Total: decimal.NewFromInt(50), Count: 1},
+		}
+		byCategoryMonth := []report.CategoryPeriodTotalRow{
+			{Period: "2026-01", CategoryID: 2, Name: "cat2", Total: decimal.RequireFromString("100.25"), Count: 2},
+			{Period: "2026-01", CategoryID: 5, Name: "cat5", Total: decimal.NewFromInt(50), Count: 1},
+		}
+
+		mockReportRepo := new(reportMocks.MockReportRepository)
+		mockReportRepo.On("Total", filter).Return(total, nil).Once()
+		mockReportRepo.On("SumByCurrency", filter).Return(byCurrency, nil).Once()
+		mockReportRepo.On("SumByCategory", filter).Return(byCategory, nil).Once()
+		mockReportRepo.On("SumByTag", filter).Return(byTag, nil).Once()
+		mockReportRepo.On("SumByMerchant", filter).Return(byMerchant, nil).Once()
+		mockReportRepo.On("SumByPeriod", filter, report.PeriodWeek).Return(byPeriod, nil).Once()
+		mockReportRepo.On("SumByCategoryAndPeriod", filter, report.PeriodMonth).Return(byCategoryMonth, nil).Once()
+
+		mockUserService := new(userMocks.MockUserService)
+		mockUserService.On("GetUserByID", userID, userID).Return(&user.UserEntity{
+			Model:           gorm.Model{ID: userID},
+			Timezone:        "Asia/Bangkok",
+			DefaultCurrency: "THB",
+		}, nil).Once()
+
+		service := report.NewReportService(mockReportRepo, mockUserService)
+		summary, err := service.GetSummary(userID, dto)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Asia/Bangkok", summary.Timezone)
+		assert.Equal(t, "THB", summary.Currency)
+		assert.Equal(t, int64(1), summary.Unconverted)
+		assert.Equal(t, byCurrency, summary.ByCurrency)
+		assert.Equal(t, report.PeriodWeek, summary.Period)
+		assert.Equal(t, total.Total, summary.Total)
+		assert.Equal(t, total.Count, summary.Count)
+		assert.Equal(t, byCategory, summary.ByCategory)
+		assert.Equal(t, byTag, summary.ByTag)
+		assert.Equal(t, byMerchant, summary.ByMerchant)
+		assert.Equal(t, byPeriod, summary.ByPeriod)
+		assert.Equal(t, byCategoryMonth, summary.ByCategoryMonth)
+		mockReportRepo.AssertExpectations(t)
+		mockUserService.AssertExpectations(t)
+	})
+
+	t.Run("error_invalid_range", func(t *testing.T) {
+		var userID uint = 11
+		dto := report.GetSummaryRequest{From: "2026-02-01", To: "2026-01-01", Timezone: "UTC", Currency: "USD"}
+
+		mockReportRepo := new(reportMocks.MockReportRepository)
+
+		mockUserService := new(userMocks.MockUserService)
+
+		service := report.NewReportService(mockReportRepo, mockUserService)
+		summary, err := service.GetSummary(userID, dto)
+
+		assert.Nil(t, summary)
+		assert.Equal(t, apperror.ErrInvalidRequest, err)
+		mockReportRepo.AssertNotCalled(t, "Total", mock.Anything)
+		mockUserService.AssertNotCalled(t, "GetUserByID", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,95 @@
+package storage_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Perajit/expense-tracker-go/internal/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeS3 stands in for an S3-compatible service, keeping objects in memory by path.
+func fakeS3(t *testing.T) *httptest.Server {
+	var mu sync.Mutex
+	objects := map[string][]byte{}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=key/") || r.Header.Get("X-Amz-Date") == "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodPut:
+			data, _ := io.ReadAll(r.Body)
+			assert.Equal(t, r.ContentLength, int64(len(data)))
+			objects[r.URL.Path] = data
+		case http.MethodGet:
+			data, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(data)
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+}
+
+func TestS3Store(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := fakeS3(t)
+		defer server.Close()
+
+		store, err := storage.NewS3Store(storage.S3Config{
+			Endpoint:        server.URL,
+			Region:          "us-east-1",
+			Bucket:          "receipts",
+			AccessKeyID:     "key",
+			SecretAccessKey: "secret",
+		})
+		assert.NoError(t, err)
+
+		key := "attachments/11/receipt 1.pdf"
+		err = store.Put(key, strings.NewReader("%PDF-1.4"), 8, "application/pdf")
+		assert.NoError(t, err)
+
+		reader, err := store.Get(key)
+		assert.NoError(t, err)
+		data, _ := io.ReadAll(reader)
+		reader.Close()
+		assert.Equal(t, "%PDF-1.4", string(data))
+
+		err = store.Delete(key)
+		assert.NoError(t, err)
+
+		_, err = store.Get(key)
+		assert.ErrorIs(t, err, storage.ErrBlobNotFound)
+	})
+
+	t.Run("error_forbidden", func(t *testing.T) {
+		server := fakeS3(t)
+		defer server.Close()
+
+		store, _ := storage.NewS3Store(storage.S3Config{
+			Endpoint:    server.URL,
+			Region:      "us-east-1",
+			Bucket:      "receipts",
+			AccessKeyID: "other",
+		})
+
+		err := store.Put("attachments/11/a.png", strings.NewReader("x"), 1, "image/png")
+
+		assert.ErrorContains(t, err, "403")
+	})
+}
@@ -1,26 +1,33 @@
 package user
 
 type CreateUserRequest struct {
-	Username string `json:"username" validate:"required"`
-	Password string `json:"password" validate:"required"`
-	Email    string `json:"email" validate:"required,email"`
+	Username        string `json:"username" validate:"required"`
+	Password        string `json:"password" validate:"required"`
+	Email           string `json:"email" validate:"required,email"`
+	DefaultCurrency string `json:"defaultCurrency" validate:"omitempty,iso4217"`
 }
 
 type UpdateUserRequest struct {
-	Password *string `json:"password"`
-	Email    *string `json:"email"`
+	Password        *string `json:"password"`
+	Email           *string `json:"email"`
+	Timezone        *string `json:"timezone" validate:"omitempty,timezone"`
+	DefaultCurrency *string `json:"defaultCurrency" validate:"omitempty,iso4217"`
 }
 
 type UserResponse struct {
-	ID       uint   `json:"id"`
-	Email    string `json:"email"`
-	Username string `json:"username"`
+	ID              uint   `json:"id"`
+	Email           string `json:"email"`
+	Username        string `json:"username"`
+	Timezone        string `json:"timezone"`
+	DefaultCurrency string `json:"defaultCurrency"`
 }
 
 func (UserResponse) FromEntity(user UserEntity) UserResponse {
 	return UserResponse{
-		ID:       user.ID,
-		Username: user.Username,
-		Email:    user.Email,
+		ID:              user.ID,
+		Username:        user.Username,
+		Email:           user.Email,
+		Timezone:        user.Timezone,
+		DefaultCurrency: user.DefaultCurrency,
 	}
 }
@@ -6,9 +6,11 @@ import (
 
 type UserEntity struct {
 	gorm.Model
-	Username string `gorm:"not null;uniqueIndex:idx_users_username"`
-	Password string `gorm:"not null"`
-	Email    string `gorm:"not null"`
+	Username        string `gorm:"not null;uniqueIndex:idx_users_username"`
+	Password        string `gorm:"not null"`
+	Email           string `gorm:"not null"`
+	Timezone        string `gorm:"not null;default:'UTC'"`
+	DefaultCurrency string `gorm:"type:char(3);not null;default:'USD'"`
 }
 
 func (UserEntity) TableName() string {
@@ -52,6 +52,9 @@ func (s *userService) CreateUser(dto CreateUserRequest) (*UserEntity, error) {
 		Password: hashedPassword,
 		Email:    dto.Email,
 	}
+	if dto.DefaultCurrency != "" {
+		user.DefaultCurrency = dto.DefaultCurrency
+	}
 	if err := s.userRepo.Create(user); err != nil {
 		return nil, err
 	}
@@ -81,6 +84,14 @@ func (s *userService) UpdateUser(id uint, authUserID uint, dto UpdateUserRequest
 		user.Email = *dto.Email
 	}
 
+	if dto.Timezone != nil {
+		user.Timezone = *dto.Timezone
+	}
+
+	if dto.DefaultCurrency != nil {
+		user.DefaultCurrency = *dto.DefaultCurrency
+	}
+
 	if err := s.userRepo.Update(user); err != nil {
 		return err
 	}
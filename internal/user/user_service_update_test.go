@@ -16,6 +16,8 @@ func TestUpdateUser(t *testing.T) {
 	var id uint = 1
 	newPassword := "pwd456"
 	newEmail := "new@example.com"
+	newTimezone := "Asia/Bangkok"
+	newCurrency := "THB"
 
 	tests_success := []struct {
 		name       string
@@ -52,6 +54,36 @@ func TestUpdateUser(t *testing.T) {
 				})).Return(nil).Once()
 			},
 		},
+		{
+			"success_update_timezone",
+			user.UpdateUserRequest{Timezone: &newTimezone},
+			func(mockUserRepo *mocks.MockUserRepository, matchedEntity *user.UserEntity, dto user.UpdateUserRequest) {
+				mockUserRepo.On("Update", mock.MatchedBy(func(e *user.UserEntity) bool {
+					if e.ID != id || e.Username != matchedEntity.Username || e.Email != matchedEntity.Email {
+						return false
+					}
+					if e.Timezone != *dto.Timezone {
+						return false
+					}
+					return true
+				})).Return(nil).Once()
+			},
+		},
+		{
+			"success_update_default_currency",
+			user.UpdateUserRequest{DefaultCurrency: &newCurrency},
+			func(mockUserRepo *mocks.MockUserRepository, matchedEntity *user.UserEntity, dto user.UpdateUserRequest) {
+				mockUserRepo.On("Update", mock.MatchedBy(func(e *user.UserEntity) bool {
+					if e.ID != id || e.Username != matchedEntity.Username || e.Email != matchedEntity.Email {
+						return false
+					}
+					if e.DefaultCurrency != *dto.DefaultCurrency {
+						return false
+					}
+					return true
+				})).Return(nil).Once()
+			},
+		},
 		{
 			"success_update_all",
 			user.UpdateUserRequest{Password: &newPassword, Email: &newEmail},
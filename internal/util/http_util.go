@@ -30,8 +30,25 @@ func ExtractDto[T any](c *fiber.Ctx, validate *validator.Validate) (T, error) {
 	return dto, nil
 }
 
+func ExtractQuery[T any](c *fiber.Ctx, validate *validator.Validate) (T, error) {
+	var dto T
+	if err := c.QueryParser(&dto); err != nil {
+		return dto, err
+	}
+
+	if err := validate.Struct(dto); err != nil {
+		return dto, err
+	}
+
+	return dto, nil
+}
+
 func ExtractIDParam(c *fiber.Ctx) (uint, error) {
-	param := c.Params("id")
+	return ExtractNamedIDParam(c, "id")
+}
+
+func ExtractNamedIDParam(c *fiber.Ctx, name string) (uint, error) {
+	param := c.Params(name)
 	id, err := strconv.ParseInt(param, 10, 64)
 	if err != nil || id <= 0 {
 		return 0, err
@@ -45,8 +62,8 @@ func ExtractIDsParam(c *fiber.Ctx) ([]uint, error) {
 	idStrs := strings.Split(param, ",")
 	ids := []uint{}
 
-	for range idStrs {
-		id, err := strconv.ParseInt(param, 10, 64)
+	for _, idStr := range idStrs {
+		id, err := strconv.ParseInt(idStr, 10, 64)
 		if err != nil {
 			return nil, err
 		}